@@ -14,10 +14,15 @@ import (
 	"go.uber.org/dig"
 
 	"link/config"
+	_celebrationUsecase "link/internal/celebration/usecase"
+	_chatUsecase "link/internal/chat/usecase"
+	_companyUsecase "link/internal/company/usecase"
 	handlerHttp "link/pkg/http"
 	"link/pkg/interceptor"
 	"link/pkg/logger"
 	"link/pkg/middleware"
+	"link/pkg/scheduler"
+	"link/pkg/util"
 	ws "link/pkg/ws"
 )
 
@@ -69,9 +74,17 @@ func startServer() {
 	}()
 
 	cfg := config.LoadConfig()
+	if err := util.CheckMediaURLSecret(); err != nil {
+		log.Fatalf("서버 시작 실패: %v", err)
+	}
 	logger.LogSuccess("서버 초기화 성공")
 
 	config.AutoMigrate(cfg.DB)
+	config.MigrateChatReadCursors(cfg.DB, cfg.Mongo)
+	config.MigrateChatRoomOwners(cfg.DB)
+	config.MigrateChatSearchTokens(cfg.Mongo)
+	config.InitChatSearchIndex(cfg.Mongo)
+	config.InitChatDeliveryIndex(cfg.Mongo)
 	config.InitCompany(cfg.DB)
 	config.InitAdminUser(cfg.DB)
 	config.InitRedisUserState(cfg.Redis)
@@ -79,6 +92,7 @@ func startServer() {
 	config.EnsureDirectory("static/profiles")
 	config.EnsureDirectory("static/posts")
 	config.EnsureDirectory("static/company")
+	config.EnsureDirectory("static/chats")
 
 	// TODO: Gin 모드 설정 (프로덕션일 경우)
 	// gin.SetMode(gin.ReleaseMode)
@@ -90,11 +104,6 @@ func startServer() {
 	r := gin.Default()
 	r.Use(middleware.RequestLogger()) // 로깅 미들웨어 추가
 
-	//TODO 이미지 정적 파일 제공
-
-	r.Static("/static/posts", "./static/posts")       //게시물
-	r.Static("/static/profiles", "./static/profiles") //프로필
-
 	// CORS 설정 - 개발 환경에서는 모든 오리진을 쿠키 허용
 	//TODO 배포 환경에서 특정도메인 허용
 	// allowedOrigins := strings.Split(os.Getenv("LINK_UI_URL"), ",")
@@ -132,16 +141,53 @@ func startServer() {
 		reportHandler *handlerHttp.ReportHandler,
 		projectHandler *handlerHttp.ProjectHandler,
 		boardHandler *handlerHttp.BoardHandler,
+		mediaHandler *handlerHttp.MediaHandler,
+		celebrationHandler *handlerHttp.CelebrationHandler,
+		companyVerificationHandler *handlerHttp.CompanyVerificationHandler,
+		companyPlanHandler *handlerHttp.CompanyPlanHandler,
+		companyInviteLinkHandler *handlerHttp.CompanyInviteLinkHandler,
+		companyEmailDomainHandler *handlerHttp.CompanyEmailDomainHandler,
+		companyRoleHandler *handlerHttp.CompanyRoleHandler,
+		companyReportingHandler *handlerHttp.CompanyReportingHandler,
+		teamHandler *handlerHttp.TeamHandler,
+		companyOffboardingHandler *handlerHttp.CompanyOffboardingHandler,
 		params struct {
 			dig.In
 			ProfileImageMiddleware *middleware.ImageUploadMiddleware `name:"profileImageMiddleware"`
 			PostImageMiddleware    *middleware.ImageUploadMiddleware `name:"postImageMiddleware"`
+
+			CompanyDocumentMiddleware *middleware.ImageUploadMiddleware `name:"companyDocumentMiddleware"`
+			ChatAttachmentMiddleware  *middleware.ChatAttachmentUploadMiddleware
 		},
 
 		tokenInterceptor *interceptor.TokenInterceptor,
 
 		wsHandler *ws.WsHandler,
+
+		celebrationUsecase _celebrationUsecase.CelebrationUsecase,
+		companyOffboardingUsecase _companyUsecase.CompanyOffboardingUsecase,
+		chatUsecase _chatUsecase.ChatUsecase,
 	) {
+		//TODO 매일 오전 9시(KST) 생일, 입사기념일 축하 알림
+		go scheduler.RunDailyAt(9, 0, "celebration", celebrationUsecase.SendDailyCelebrations)
+
+		//TODO 매일 새벽 4시(KST) 삭제 유예 기간이 지난 회사 영구 삭제
+		go scheduler.RunDailyAt(4, 0, "company-purge", companyOffboardingUsecase.PurgeExpiredCompanies)
+
+		//TODO 30초마다 전송 시각이 된 예약 메시지 전송
+		go scheduler.RunEvery(30*time.Second, "scheduled-chat", chatUsecase.DeliverScheduledChatMessages)
+
+		//TODO 매일 새벽 4시 30분(KST) 요금제 채팅 보관 기간이 지난 팀 채팅방 메시지 삭제
+		go scheduler.RunDailyAt(4, 30, "chat-retention", chatUsecase.PurgeExpiredChatMessages)
+
+		//TODO 이미지 파일 제공 - 서명 URL 또는 접근 권한 확인 후 제공
+		staticGroup := r.Group("/static", tokenInterceptor.AccessTokenInterceptor())
+		{
+			staticGroup.GET("/posts/*filepath", mediaHandler.ServePostMedia)       //게시물
+			staticGroup.GET("/profiles/*filepath", mediaHandler.ServeProfileMedia) //프로필
+			staticGroup.GET("/chats/*filepath", mediaHandler.ServeChatMedia)       //채팅 첨부파일
+		}
+
 		// WebSocket 관련 라우팅 그룹
 		wsGroup := r.Group("/ws")
 		{
@@ -160,6 +206,7 @@ func startServer() {
 			})
 			publicRoute.POST("user/signup", userHandler.RegisterUser)
 			publicRoute.GET("user/validate-email", userHandler.ValidateEmail)
+			publicRoute.POST("user/verify-email", userHandler.VerifyEmail) //TODO 메일로 받은 토큰으로 이메일 확인
 			publicRoute.GET("user/validate-nickname", userHandler.ValidateNickname)
 			publicRoute.POST("auth/signin", authHandler.SignIn)
 			publicRoute.GET("company/list", companyHandler.GetAllCompanies)
@@ -168,33 +215,72 @@ func startServer() {
 			publicRoute.GET("auth/refresh", tokenInterceptor.RefreshTokenInterceptor(), authHandler.RefreshToken) //TODO accessToken 재발급
 
 		}
-		protectedRoute := api.Group("/", tokenInterceptor.AccessTokenInterceptor())
+		//TODO 삭제 예정 회사는 읽기 전용 - 로그아웃, 회사 전환, 운영자 기능은 허용
+		protectedRoute := api.Group("/", tokenInterceptor.AccessTokenInterceptor(),
+			middleware.CompanyReadOnlyMiddleware(companyOffboardingUsecase, "/api/auth/", "/api/admin/"))
 		//, tokenInterceptor.RefreshTokenInterceptor() accessToken 재발급 인터셉터 제거 -> accessToken 재발급 기능 따로 구현 (필요해지면 다시 사용)
 		{
 
 			auth := protectedRoute.Group("auth")
 			{
 				auth.POST("/signout", authHandler.SignOut) //완료되면 모든 로그 찍기
+
+				//TODO 활성 회사 변경 (토큰 재발급)
+				auth.POST("/company/switch", authHandler.SwitchCompany)
 			}
 
 			chat := protectedRoute.Group("chat")
 			{
 				//! 채팅방 관련 핸들러
 				chat.GET("/list", chatHandler.GetChatRoomList)
+				chat.GET("/search", chatHandler.SearchChatMessages) //! 참여 중인 채팅방 전체 검색
 				chat.GET("/:chatroomid", chatHandler.GetChatRoomById)
-				chat.DELETE("/:chatroomid", chatHandler.LeaveChatRoom) //! 채팅방 나가기
+				chat.DELETE("/:chatroomid", chatHandler.LeaveChatRoom)                //! 채팅방 나가기
+				chat.PUT("/:chatroomid/settings", chatHandler.UpdateChatRoomSettings) //! 알림 끄기, 보관, 상단 고정
+				//! 그룹 채팅방 관리 - 방장, 관리자
+				chat.PUT("/:chatroomid", params.ChatAttachmentMiddleware.ChatRoomImageUpload(), chatHandler.UpdateChatRoom)
+				chat.GET("/:chatroomid/members", chatHandler.GetChatRoomMembers)
+				chat.POST("/:chatroomid/members", chatHandler.AddChatRoomMembers)
+				chat.DELETE("/:chatroomid/members/:userid", chatHandler.RemoveChatRoomMember)
+				chat.PUT("/:chatroomid/members/:userid/role", chatHandler.UpdateChatRoomMemberRole)
+				chat.PUT("/:chatroomid/owner", chatHandler.TransferChatRoomOwnership)
 				chat.POST("", chatHandler.CreateChatRoom)
 				chat.GET("/:chatroomid/messages", chatHandler.GetChatMessages)
 				chat.DELETE("/messages", chatHandler.DeleteChatMessage) //! 채팅 메시지 삭제
+				chat.PUT("/messages", chatHandler.UpdateChatMessage)    //! 채팅 메시지 수정
+				chat.GET("/:chatroomid/messages/:messageid/history", chatHandler.GetChatMessageHistory)
+				chat.GET("/:chatroomid/messages/:messageid/thread", chatHandler.GetChatThread) //! 스레드 답글 조회
+				chat.PUT("/:chatroomid/read", chatHandler.ReadChatRoom)                        //! 읽음 커서 이동
+				chat.POST("/:chatroomid/messages/:messageid/reaction", chatHandler.AddChatMessageReaction)
+				chat.DELETE("/:chatroomid/messages/:messageid/reaction/:emojiid", chatHandler.RemoveChatMessageReaction)
+				//! 첨부파일 전송, 채팅방 파일 모아보기
+				chat.POST("/:chatroomid/attachments", params.ChatAttachmentMiddleware.ChatAttachmentUpload(), chatHandler.UploadChatAttachments)
+				chat.GET("/:chatroomid/files", chatHandler.GetChatFiles)
+				chat.GET("/:chatroomid/messages/:messageid/context", chatHandler.GetChatMessageContext) //! 검색 결과로 이동
+
+				//! 예약 메시지 - 작성자만
+				chat.POST("/:chatroomid/scheduled", chatHandler.ScheduleChatMessage)
+				chat.GET("/:chatroomid/scheduled", chatHandler.GetScheduledChatMessages)
+				chat.PUT("/:chatroomid/scheduled/:scheduledid", chatHandler.UpdateScheduledChatMessage)
+				chat.DELETE("/:chatroomid/scheduled/:scheduledid", chatHandler.CancelScheduledChatMessage)
+
+				chat.GET("/:chatroomid/pins", chatHandler.GetPinnedChatMessages)
+				chat.POST("/:chatroomid/messages/:messageid/pin", chatHandler.PinChatMessage)
+				chat.DELETE("/:chatroomid/messages/:messageid/pin", chatHandler.UnpinChatMessage)
+				chat.POST("/:chatroomid/messages/:messageid/bookmark", chatHandler.BookmarkChatMessage)
+				chat.GET("/bookmarks", chatHandler.GetChatBookmarks) //! 저장 항목
+				chat.DELETE("/bookmarks/:messageid", chatHandler.RemoveChatMessageBookmark)
 
 				// chat.GET("/:id", chatHandler.GetChatRoom) // 채팅방 정보
 			}
 			user := protectedRoute.Group("user")
 			{
+				user.POST("/verify-email/resend", userHandler.ResendEmailVerification) //TODO 이메일 확인 메일 재발송
 				user.GET("/:id", userHandler.GetUserInfo)
 				user.PUT("/:id", params.ProfileImageMiddleware.ProfileImageUploadMiddleware(), userHandler.UpdateUserInfo)
 				user.DELETE("/:id", userHandler.DeleteUser)
 				user.GET("/company/list", userHandler.GetUserByCompany) //TODO 같은 회사 사용자 조회
+				user.GET("/companies", userHandler.GetUserCompanies)    //TODO 소속된 회사 목록
 				user.GET("/department/:departmentid", userHandler.GetUsersByDepartment)
 				// user.GET("/company/organization/:companyid", userHandler.GetOrganizationByCompany)
 			}
@@ -206,6 +292,7 @@ func startServer() {
 
 				//TODO 회사 조직도 조회
 				company.GET("/organization", companyHandler.GetOrganizationByCompany)
+				company.GET("/organization/tree", companyHandler.GetOrganizationTree) //TODO 계층형 조직도
 
 				//TODO 회사 직책 관련 핸들러
 				company.GET("/position/list", companyHandler.GetCompanyPositionList)
@@ -213,6 +300,55 @@ func startServer() {
 				company.POST("/position/:companyid", companyHandler.CreateCompanyPosition)
 				company.DELETE("/position/:positionid", companyHandler.DeleteCompanyPosition)
 				company.PUT("/position/:positionid", companyHandler.UpdateCompanyPosition)
+
+				//TODO 생일, 입사기념일
+				company.GET("/celebrations", celebrationHandler.GetCelebrations)
+				company.PUT("/celebrations/setting", celebrationHandler.UpdateCelebrationSetting)
+
+				//TODO 회사 인증 요청 (사업자등록 서류 제출)
+				company.POST("/verification", params.CompanyDocumentMiddleware.CompanyDocumentUploadMiddleware(), companyVerificationHandler.SubmitCompanyVerification)
+				company.GET("/verification", companyVerificationHandler.GetCompanyVerifications)
+				company.GET("/verification/document/:documentid", companyVerificationHandler.DownloadCompanyVerificationDocument)
+
+				//TODO 요금제 사용량 조회
+				company.GET("/usage", companyPlanHandler.GetCompanyUsage)
+
+				//TODO 공유용 초대 링크 관리
+				company.POST("/invite-link", companyInviteLinkHandler.CreateCompanyInviteLink)
+				company.GET("/invite-link", companyInviteLinkHandler.GetCompanyInviteLinks)
+				company.DELETE("/invite-link/:linkid", companyInviteLinkHandler.RevokeCompanyInviteLink)
+
+				//TODO 이메일 도메인 등록 및 도메인 사용자 가입
+				company.POST("/domain", companyEmailDomainHandler.ClaimCompanyEmailDomain)
+				company.GET("/domain", companyEmailDomainHandler.GetCompanyEmailDomains)
+				company.POST("/domain/:domainid/verify", companyEmailDomainHandler.VerifyCompanyEmailDomain)
+				company.PUT("/domain/:domainid", companyEmailDomainHandler.UpdateCompanyEmailDomainPolicy)
+				company.DELETE("/domain/:domainid", companyEmailDomainHandler.DeleteCompanyEmailDomain)
+				company.GET("/domain/suggestions", companyEmailDomainHandler.GetSuggestedCompanies)
+				company.POST("/domain/join", companyHandler.JoinCompanyByEmailDomain)
+
+				//TODO 회사 역할 및 권한
+				company.POST("/role", companyRoleHandler.CreateCompanyRole)
+				company.GET("/role", companyRoleHandler.GetCompanyRoles)
+				company.GET("/role/me", companyRoleHandler.GetMyCompanyPermissions)
+				company.PUT("/role/:roleid", companyRoleHandler.UpdateCompanyRole)
+				company.DELETE("/role/:roleid", companyRoleHandler.DeleteCompanyRole)
+				company.PUT("/role/member/:userid", companyRoleHandler.AssignCompanyRole)
+
+				//TODO 보고 라인 (상급자, 직속 부하, 조직도)
+				company.GET("/reporting/chart", companyReportingHandler.GetReportingChart)
+				company.GET("/reporting/:userid/reports", companyReportingHandler.GetDirectReports)
+				company.GET("/reporting/:userid/managers", companyReportingHandler.GetManagementChain)
+				company.PUT("/reporting/:userid/manager", companyReportingHandler.UpdateManager)
+
+				//TODO 회사 전체 데이터 내보내기 (JSON 파일 다운로드)
+				company.GET("/export", companyOffboardingHandler.ExportCompanyData)
+			}
+			invite := protectedRoute.Group("invite")
+			{
+				//TODO 초대 링크 확인 및 사용
+				invite.GET("/:code", companyInviteLinkHandler.GetCompanyInviteLinkPreview)
+				invite.POST("/:code/redeem", companyInviteLinkHandler.RedeemCompanyInviteLink)
 			}
 			department := protectedRoute.Group("department")
 			{
@@ -221,12 +357,33 @@ func startServer() {
 				department.GET("/:id", departmentHandler.GetDepartment)
 				department.PUT("/:id", departmentHandler.UpdateDepartment)
 				department.DELETE("/:id", departmentHandler.DeleteDepartment)
+				department.PUT("/:id/move", departmentHandler.MoveDepartment) //TODO 상위 부서 변경
+
+				//TODO 부서 통합, 분리 (preview는 실제 변경 없이 결과만 반환)
+				department.POST("/:id/merge/preview", departmentHandler.PreviewMergeDepartment)
+				department.POST("/:id/merge", departmentHandler.MergeDepartment)
+				department.POST("/:id/split/preview", departmentHandler.PreviewSplitDepartment)
+				department.POST("/:id/split", departmentHandler.SplitDepartment)
 				department.POST("/invite", departmentHandler.InviteUserToDepartment)
 			}
 
+			//TODO 팀 - 부서와 별개로 여러 부서에 걸친 팀 구성 가능
+			team := protectedRoute.Group("team")
+			{
+				team.POST("", teamHandler.CreateTeam)
+				team.GET("/list", teamHandler.GetTeams)
+				team.GET("/:teamid", teamHandler.GetTeam)
+				team.PUT("/:teamid", teamHandler.UpdateTeam)
+				team.DELETE("/:teamid", teamHandler.DeleteTeam)
+				team.POST("/:teamid/member", teamHandler.AddTeamMembers)
+				team.PUT("/:teamid/member/:userid", teamHandler.UpdateTeamMember)
+				team.DELETE("/:teamid/member/:userid", teamHandler.RemoveTeamMember) //TODO 본인이면 팀 나가기
+			}
+
 			notification := protectedRoute.Group("notification")
 			{
 				notification.POST("/mention", notificationHandler.SendMentionNotification)
+				notification.POST("/mention/team", notificationHandler.SendTeamMentionNotification) //TODO @팀핸들 언급 시 팀 구성원 전체 알림
 				notification.GET("/list", notificationHandler.GetNotifications)
 				notification.PUT("/invite/status", notificationHandler.UpdateInviteNotificationStatus) //! 초대 알림 수락 및 거절
 				notification.PUT("/:docId", notificationHandler.UpdateNotificationReadStatus)          //! 알림 읽음 처리
@@ -260,7 +417,14 @@ func startServer() {
 				admin.POST("/signup", adminHandler.AdminCreateAdmin)
 				admin.POST("/company", params.ProfileImageMiddleware.CompanyImageUploadMiddleware(), adminHandler.AdminCreateCompany)
 				admin.PUT("/company", adminHandler.AdminUpdateCompany)
-				admin.DELETE("/company/:companyid", adminHandler.AdminDeleteCompany)
+				admin.DELETE("/company/:companyid", adminHandler.AdminDeleteCompany) //TODO 30일 유예 후 영구 삭제
+				admin.POST("/company/:companyid/restore", adminHandler.AdminRestoreCompany)
+				admin.GET("/company/:companyid/export", companyOffboardingHandler.AdminExportCompanyData)
+
+				//TODO 회사 인증 요청 검토 목록, 승인/반려
+				admin.GET("/company/verification", companyVerificationHandler.GetCompanyVerificationQueue)
+				admin.PUT("/company/verification/:verificationid", companyVerificationHandler.ReviewCompanyVerification)
+
 				admin.GET("/user/list", adminHandler.AdminGetAllUsers)                     //TODO 전체 사용자 조회
 				admin.GET("/user/company/:companyid", adminHandler.AdminGetUsersByCompany) //TODO 회사 사용자 조회
 				admin.GET("/user/search", adminHandler.AdminSearchUser)
@@ -335,6 +499,11 @@ func startServer() {
 				//활동 로그
 			}
 
+			media := protectedRoute.Group("media")
+			{
+				media.GET("/signed-url", mediaHandler.GetSignedMediaURL)
+			}
+
 			report := protectedRoute.Group("report")
 			{
 				report.POST("", reportHandler.CreateReport)
@@ -15,16 +15,20 @@ import (
 	adminUsecase "link/internal/admin/usecase"
 	authUsecase "link/internal/auth/usecase"
 	boardUsecase "link/internal/board/usecase"
+	celebrationUsecase "link/internal/celebration/usecase"
+	_chatRepo "link/internal/chat/repository"
 	chatUsecase "link/internal/chat/usecase"
 	commentUsecase "link/internal/comment/usecase"
 	companyUsecase "link/internal/company/usecase"
 	departmentUsecase "link/internal/department/usecase"
 	likeUsecase "link/internal/like/usecase"
+	mediaUsecase "link/internal/media/usecase"
 	notificationUsecase "link/internal/notification/usecase"
 	postUsecase "link/internal/post/usecase"
 	projectUsecase "link/internal/project/usecase"
 	reportUsecase "link/internal/report/usecase"
 	statUsecase "link/internal/stat/usecase"
+	teamUsecase "link/internal/team/usecase"
 	userUsecase "link/internal/user/usecase"
 	_nats "link/pkg/nats"
 
@@ -59,14 +63,22 @@ func BuildContainer(db *gorm.DB,
 
 	//미들웨어 주입
 	// config.go의 BuildContainer 함수에서 미들웨어 등록 부분을 수정
-	container.Provide(func() *middleware.ImageUploadMiddleware {
-		return middleware.NewImageUploadMiddleware("./static/profiles", "/static/profiles")
+	container.Provide(func(storageQuota companyUsecase.CompanyPlanUsecase) *middleware.ImageUploadMiddleware {
+		return middleware.NewImageUploadMiddleware("./static/profiles", "/static/profiles", storageQuota)
 	}, dig.Name("profileImageMiddleware"))
 
-	container.Provide(func() *middleware.ImageUploadMiddleware {
-		return middleware.NewImageUploadMiddleware("./static/posts", "/static/posts")
+	container.Provide(func(storageQuota companyUsecase.CompanyPlanUsecase) *middleware.ImageUploadMiddleware {
+		return middleware.NewImageUploadMiddleware("./static/posts", "/static/posts", storageQuota)
 	}, dig.Name("postImageMiddleware"))
 
+	container.Provide(func(storageQuota companyUsecase.CompanyPlanUsecase) *middleware.ImageUploadMiddleware {
+		return middleware.NewImageUploadMiddleware("./static/company_documents", "/static/company_documents", storageQuota)
+	}, dig.Name("companyDocumentMiddleware"))
+
+	container.Provide(func(storageQuota companyUsecase.CompanyPlanUsecase, chatRepo _chatRepo.ChatRepository) *middleware.ChatAttachmentUploadMiddleware {
+		return middleware.NewChatAttachmentUploadMiddleware("./static/chats", "/static/chats", storageQuota, chatRepo)
+	})
+
 	// Repository 계층 등록
 	container.Provide(persistence.NewAuthPersistence)
 	container.Provide(persistence.NewUserPersistence)
@@ -81,6 +93,14 @@ func BuildContainer(db *gorm.DB,
 	container.Provide(persistence.NewReportPersistence)
 	container.Provide(persistence.NewProjectPersistence)
 	container.Provide(persistence.NewBoardPersistence)
+	container.Provide(persistence.NewCelebrationPersistence)
+	container.Provide(persistence.NewCompanyVerificationPersistence)
+	container.Provide(persistence.NewCompanyInviteLinkPersistence)
+	container.Provide(persistence.NewCompanyEmailDomainPersistence)
+	container.Provide(persistence.NewCompanyRolePersistence)
+	container.Provide(persistence.NewCompanyReportingPersistence)
+	container.Provide(persistence.NewTeamPersistence)
+	container.Provide(persistence.NewCompanyOffboardingPersistence)
 	// Usecase 계층 등록
 	container.Provide(authUsecase.NewAuthUsecase)
 	container.Provide(userUsecase.NewUserUsecase)
@@ -96,6 +116,16 @@ func BuildContainer(db *gorm.DB,
 	container.Provide(reportUsecase.NewReportUsecase)
 	container.Provide(projectUsecase.NewProjectUsecase)
 	container.Provide(boardUsecase.NewBoardUsecase)
+	container.Provide(mediaUsecase.NewMediaUsecase)
+	container.Provide(celebrationUsecase.NewCelebrationUsecase)
+	container.Provide(companyUsecase.NewCompanyVerificationUsecase)
+	container.Provide(companyUsecase.NewCompanyPlanUsecase)
+	container.Provide(companyUsecase.NewCompanyInviteLinkUsecase)
+	container.Provide(companyUsecase.NewCompanyEmailDomainUsecase)
+	container.Provide(companyUsecase.NewCompanyRoleUsecase)
+	container.Provide(companyUsecase.NewCompanyReportingUsecase)
+	container.Provide(teamUsecase.NewTeamUsecase)
+	container.Provide(companyUsecase.NewCompanyOffboardingUsecase)
 	// Handler 계층 등록
 	container.Provide(http.NewUserHandler)
 	container.Provide(http.NewAuthHandler)
@@ -111,6 +141,16 @@ func BuildContainer(db *gorm.DB,
 	container.Provide(http.NewReportHandler)
 	container.Provide(http.NewProjectHandler)
 	container.Provide(http.NewBoardHandler)
+	container.Provide(http.NewMediaHandler)
+	container.Provide(http.NewCelebrationHandler)
+	container.Provide(http.NewCompanyVerificationHandler)
+	container.Provide(http.NewCompanyPlanHandler)
+	container.Provide(http.NewCompanyInviteLinkHandler)
+	container.Provide(http.NewCompanyEmailDomainHandler)
+	container.Provide(http.NewCompanyRoleHandler)
+	container.Provide(http.NewCompanyReportingHandler)
+	container.Provide(http.NewTeamHandler)
+	container.Provide(http.NewCompanyOffboardingHandler)
 	container.Provide(ws.NewWebSocketHub)
 
 	return container
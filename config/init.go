@@ -2,11 +2,15 @@ package config
 
 import (
 	"context"
+	"errors"
 	"log"
 	"os"
 	"time"
 
 	"github.com/go-redis/redis/v8"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"gorm.io/gorm"
 
 	"link/infrastructure/model"
@@ -123,6 +127,10 @@ func AutoMigrate(db *gorm.DB) {
 		&model.Department{},
 		&model.ChatRoom{},
 		&model.ChatRoomUser{},
+		&model.ChatBookmark{},
+		&model.ScheduledChatMessage{},
+		&model.Team{},
+		&model.TeamMember{},
 		&model.Post{},
 		&model.PostImage{},
 		&model.Comment{},
@@ -136,10 +144,38 @@ func AutoMigrate(db *gorm.DB) {
 		&model.BoardColumn{},
 		&model.BoardCard{},
 		&model.CardAssignee{},
+		&model.CompanyVerification{},
+		&model.CompanyVerificationDocument{},
+		&model.CompanyRole{},
+		&model.CompanyRolePermission{},
+		&model.CompanyMembership{},
+		&model.CompanyInviteLink{},
+		&model.CompanyInviteLinkRedemption{},
+		&model.CompanyEmailDomain{},
+		&model.EmailVerification{},
 	); err != nil {
 		log.Fatalf("마이그레이션 실패: %v", err)
 	}
 
+	//TODO 부서 이름 unique를 전역 -> 회사 단위로 변경 (기존 제약조건 제거)
+	if err := db.Exec("ALTER TABLE departments DROP CONSTRAINT IF EXISTS uni_departments_name").Error; err != nil {
+		log.Fatalf("부서 이름 제약조건 제거 중 오류 발생: %v", err)
+	}
+	if err := db.Exec("DROP INDEX IF EXISTS idx_departments_name").Error; err != nil {
+		log.Fatalf("부서 이름 인덱스 제거 중 오류 발생: %v", err)
+	}
+
+	//TODO 기존 사용자의 대표 회사를 회사 소속 정보로 옮김 (운영자는 회사 관리자로)
+	if err := db.Exec(`
+		INSERT INTO company_memberships (user_id, company_id, role, position_id, entry_date, created_at, updated_at)
+		SELECT user_profiles.user_id, user_profiles.company_id, GREATEST(users.role, ?), user_profiles.position_id, user_profiles.entry_date, NOW(), NOW()
+		FROM user_profiles
+		JOIN users ON users.id = user_profiles.user_id
+		WHERE user_profiles.company_id IS NOT NULL
+		ON CONFLICT (user_id, company_id) DO NOTHING`, model.RoleCompanyManager).Error; err != nil {
+		log.Fatalf("회사 소속 정보 이전 중 오류 발생: %v", err)
+	}
+
 	//EXTENSION
 	// GIN 인덱스 생성
 	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error; err != nil {
@@ -151,6 +187,186 @@ func AutoMigrate(db *gorm.DB) {
 	}
 }
 
+// TODO 메시지별 unread_by 배열을 채팅방 참여자별 읽음 커서로 변환
+// unread_by가 남아있는 메시지가 없으면 아무것도 하지 않음
+func MigrateChatReadCursors(db *gorm.DB, mongoClient *mongo.Client) {
+	ctx := context.Background()
+	collection := mongoClient.Database("link").Collection("messages")
+
+	legacyCount, err := collection.CountDocuments(ctx, bson.M{"unread_by": bson.M{"$exists": true}})
+	if err != nil {
+		log.Fatalf("읽음 커서 마이그레이션 중 오류 발생: %v", err)
+	}
+	if legacyCount == 0 {
+		return
+	}
+
+	var chatRoomUsers []model.ChatRoomUser
+	if err := db.Where("last_read_at IS NULL").Find(&chatRoomUsers).Error; err != nil {
+		log.Fatalf("읽음 커서 마이그레이션 중 오류 발생: %v", err)
+	}
+
+	//TODO 사용자가 unread_by에 없는 가장 최근 메시지 = 마지막으로 읽은 메시지
+	for _, chatRoomUser := range chatRoomUsers {
+		var lastRead model.Chat
+		err := collection.FindOne(ctx, bson.M{
+			"chat_room_id": chatRoomUser.ChatRoomID,
+			"unread_by":    bson.M{"$exists": true, "$nin": []uint{chatRoomUser.UserID}},
+		}, options.FindOne().SetSort(bson.M{"created_at": -1})).Decode(&lastRead)
+		if err == mongo.ErrNoDocuments {
+			continue
+		}
+		if err != nil {
+			log.Fatalf("읽음 커서 마이그레이션 중 오류 발생: %v", err)
+		}
+
+		if err := db.Model(&model.ChatRoomUser{}).
+			Where("chat_room_id = ? AND user_id = ?", chatRoomUser.ChatRoomID, chatRoomUser.UserID).
+			Updates(map[string]interface{}{
+				"last_read_message_id": lastRead.ID.Hex(),
+				"last_read_at":         lastRead.CreatedAt,
+			}).Error; err != nil {
+			log.Fatalf("읽음 커서 마이그레이션 중 오류 발생: %v", err)
+		}
+	}
+
+	result, err := collection.UpdateMany(ctx,
+		bson.M{"unread_by": bson.M{"$exists": true}},
+		bson.M{"$unset": bson.M{"unread_by": "", "unread_count": ""}},
+	)
+	if err != nil {
+		log.Fatalf("읽음 커서 마이그레이션 중 오류 발생: %v", err)
+	}
+	log.Printf("읽음 커서 마이그레이션 완료: 참여자 %d명, 메시지 %d건", len(chatRoomUsers), result.ModifiedCount)
+}
+
+// TODO 방장이 없는 그룹 채팅방은 가장 먼저 참여한 사용자를 방장으로 지정
+func MigrateChatRoomOwners(db *gorm.DB) {
+	result := db.Exec(`
+		UPDATE chat_room_users SET role = 'owner'
+		FROM (
+			SELECT DISTINCT ON (cru.chat_room_id) cru.chat_room_id, cru.user_id
+			FROM chat_room_users cru
+			JOIN chat_rooms cr ON cr.id = cru.chat_room_id
+			WHERE cr.is_private = false
+				AND cru.joined_at IS NOT NULL AND cru.left_at IS NULL
+				AND NOT EXISTS (
+					SELECT 1 FROM chat_room_users owner
+					WHERE owner.chat_room_id = cru.chat_room_id AND owner.role = 'owner'
+						AND owner.joined_at IS NOT NULL AND owner.left_at IS NULL
+				)
+			ORDER BY cru.chat_room_id, cru.joined_at, cru.user_id
+		) first_member
+		WHERE chat_room_users.chat_room_id = first_member.chat_room_id
+			AND chat_room_users.user_id = first_member.user_id`)
+	if result.Error != nil {
+		log.Fatalf("채팅방 방장 마이그레이션 중 오류 발생: %v", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		log.Printf("채팅방 방장 마이그레이션 완료: 채팅방 %d개", result.RowsAffected)
+	}
+}
+
+// TODO 채팅 검색용 텍스트 인덱스 - 한국어 형태소 분석이 없으므로 저장 시 만든 search_tokens(한글 1, 2글자 묶음)를 색인
+// 텍스트 인덱스는 컬렉션에 하나만 만들 수 있으므로 이전 content 인덱스는 삭제
+func InitChatSearchIndex(mongoClient *mongo.Client) {
+	collection := mongoClient.Database("link").Collection("messages")
+
+	if _, err := collection.Indexes().DropOne(context.Background(), "messages_content_text"); err != nil {
+		var commandErr mongo.CommandError
+		if !errors.As(err, &commandErr) || commandErr.Name != "IndexNotFound" {
+			log.Fatalf("채팅 검색 인덱스 삭제 중 오류 발생: %v", err)
+		}
+	}
+
+	_, err := collection.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "search_tokens", Value: "text"}},
+			Options: options.Index().
+				SetName("messages_search_tokens_text").
+				SetDefaultLanguage("none"),
+		},
+		{
+			Keys:    bson.D{{Key: "chat_room_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("messages_room_created_at"),
+		},
+	})
+	if err != nil {
+		log.Fatalf("채팅 검색 인덱스 생성 중 오류 발생: %v", err)
+	}
+}
+
+// TODO search_tokens가 없는 기존 메시지에 검색용 토큰 채우기
+func MigrateChatSearchTokens(mongoClient *mongo.Client) {
+	ctx := context.Background()
+	collection := mongoClient.Database("link").Collection("messages")
+
+	cursor, err := collection.Find(ctx,
+		bson.M{"search_tokens": bson.M{"$exists": false}},
+		options.Find().SetProjection(bson.M{"content": 1}))
+	if err != nil {
+		log.Fatalf("채팅 검색 토큰 마이그레이션 중 오류 발생: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	const batchSize = 500
+	updates := make([]mongo.WriteModel, 0, batchSize)
+	migrated := 0
+	flush := func() {
+		if len(updates) == 0 {
+			return
+		}
+		if _, err := collection.BulkWrite(ctx, updates, options.BulkWrite().SetOrdered(false)); err != nil {
+			log.Fatalf("채팅 검색 토큰 마이그레이션 중 오류 발생: %v", err)
+		}
+		migrated += len(updates)
+		updates = updates[:0]
+	}
+
+	for cursor.Next(ctx) {
+		var chat model.Chat
+		if err := cursor.Decode(&chat); err != nil {
+			log.Fatalf("채팅 검색 토큰 마이그레이션 중 오류 발생: %v", err)
+		}
+		updates = append(updates, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": chat.ID}).
+			SetUpdate(bson.M{"$set": bson.M{"search_tokens": util.SearchTokens(chat.Content)}}))
+		if len(updates) == batchSize {
+			flush()
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		log.Fatalf("채팅 검색 토큰 마이그레이션 중 오류 발생: %v", err)
+	}
+	flush()
+
+	if migrated > 0 {
+		log.Printf("채팅 검색 토큰 마이그레이션 완료: 메시지 %d건", migrated)
+	}
+}
+
+// TODO 채팅 전송 인덱스 - 같은 client_msg_id 재전송은 한 번만 저장, 순번으로 누락 메시지 조회
+func InitChatDeliveryIndex(mongoClient *mongo.Client) {
+	collection := mongoClient.Database("link").Collection("messages")
+
+	_, err := collection.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "chat_room_id", Value: 1}, {Key: "sender_id", Value: 1}, {Key: "client_msg_id", Value: 1}},
+			Options: options.Index().
+				SetName("messages_room_sender_client_msg_id").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"client_msg_id": bson.M{"$type": "string"}}),
+		},
+		{
+			Keys:    bson.D{{Key: "chat_room_id", Value: 1}, {Key: "seq", Value: 1}},
+			Options: options.Index().SetName("messages_room_seq"),
+		},
+	})
+	if err != nil {
+		log.Fatalf("채팅 전송 인덱스 생성 중 오류 발생: %v", err)
+	}
+}
+
 // TODO 레디스 사용자 정보 초기화
 func InitRedisUserState(redis *redis.Client) error {
 	keys, err := redis.Keys(context.Background(), "user:*").Result()
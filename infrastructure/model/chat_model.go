@@ -15,6 +15,71 @@ type Chat struct {
 	SenderImage string             `json:"sender_image,omitempty" bson:"sender_image,omitempty"` // 송신자 이미지
 	Content     string             `json:"content" bson:"content"`
 	CreatedAt   time.Time          `json:"created_at" bson:"created_at"`
-	UnreadBy    []uint             `bson:"unread_by"`    // 아직 읽지 않은 사용자 ID 목록
-	UnreadCount int                `bson:"unread_count"` // 읽지 않은 사용자 수
+
+	//TODO 검색용 토큰 (한글 1, 2글자 묶음과 단어) - 저장, 수정 시 content로 생성
+	SearchTokens []string `json:"-" bson:"search_tokens"`
+
+	//TODO 재연결 시 누락 메시지 재전송 - 클라이언트 메시지 ID(중복 저장 방지)와 채팅방별 순번
+	ClientMsgID string `json:"client_msg_id,omitempty" bson:"client_msg_id,omitempty"`
+	Seq         int64  `json:"seq,omitempty" bson:"seq,omitempty"`
+
+	//TODO 시스템 메시지 (멤버 추가/내보내기, 채팅방 이름 변경 등) - 일반 메시지는 비어있음
+	MessageType string `json:"message_type,omitempty" bson:"message_type,omitempty"`
+
+	//TODO 메시지 수정 - 수정 시각과 이전 내용 이력
+	EditedAt    *time.Time        `json:"edited_at,omitempty" bson:"edited_at,omitempty"`
+	EditHistory []ChatEditHistory `json:"edit_history,omitempty" bson:"edit_history,omitempty"`
+
+	//TODO 스레드 답글 - 답글은 parent_message_id, 원본 메시지는 답글 수와 마지막 답글 미리보기
+	ParentMessageID string         `json:"parent_message_id,omitempty" bson:"parent_message_id,omitempty"`
+	ShowInRoom      bool           `json:"show_in_room,omitempty" bson:"show_in_room,omitempty"` // 답글을 채팅방에도 표시
+	ReplyCount      int            `json:"reply_count,omitempty" bson:"reply_count,omitempty"`
+	LastReply       *ChatLastReply `json:"last_reply,omitempty" bson:"last_reply,omitempty"`
+
+	//TODO 이모지 반응 - emojis 테이블 재사용
+	Reactions []ChatReaction `json:"reactions,omitempty" bson:"reactions,omitempty"`
+
+	//TODO 채팅방 고정 메시지 - 고정한 사람과 시각
+	PinnedAt *time.Time `json:"pinned_at,omitempty" bson:"pinned_at,omitempty"`
+	PinnedBy uint       `json:"pinned_by,omitempty" bson:"pinned_by,omitempty"`
+
+	//TODO 첨부파일 - 파일은 static/chats/{채팅방 ID} 아래 저장
+	Attachments []ChatAttachment `json:"attachments,omitempty" bson:"attachments,omitempty"`
+}
+
+// 채팅방별 메시지 순번 (_id: 채팅방 ID)
+type ChatSequence struct {
+	ChatRoomID uint  `bson:"_id"`
+	Seq        int64 `bson:"seq"`
+}
+
+type ChatAttachment struct {
+	Name         string `json:"name" bson:"name"`
+	Size         int64  `json:"size" bson:"size"`
+	MimeType     string `json:"mime_type" bson:"mime_type"`
+	URL          string `json:"url" bson:"url"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty" bson:"thumbnail_url,omitempty"`
+}
+
+// 채팅 메시지 이모지 반응 (사용자별 1건)
+type ChatReaction struct {
+	EmojiID   uint      `json:"emoji_id" bson:"emoji_id"`
+	Unified   string    `json:"unified" bson:"unified"`
+	Content   string    `json:"content" bson:"content"`
+	UserID    uint      `json:"user_id" bson:"user_id"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
+
+// 스레드 마지막 답글 미리보기
+type ChatLastReply struct {
+	SenderID   uint      `json:"sender_id" bson:"sender_id"`
+	SenderName string    `json:"sender_name" bson:"sender_name"`
+	Content    string    `json:"content" bson:"content"`
+	CreatedAt  time.Time `json:"created_at" bson:"created_at"`
+}
+
+// 수정 전 메시지 내용
+type ChatEditHistory struct {
+	Content  string    `json:"content" bson:"content"`
+	EditedAt time.Time `json:"edited_at" bson:"edited_at"`
 }
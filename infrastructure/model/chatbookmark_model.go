@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// TODO 채팅 메시지 북마크 - 사용자별 비공개, 메시지는 mongo에 있으므로 ID만 저장
+type ChatBookmark struct {
+	ID            uint      `gorm:"primaryKey"`
+	UserID        uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_chat_bookmarks_user_message"`
+	User          User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	ChatRoomID    uint      `json:"chat_room_id" gorm:"not null;index"`
+	ChatRoom      ChatRoom  `gorm:"foreignKey:ChatRoomID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	ChatMessageID string    `json:"chat_message_id" gorm:"size:24;not null;uniqueIndex:idx_chat_bookmarks_user_message"`
+	CreatedAt     time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
@@ -10,6 +10,19 @@ type ChatRoomUser struct {
 	LeftAt     time.Time `gorm:"default:null"`
 	//TODO 사용자별 채팅방 별칭 추가
 	ChatRoomAlias string `gorm:"default:''"`
+
+	//TODO 읽음 커서 - 마지막으로 읽은 메시지 (메시지별 unread_by 대체)
+	LastReadMessageID string     `gorm:"default:''"`
+	LastReadAt        *time.Time `gorm:"default:null"`
+
+	//TODO 그룹 채팅방 권한 - owner(방장), admin(관리자), member
+	Role string `gorm:"size:20;not null;default:'member'"`
+
+	//TODO 사용자별 채팅방 설정 - 알림 끄기, 보관(새 메시지가 오면 다시 표시), 상단 고정
+	IsMuted    bool       `gorm:"not null;default:false"`
+	ArchivedAt *time.Time `gorm:"default:null"`
+	PinnedAt   *time.Time `gorm:"default:null"`
+
 	// 관계 설정 belongsTo
 	User     *User     `gorm:"foreignKey:UserID;references:ID"`
 	ChatRoom *ChatRoom `gorm:"foreignKey:ChatRoomID;references:ID"`
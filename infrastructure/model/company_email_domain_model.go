@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// TODO 회사 이메일 도메인 - DNS TXT 레코드로 소유 확인 후 같은 도메인 사용자를 회사에 연결
+// 인증 완료된 도메인은 하나의 회사만 가질 수 있음
+type CompanyEmailDomain struct {
+	ID                uint       `gorm:"primaryKey"`
+	CompanyID         uint       `json:"company_id" gorm:"not null;uniqueIndex:idx_company_email_domain"`
+	Company           Company    `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Domain            string     `json:"domain" gorm:"size:255;not null;uniqueIndex:idx_company_email_domain;uniqueIndex:idx_verified_email_domain,where:verified_at IS NOT NULL"`
+	VerificationToken string     `json:"-" gorm:"size:64;not null"`
+	JoinPolicy        string     `json:"join_policy" gorm:"size:20;not null;default:'SUGGEST'"` // SUGGEST: 가입 제안, AUTO_JOIN: 자동 가입
+	VerifiedAt        *time.Time `json:"verified_at,omitempty" gorm:"default:null"`
+	CreatedAt         time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// TODO 공유용 초대 링크 - 코드만 알면 누구나 사용 가능하므로 만료, 사용 횟수, 이메일 도메인으로 제한
+type CompanyInviteLink struct {
+	ID           uint        `gorm:"primaryKey"`
+	Code         string      `json:"code" gorm:"size:32;not null;uniqueIndex"`
+	CompanyID    uint        `json:"company_id" gorm:"not null;index"`
+	Company      Company     `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	CreatorID    uint        `json:"creator_id" gorm:"not null"`
+	Creator      User        `gorm:"foreignKey:CreatorID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	TargetType   string      `json:"target_type" gorm:"size:20;not null"` // COMPANY, DEPARTMENT, PROJECT
+	DepartmentID *uint       `json:"department_id,omitempty" gorm:"default:null"`
+	Department   *Department `gorm:"foreignKey:DepartmentID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	ProjectID    *uint       `json:"project_id,omitempty" gorm:"default:null"`
+	Project      *Project    `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Role         UserRole    `json:"role" gorm:"not null;default:5"`     // 가입 시 회사 내 역할
+	EmailDomain  string      `json:"email_domain" gorm:"size:255"`       // 비어있으면 제한 없음
+	MaxUses      int         `json:"max_uses" gorm:"not null;default:0"` // 0이면 무제한
+	UseCount     int         `json:"use_count" gorm:"not null;default:0"`
+	ExpiresAt    *time.Time  `json:"expires_at,omitempty" gorm:"default:null"`
+	RevokedAt    *time.Time  `json:"revoked_at,omitempty" gorm:"default:null"`
+	CreatedAt    time.Time   `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+}
+
+// TODO 초대 링크 사용 이력 - 한 사용자는 같은 링크를 한 번만 사용
+type CompanyInviteLinkRedemption struct {
+	InviteLinkID uint              `gorm:"primaryKey"`
+	UserID       uint              `gorm:"primaryKey"`
+	InviteLink   CompanyInviteLink `gorm:"foreignKey:InviteLinkID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	User         User              `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	CreatedAt    time.Time         `gorm:"autoCreateTime"`
+}
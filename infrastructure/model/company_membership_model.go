@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// TODO 회사별 소속 정보 - 한 사용자가 여러 회사에 소속될 수 있음
+// user_profiles.company_id 는 로그인 시 기본으로 선택되는 대표 회사
+type CompanyMembership struct {
+	UserID     uint       `gorm:"primaryKey"`
+	CompanyID  uint       `gorm:"primaryKey;index"`
+	Role       UserRole   `gorm:"not null;default:5"` // 회사 내 역할 (3: 관리자, 4: 부관리자, 5: 일반)
+	PositionID *uint      `gorm:"default:null"`
+	EntryDate  *time.Time `gorm:"default:null"`
+	User       User       `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Company    Company    `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Position   *Position  `gorm:"foreignKey:PositionID;constraint:OnDelete:SET NULL;OnUpdate:CASCADE"`
+
+	//TODO 회사에서 정의한 역할 (없으면 Role 기준 권한만)
+	CustomRoleID *uint        `gorm:"default:null;index"`
+	CustomRole   *CompanyRole `gorm:"foreignKey:CustomRoleID;constraint:OnDelete:SET NULL;OnUpdate:CASCADE"`
+	CreatedAt    time.Time    `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time    `gorm:"autoUpdateTime"`
+
+	//TODO 회사 내 상급자 (보고 라인)
+	ManagerID *uint `gorm:"default:null;index"`
+	Manager   *User `gorm:"foreignKey:ManagerID;constraint:OnDelete:SET NULL;OnUpdate:CASCADE"`
+}
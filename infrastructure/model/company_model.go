@@ -21,7 +21,13 @@ type Company struct {
 	RepresentativePostalCode  string       `json:"representative_postal_code,omitempty" gorm:"size:255" default:""`   //대표 주소 우편번호
 	IsVerified                bool         `json:"is_verified" gorm:"default:false"`                                  // 인증하게 되면 Basic 등급이 됨
 	Grade                     CompanyGrade `json:"grade,omitempty" gorm:"default:0"`                                  // 인증 받으면 Basic 등급이 됨
+	CelebrationPostEnabled    bool         `json:"celebration_post_enabled" gorm:"default:false"`                     // 생일, 입사기념일 축하 게시물 자동 작성 여부
+	StorageUsedBytes          int64        `json:"storage_used_bytes" gorm:"default:0"`                               // 업로드된 파일 총 용량 (요금제 저장 용량 제한)
 	Departments               []Department `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"` // hasmany
 	CreatedAt                 time.Time    `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt                 time.Time    `json:"updated_at"`
+
+	//TODO 회사 삭제 유예 - 영구 삭제 전까지 읽기 전용, 복구 가능
+	DeletionRequestedAt *time.Time `json:"deletion_requested_at,omitempty" gorm:"default:null"`
+	PurgeScheduledAt    *time.Time `json:"purge_scheduled_at,omitempty" gorm:"default:null;index"`
 }
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// TODO 회사별 사용자 정의 역할 - 전역 UserRole과 별개로 회사 관리자가 권한 묶음을 만들어 소속 사용자에게 부여
+type CompanyRole struct {
+	ID          uint                    `gorm:"primaryKey"`
+	CompanyID   uint                    `json:"company_id" gorm:"not null;uniqueIndex:idx_company_role_name"`
+	Company     Company                 `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Name        string                  `json:"name" gorm:"size:50;not null;uniqueIndex:idx_company_role_name"`
+	Description string                  `json:"description" gorm:"size:255"`
+	Permissions []CompanyRolePermission `gorm:"foreignKey:RoleID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	CreatedAt   time.Time               `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+}
+
+type CompanyRolePermission struct {
+	RoleID     uint   `gorm:"primaryKey"`
+	Permission string `gorm:"primaryKey;size:50"` // MANAGE_POSITIONS, MANAGE_DEPARTMENTS, MODERATE_POSTS, VIEW_STATS, INVITE_MEMBERS
+}
@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+type CompanyVerificationStatus string
+
+const (
+	CompanyVerificationPending  CompanyVerificationStatus = "PENDING"
+	CompanyVerificationApproved CompanyVerificationStatus = "APPROVED"
+	CompanyVerificationRejected CompanyVerificationStatus = "REJECTED"
+)
+
+// TODO 회사 인증 요청 - 회사 관리자가 사업자등록 서류 제출, 운영자가 승인/반려
+type CompanyVerification struct {
+	ID          uint                          `gorm:"primaryKey"`
+	CompanyID   uint                          `json:"company_id" gorm:"not null;index"`
+	Company     Company                       `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	RequesterID uint                          `json:"requester_id" gorm:"not null"`
+	Requester   User                          `gorm:"foreignKey:RequesterID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	CpNumber    string                        `json:"cp_number" gorm:"size:255;not null"`                     // 제출한 사업자등록번호
+	Status      CompanyVerificationStatus     `json:"status" gorm:"size:20;not null;default:'PENDING';index"` // PENDING, APPROVED, REJECTED
+	Reason      string                        `json:"reason,omitempty" gorm:"type:text"`                      // 반려 사유
+	ReviewerID  *uint                         `json:"reviewer_id,omitempty" gorm:"default:null"`
+	Reviewer    *User                         `gorm:"foreignKey:ReviewerID;constraint:OnDelete:SET NULL;OnUpdate:CASCADE"`
+	ReviewedAt  *time.Time                    `json:"reviewed_at,omitempty"`
+	Documents   []CompanyVerificationDocument `gorm:"foreignKey:VerificationID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	CreatedAt   time.Time                     `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time                     `json:"updated_at"`
+}
+
+type CompanyVerificationDocument struct {
+	ID             uint      `gorm:"primaryKey"`
+	VerificationID uint      `gorm:"not null;index"`
+	FileURL        string    `gorm:"size:255;not null"`
+	FileName       string    `gorm:"size:255"` // 업로드 당시 원본 파일명
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+}
@@ -6,13 +6,15 @@ import (
 
 type Department struct {
 	ID   uint   `gorm:"primaryKey"`
-	Name string `gorm:"type:varchar(255);not null;unique"`
+	Name string `gorm:"type:varchar(255);not null;uniqueIndex:idx_departments_company_name,priority:2"` // 회사 내에서만 중복 불가
 	// Manager와의 관계 설정 (nullable)
-	DepartmentLeaderID *uint     `json:"department_leader_id" gorm:"default:null"`                                                 // 외래 키 nullable 설정
-	DepartmentLeader   *User     `gorm:"foreignKey:DepartmentLeaderID;default:null;constraint:OnDelete:SET NULL;OnUpdate:CASCADE"` // GORM 관계 설정 (nullable)
-	CompanyID          uint      `json:"company_id"`                                                                               // 회사에 무조건 속함
-	Company            Company   `gorm:"foreignKey:CompanyID;constraint:OnDelete:SET NULL;OnUpdate:CASCADE"`
-	CreatedAt          time.Time `json:"created_at" gorm:"autoCreateTime"` // 메시지를 보낸 시간
-	UpdatedAt          time.Time `json:"updated_at"`                       // 메시지를 보낸 시간
-	Posts              []*Post   `gorm:"many2many:post_departments;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	DepartmentLeaderID *uint       `json:"department_leader_id" gorm:"default:null"`                                                 // 외래 키 nullable 설정
+	DepartmentLeader   *User       `gorm:"foreignKey:DepartmentLeaderID;default:null;constraint:OnDelete:SET NULL;OnUpdate:CASCADE"` // GORM 관계 설정 (nullable)
+	CompanyID          uint        `json:"company_id" gorm:"uniqueIndex:idx_departments_company_name,priority:1"`                    // 회사에 무조건 속함
+	Company            Company     `gorm:"foreignKey:CompanyID;constraint:OnDelete:SET NULL;OnUpdate:CASCADE"`
+	ParentID           *uint       `json:"parent_id" gorm:"default:null;index"` // 상위 부서 (본부 -> 부서 -> 팀), null이면 최상위 부서
+	Parent             *Department `gorm:"foreignKey:ParentID;constraint:OnDelete:SET NULL;OnUpdate:CASCADE"`
+	CreatedAt          time.Time   `json:"created_at" gorm:"autoCreateTime"` // 메시지를 보낸 시간
+	UpdatedAt          time.Time   `json:"updated_at"`                       // 메시지를 보낸 시간
+	Posts              []*Post     `gorm:"many2many:post_departments;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
 }
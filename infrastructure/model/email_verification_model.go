@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// TODO 이메일 소유 확인 - 메일로 보낸 토큰을 확인해야 이메일 도메인으로 회사에 가입 가능
+// 사용자마다 하나만 유지하고 재발송 시 토큰을 교체함
+type EmailVerification struct {
+	ID         uint       `gorm:"primaryKey"`
+	UserID     uint       `json:"user_id" gorm:"not null;uniqueIndex"`
+	User       User       `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Email      string     `json:"email" gorm:"size:255;not null"` // 토큰을 보낸 주소 - 이메일이 바뀌면 다시 확인 필요
+	Token      string     `json:"-" gorm:"size:64;not null;uniqueIndex"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"not null"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty" gorm:"default:null"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
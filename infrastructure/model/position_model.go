@@ -10,4 +10,7 @@ type Position struct {
 	Company   Company   `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
 	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	//TODO 직급 순위 - 숫자가 클수록 높은 직급 (0은 순위 없음)
+	Rank int `gorm:"not null;default:0"`
 }
@@ -22,4 +22,8 @@ type Post struct {
 	Likes       []*Like       `gorm:"polymorphic:Target;polymorphicValue:post;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
 	PostImages  []*PostImage  `gorm:"foreignKey:PostID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
 	Departments []*Department `gorm:"many2many:post_departments;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+
+	//TODO 팀 공개 게시물 - 팀이 삭제되면 같이 삭제
+	TeamID *uint `json:"team_id" gorm:"default:null;index"`
+	Team   *Team `gorm:"foreignKey:TeamID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
 }
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// TODO 예약 메시지 - 전송 시각이 되면 스케줄러가 SaveMessage로 전송 (mongo에는 전송 후 저장)
+type ScheduledChatMessage struct {
+	ID              uint      `gorm:"primaryKey"`
+	SenderID        uint      `json:"sender_id" gorm:"not null;index"`
+	Sender          User      `gorm:"foreignKey:SenderID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	ChatRoomID      uint      `json:"chat_room_id" gorm:"not null;index"`
+	ChatRoom        ChatRoom  `gorm:"foreignKey:ChatRoomID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Content         string    `json:"content" gorm:"type:text;not null"`
+	ParentMessageID string    `json:"parent_message_id" gorm:"size:24;default:''"`
+	ShowInRoom      bool      `json:"show_in_room" gorm:"not null;default:false"`
+	SendAt          time.Time `json:"send_at" gorm:"not null;index:idx_scheduled_chat_messages_status_send_at,priority:2"`
+	//TODO pending(대기), sending(전송 중), sent(전송 완료), canceled(취소), failed(실패)
+	Status        string     `json:"status" gorm:"size:20;not null;default:'pending';index:idx_scheduled_chat_messages_status_send_at,priority:1"`
+	ChatMessageID string     `json:"chat_message_id" gorm:"size:24;default:''"` // 전송된 mongo 메시지 ID
+	FailReason    string     `json:"fail_reason" gorm:"size:255;default:''"`
+	SentAt        *time.Time `json:"sent_at" gorm:"default:null"`
+	CreatedAt     time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
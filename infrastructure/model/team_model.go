@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// TODO 팀 - 부서와 별개로 여러 부서 사람이 모이는 조직 (부서에 속할 수도 있음)
+type Team struct {
+	ID           uint         `gorm:"primaryKey"`
+	CompanyID    uint         `gorm:"not null;uniqueIndex:idx_teams_company_handle,priority:1"`
+	Company      Company      `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	DepartmentID *uint        `gorm:"default:null;index"`
+	Department   *Department  `gorm:"foreignKey:DepartmentID;constraint:OnDelete:SET NULL;OnUpdate:CASCADE"`
+	Name         string       `gorm:"size:100;not null"`
+	Handle       string       `gorm:"size:50;not null;uniqueIndex:idx_teams_company_handle,priority:2"` // @멘션용, 회사 내에서 중복 불가
+	Description  string       `gorm:"type:text"`
+	ChatRoomID   *uint        `gorm:"default:null"` // 팀 생성 시 자동으로 만들어지는 팀 채팅방
+	ChatRoom     *ChatRoom    `gorm:"foreignKey:ChatRoomID;constraint:OnDelete:SET NULL;OnUpdate:CASCADE"`
+	Members      []TeamMember `gorm:"foreignKey:TeamID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	CreatedAt    time.Time    `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time    `gorm:"autoUpdateTime"`
+}
+
+// TODO 팀 구성원 - 팀장은 여러 명 가능
+type TeamMember struct {
+	TeamID   uint      `gorm:"primaryKey"`
+	UserID   uint      `gorm:"primaryKey;index"`
+	User     User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	IsLead   bool      `gorm:"not null;default:false"`
+	JoinedAt time.Time `gorm:"autoCreateTime"`
+}
@@ -15,6 +15,13 @@ type UserProfile struct {
 	PositionID   *uint         `json:"position_id,omitempty" gorm:"default:null"`
 	Position     *Position     `json:"position,omitempty" gorm:"foreignKey:PositionID"`
 	EntryDate    time.Time     `json:"entry_date" gorm:"default:null"`
-	CreatedAt    time.Time     `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt    time.Time
+
+	//TODO 대표 회사 기준 상급자 (회사별 보고 라인은 company_memberships.manager_id)
+	ManagerID *uint `json:"manager_id,omitempty" gorm:"default:null;index"`
+	Manager   *User `json:"-" gorm:"foreignKey:ManagerID;constraint:OnDelete:SET NULL;OnUpdate:CASCADE"`
+
+	//TODO 생일, 입사기념일 공개 및 축하 알림 수신 거부
+	CelebrationOptOut bool      `json:"celebration_opt_out" gorm:"default:false"`
+	CreatedAt         time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt         time.Time
 }
@@ -112,6 +112,14 @@ func (p *BoardPersistence) GetBoardsByProjectID(projectID uint) ([]entity.Board,
 	return boardsEntity, nil
 }
 
+func (p *BoardPersistence) GetBoardCountByProjectID(projectID uint) (int64, error) {
+	var count int64
+	if err := p.db.Model(&model.Board{}).Where("project_id = ?", projectID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func (p *BoardPersistence) UpdateBoard(board *entity.Board) error {
 	if err := p.db.Model(&model.Board{}).Where("id = ?", board.ID).Updates(map[string]interface{}{
 		"title":      board.Title,
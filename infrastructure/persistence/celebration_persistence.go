@@ -0,0 +1,139 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+
+	"link/infrastructure/model"
+	"link/internal/celebration/entity"
+	"link/internal/celebration/repository"
+)
+
+type celebrationPersistence struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewCelebrationPersistence(db *gorm.DB, redis *redis.Client) repository.CelebrationRepository {
+	return &celebrationPersistence{db: db, redis: redis}
+}
+
+// TODO 소속 사용자가 있는 회사 목록 - 삭제 예정(읽기 전용) 회사 제외
+func (r *celebrationPersistence) GetCelebrationCompanyIds() ([]uint, error) {
+	var companyIds []uint
+	if err := r.db.Model(&model.UserProfile{}).
+		Where("company_id IS NOT NULL").
+		Where("company_id NOT IN (SELECT id FROM companies WHERE purge_scheduled_at IS NOT NULL)").
+		Distinct().
+		Pluck("company_id", &companyIds).Error; err != nil {
+		return nil, fmt.Errorf("회사 목록 조회 중 DB 오류: %w", err)
+	}
+	return companyIds, nil
+}
+
+// TODO 회사 사용자 중 기념일 알림을 거부하지 않은 사용자 조회
+func (r *celebrationPersistence) GetCelebrationUsers(companyId uint) ([]entity.CelebrationUser, error) {
+	var profiles []model.UserProfile
+	if err := r.db.Model(&model.UserProfile{}).
+		Preload("Departments", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id")
+		}).
+		Where("company_id = ?", companyId).
+		Where("celebration_opt_out = ?", false).
+		Where("(birthday IS NOT NULL AND birthday <> '') OR entry_date IS NOT NULL").
+		Find(&profiles).Error; err != nil {
+		return nil, fmt.Errorf("기념일 사용자 조회 중 DB 오류: %w", err)
+	}
+
+	if len(profiles) == 0 {
+		return []entity.CelebrationUser{}, nil
+	}
+
+	userIds := make([]uint, len(profiles))
+	for i, profile := range profiles {
+		userIds[i] = profile.UserID
+	}
+
+	var users []model.User
+	if err := r.db.Select("id, name").
+		Where("id IN ?", userIds).
+		Where("status = ?", "active").
+		Find(&users).Error; err != nil {
+		return nil, fmt.Errorf("기념일 사용자 조회 중 DB 오류: %w", err)
+	}
+
+	userNames := make(map[uint]string, len(users))
+	for _, user := range users {
+		userNames[user.ID] = user.Name
+	}
+
+	result := make([]entity.CelebrationUser, 0, len(profiles))
+	for _, profile := range profiles {
+		name, ok := userNames[profile.UserID]
+		if !ok {
+			continue
+		}
+
+		departmentIds := make([]uint, len(profile.Departments))
+		for i, dept := range profile.Departments {
+			departmentIds[i] = dept.ID
+		}
+
+		result = append(result, entity.CelebrationUser{
+			UserID:        profile.UserID,
+			Name:          name,
+			Image:         profile.Image,
+			Birthday:      profile.Birthday,
+			EntryDate:     profile.EntryDate,
+			DepartmentIds: departmentIds,
+		})
+	}
+
+	return result, nil
+}
+
+// TODO 부서 소속 사용자 ID 조회 (알림 거부 사용자 제외)
+func (r *celebrationPersistence) GetDepartmentMemberIds(departmentIds []uint) ([]uint, error) {
+	if len(departmentIds) == 0 {
+		return []uint{}, nil
+	}
+
+	var userIds []uint
+	if err := r.db.Table("user_profile_departments").
+		Joins("JOIN user_profiles ON user_profiles.user_id = user_profile_departments.user_profile_user_id").
+		Where("user_profile_departments.department_id IN ?", departmentIds).
+		Where("user_profiles.celebration_opt_out = ?", false).
+		Distinct().
+		Pluck("user_profile_departments.user_profile_user_id", &userIds).Error; err != nil {
+		return nil, fmt.Errorf("부서 사용자 조회 중 DB 오류: %w", err)
+	}
+	return userIds, nil
+}
+
+func (r *celebrationPersistence) IsCelebrationPostEnabled(companyId uint) (bool, error) {
+	var company model.Company
+	if err := r.db.Select("id, celebration_post_enabled").Where("id = ?", companyId).First(&company).Error; err != nil {
+		return false, fmt.Errorf("회사 조회 중 DB 오류: %w", err)
+	}
+	return company.CelebrationPostEnabled, nil
+}
+
+func (r *celebrationPersistence) UpdateCelebrationPostEnabled(companyId uint, enabled bool) error {
+	if err := r.db.Model(&model.Company{}).Where("id = ?", companyId).Update("celebration_post_enabled", enabled).Error; err != nil {
+		return fmt.Errorf("회사 기념일 설정 업데이트 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+func (r *celebrationPersistence) MarkCelebrationSent(companyId uint, date string) (bool, error) {
+	key := fmt.Sprintf("celebration:sent:%s:%d", date, companyId)
+	ok, err := r.redis.SetNX(context.Background(), key, 1, 48*time.Hour).Result()
+	if err != nil {
+		return false, fmt.Errorf("기념일 발송 기록 저장 중 오류: %w", err)
+	}
+	return ok, nil
+}
@@ -6,18 +6,22 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v8"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"link/infrastructure/model"
 	chatEntity "link/internal/chat/entity"
 	"link/internal/chat/repository"
 	userEntity "link/internal/user/entity"
+	_util "link/pkg/util"
 )
 
 type chatPersistence struct {
@@ -66,6 +70,10 @@ func (r *chatPersistence) CreateChatRoom(chatRoom *chatEntity.ChatRoom) error {
 			}
 		} else {
 			chatRoomUser.ChatRoomAlias = modelChatRoom.Name
+			//TODO 그룹 채팅방은 만든 사람이 방장
+			if *user.ID == chatRoom.OwnerID {
+				chatRoomUser.Role = chatEntity.ChatRoomRoleOwner
+			}
 		}
 
 		if err := tx.Create(&chatRoomUser).Error; err != nil {
@@ -117,7 +125,19 @@ func (r *chatPersistence) GetChatRoomList(userId uint) ([]*chatEntity.ChatRoom,
 			ID:        chatRoom.ID,
 			Name:      chatRoom.Name,
 			IsPrivate: chatRoom.IsPrivate,
+			Image:     chatRoom.Image,
 			Users:     users,
+			CreatedAt: chatRoom.CreatedAt,
+		}
+
+		//TODO 조회한 사용자의 채팅방 설정
+		for _, chatRoomUser := range chatRoom.ChatRoomUsers {
+			if chatRoomUser.UserID == userId {
+				result[i].IsMuted = chatRoomUser.IsMuted
+				result[i].ArchivedAt = chatRoomUser.ArchivedAt
+				result[i].PinnedAt = chatRoomUser.PinnedAt
+				break
+			}
 		}
 	}
 
@@ -151,34 +171,28 @@ func (r *chatPersistence) FindPrivateChatRoomByUsers(userID1, userID2 uint) (*ch
 
 // TODO 메시지 저장 - 이건 mongo에 저장
 func (r *chatPersistence) SaveMessage(chat *chatEntity.Chat) error {
-	//TODO 처음에는 모든 사용자가 읽지 않았으므로 UnreadBy에 모든 사용자를 추가하고 UnreadCount를 사용자 수와 동일하게 설정
-	//TODO postgres에서 채팅방 참여중인 사용자들 조회
-	var users []model.User
-	err := r.db.Table("chat_room_users").
-		Joins("JOIN users ON chat_room_users.user_id = users.id").
-		Where("chat_room_users.chat_room_id = ?", chat.ChatRoomID).
-		Select("users.id"). // 사용자 ID만 조회
-		Scan(&users).Error
-
+	//TODO 채팅방별 순번 발급 - 저장 후 chat.ID, chat.Seq 채움
+	seq, err := r.nextChatSequence(chat.ChatRoomID)
 	if err != nil {
-		return fmt.Errorf("채팅방 참여 중인 사용자들 조회 중 DB 오류: %w", err)
-	}
-
-	// 조회한 사용자 ID를 기반으로 UnreadBy 필드 설정
-	unreadBy := make([]uint, len(users))
-	for i, user := range users {
-		unreadBy[i] = user.ID
+		return err
 	}
 
+	//TODO 읽음 여부는 chat_room_users의 읽음 커서로 관리 - 메시지에는 저장하지 않음
 	chatModel := model.Chat{
-		Content:     chat.Content,
-		ChatRoomID:  chat.ChatRoomID,
-		SenderID:    chat.SenderID,
-		SenderName:  chat.SenderName,
-		SenderEmail: chat.SenderEmail,
-		CreatedAt:   chat.CreatedAt,
-		UnreadBy:    unreadBy,   // 모든 사용자를 UnreadBy에 추가
-		UnreadCount: len(users), // 처음엔 모든 사용자가 읽지 않았으므로 UnreadCount는 사용자 수와 동일
+		Content:      chat.Content,
+		SearchTokens: _util.SearchTokens(chat.Content),
+		ChatRoomID:   chat.ChatRoomID,
+		SenderID:     chat.SenderID,
+		SenderName:   chat.SenderName,
+		SenderEmail:  chat.SenderEmail,
+		CreatedAt:    chat.CreatedAt,
+		MessageType:  chat.MessageType,
+		ClientMsgID:  chat.ClientMsgID,
+		Seq:          seq,
+
+		ParentMessageID: chat.ParentMessageID,
+		ShowInRoom:      chat.ShowInRoom,
+		Attachments:     toChatAttachmentModels(chat.Attachments),
 	}
 
 	if chat.SenderImage != "" {
@@ -187,14 +201,105 @@ func (r *chatPersistence) SaveMessage(chat *chatEntity.Chat) error {
 
 	// MongoDB에 메시지 저장
 	collection := r.mongo.Database("link").Collection("messages")
-	_, err = collection.InsertOne(context.Background(), chatModel)
+	result, err := collection.InsertOne(context.Background(), chatModel)
 	if err != nil {
+		//TODO 같은 client_msg_id가 동시에 들어온 경우 - 먼저 저장된 메시지로 채우고 중복 오류 반환
+		if chat.ClientMsgID != "" && mongo.IsDuplicateKeyError(err) {
+			saved, findErr := r.GetChatMessageByClientMsgID(chat.ChatRoomID, chat.SenderID, chat.ClientMsgID)
+			if findErr != nil || saved == nil {
+				return fmt.Errorf("메시지 저장 중 MongoDB 오류: %w", err)
+			}
+			*chat = *saved
+			return chatEntity.ErrDuplicateChatMessage
+		}
 		return fmt.Errorf("메시지 저장 중 MongoDB 오류: %w", err)
 	}
 
+	if id, ok := result.InsertedID.(primitive.ObjectID); ok {
+		chat.ID = id.Hex()
+	}
+	chat.Seq = seq
+
 	return nil
 }
 
+// 채팅방별 메시지 순번 증가 (chat_sequences)
+func (r *chatPersistence) nextChatSequence(chatRoomID uint) (int64, error) {
+	collection := r.mongo.Database("link").Collection("chat_sequences")
+
+	var sequence model.ChatSequence
+	err := collection.FindOneAndUpdate(context.Background(),
+		bson.M{"_id": chatRoomID},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&sequence)
+	if err != nil {
+		return 0, fmt.Errorf("채팅방 메시지 순번 발급 중 MongoDB 오류: %w", err)
+	}
+	return sequence.Seq, nil
+}
+
+// TODO 클라이언트 메시지 ID로 저장된 메시지 조회 - 없으면 nil
+func (r *chatPersistence) GetChatMessageByClientMsgID(chatRoomID uint, senderID uint, clientMsgID string) (*chatEntity.Chat, error) {
+	collection := r.mongo.Database("link").Collection("messages")
+
+	var chatMessage model.Chat
+	err := collection.FindOne(context.Background(), bson.M{
+		"chat_room_id":  chatRoomID,
+		"sender_id":     senderID,
+		"client_msg_id": clientMsgID,
+	}).Decode(&chatMessage)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("클라이언트 메시지 ID로 메시지 조회 중 MongoDB 오류: %w", err)
+	}
+	return toChatEntity(&chatMessage), nil
+}
+
+// TODO 재연결 시 누락 메시지 조회 - afterSeq 다음 순번부터 오름차순 (limit+1개 조회해서 다음 페이지 여부 확인)
+func (r *chatPersistence) GetChatMessagesAfterSeq(chatRoomID uint, afterSeq int64, limit int) (*chatEntity.ChatMeta, []*chatEntity.Chat, error) {
+	if limit <= 0 {
+		limit = 100 // 기본값
+	}
+
+	filter := bson.M{
+		"chat_room_id": chatRoomID,
+		"seq":          bson.M{"$gt": afterSeq},
+		"$or":          roomVisibleMessageFilter(),
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	cursor, err := collection.Find(context.Background(), filter,
+		options.Find().SetSort(bson.M{"seq": 1}).SetLimit(int64(limit+1)),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("누락 메시지 조회 중 MongoDB 오류: %w", err)
+	}
+	defer cursor.Close(context.Background())
+
+	var chatMessages []*model.Chat
+	if err := cursor.All(context.Background(), &chatMessages); err != nil {
+		return nil, nil, fmt.Errorf("누락 메시지 디코딩 중 오류: %w", err)
+	}
+
+	hasMore := len(chatMessages) > limit
+	if hasMore {
+		chatMessages = chatMessages[:limit]
+	}
+
+	result := make([]*chatEntity.Chat, len(chatMessages))
+	for i, chatMessage := range chatMessages {
+		result[i] = toChatEntity(chatMessage)
+	}
+
+	return &chatEntity.ChatMeta{
+		PageSize: limit,
+		HasMore:  &hasMore,
+	}, result, nil
+}
+
 // TODO 채팅방 조회
 func (r *chatPersistence) GetChatRoomById(chatRoomID uint) (*chatEntity.ChatRoom, error) {
 	var chatRoom model.ChatRoom
@@ -226,6 +331,7 @@ func (r *chatPersistence) GetChatRoomById(chatRoomID uint) (*chatEntity.ChatRoom
 					"alias_name": chatRoomUser.ChatRoomAlias,
 					"joined_at":  chatRoomUser.JoinedAt,
 					"left_at":    chatRoomUser.LeftAt,
+					"role":       chatRoomUser.Role,
 				},
 			},
 		}
@@ -236,13 +342,18 @@ func (r *chatPersistence) GetChatRoomById(chatRoomID uint) (*chatEntity.ChatRoom
 		Users:     users, // 변환된 사용자 리스트 설정
 		Name:      chatRoom.Name,
 		IsPrivate: chatRoom.IsPrivate,
+		Image:     chatRoom.Image,
 	}, nil
 }
 
 // TODO 메시지 조회
 func (r *chatPersistence) GetChatMessages(chatRoomID uint, queryOptions map[string]interface{}) (*chatEntity.ChatMeta, []*chatEntity.Chat, error) {
 	collection := r.mongo.Database("link").Collection("messages")
-	filter := bson.M{"chat_room_id": chatRoomID}
+	//TODO 스레드 답글은 채팅방에도 표시하도록 보낸 경우만 포함
+	filter := bson.M{
+		"chat_room_id": chatRoomID,
+		"$or":          roomVisibleMessageFilter(),
+	}
 
 	limit, ok := queryOptions["limit"].(int)
 	if !ok || limit <= 0 {
@@ -271,6 +382,7 @@ func (r *chatPersistence) GetChatMessages(chatRoomID uint, queryOptions map[stri
 				{
 					"$match": bson.M{
 						"chat_room_id": chatRoomID,
+						"$or":          filter["$or"],
 						"created_at":   bson.M{"$lt": primitive.NewDateTimeFromTime(parsedTime.UTC())},
 					},
 				},
@@ -361,6 +473,18 @@ func (r *chatPersistence) GetChatMessages(chatRoomID uint, queryOptions map[stri
 			SenderEmail: chatMessage.SenderEmail,
 			SenderImage: senderImage,
 			CreatedAt:   chatMessage.CreatedAt,
+			EditedAt:    chatMessage.EditedAt,
+			MessageType: chatMessage.MessageType,
+			PinnedAt:    chatMessage.PinnedAt,
+			PinnedBy:    chatMessage.PinnedBy,
+			ClientMsgID: chatMessage.ClientMsgID,
+			Seq:         chatMessage.Seq,
+
+			ParentMessageID: chatMessage.ParentMessageID,
+			ReplyCount:      chatMessage.ReplyCount,
+			LastReply:       toChatLastReplyEntity(chatMessage.LastReply),
+			Reactions:       toChatReactionEntities(chatMessage.Reactions),
+			Attachments:     toChatAttachmentEntities(chatMessage.Attachments),
 		}
 	}
 
@@ -370,6 +494,7 @@ func (r *chatPersistence) GetChatMessages(chatRoomID uint, queryOptions map[stri
 		oldestTime := entityChatMessages[0].CreatedAt
 		olderCount, err := collection.CountDocuments(context.Background(), bson.M{
 			"chat_room_id": chatRoomID,
+			"$or":          filter["$or"],
 			"created_at":   bson.M{"$lt": oldestTime},
 		})
 		if err != nil {
@@ -433,169 +558,1506 @@ func (r *chatPersistence) DeleteChatMessage(senderID uint, chatRoomID uint, chat
 	return nil
 }
 
-// TODO 레디스 관련
-func (r *chatPersistence) SetChatRoomToRedis(roomId uint, chatRoomInfo map[string]interface{}) error {
-	//json으로 변환
-	chatRoomJson, err := json.Marshal(chatRoomInfo)
+// TODO 메시지 단건 조회 - 수정 이력 포함
+func (r *chatPersistence) GetChatMessageById(chatMessageID string) (*chatEntity.Chat, error) {
+	chatMessageIDObject, err := primitive.ObjectIDFromHex(chatMessageID)
 	if err != nil {
-		return fmt.Errorf("채팅방 직렬화 중 오류: %w", err)
+		return nil, fmt.Errorf("채팅 메시지 ID 변환 중 오류: %w", err)
 	}
 
-	r.redis.Set(context.Background(), fmt.Sprintf("chatroom:%d", roomId), chatRoomJson, 0)
+	var chatMessage model.Chat
+	collection := r.mongo.Database("link").Collection("messages")
+	if err := collection.FindOne(context.Background(), bson.M{"_id": chatMessageIDObject}).Decode(&chatMessage); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("채팅 메시지를 찾을 수 없습니다")
+		}
+		return nil, fmt.Errorf("채팅 메시지 조회 중 MongoDB 오류: %w", err)
+	}
 
-	return nil
-}
+	editHistory := make([]*chatEntity.ChatEditHistory, len(chatMessage.EditHistory))
+	for i, history := range chatMessage.EditHistory {
+		editHistory[i] = &chatEntity.ChatEditHistory{
+			Content:  history.Content,
+			EditedAt: history.EditedAt,
+		}
+	}
 
-func (r *chatPersistence) GetChatRoomByIdFromRedis(roomId uint) (*chatEntity.ChatRoom, error) {
+	return &chatEntity.Chat{
+		ID:          chatMessage.ID.Hex(),
+		Content:     chatMessage.Content,
+		ChatRoomID:  chatMessage.ChatRoomID,
+		SenderID:    chatMessage.SenderID,
+		SenderName:  chatMessage.SenderName,
+		SenderEmail: chatMessage.SenderEmail,
+		SenderImage: chatMessage.SenderImage,
+		CreatedAt:   chatMessage.CreatedAt,
+		EditedAt:    chatMessage.EditedAt,
+		EditHistory: editHistory,
+		MessageType: chatMessage.MessageType,
+		PinnedAt:    chatMessage.PinnedAt,
+		PinnedBy:    chatMessage.PinnedBy,
+		ClientMsgID: chatMessage.ClientMsgID,
+		Seq:         chatMessage.Seq,
+
+		ParentMessageID: chatMessage.ParentMessageID,
+		ShowInRoom:      chatMessage.ShowInRoom,
+		ReplyCount:      chatMessage.ReplyCount,
+		LastReply:       toChatLastReplyEntity(chatMessage.LastReply),
+		Reactions:       toChatReactionEntities(chatMessage.Reactions),
+		Attachments:     toChatAttachmentEntities(chatMessage.Attachments),
+	}, nil
+}
 
-	//redis에서 조회
-	chatRoomJson, err := r.redis.Get(context.Background(), fmt.Sprintf("chatroom:%d", roomId)).Result()
+// TODO 메시지 수정 - 이전 내용은 edit_history에 보관
+func (r *chatPersistence) UpdateChatMessage(chatMessageID string, prevContent string, content string, editedAt time.Time) error {
+	chatMessageIDObject, err := primitive.ObjectIDFromHex(chatMessageID)
 	if err != nil {
-		return nil, fmt.Errorf("채팅방 조회 중 Redis 오류: %w", err)
+		return fmt.Errorf("채팅 메시지 ID 변환 중 오류: %w", err)
 	}
 
-	//역직렬화
-	var chatRoom chatEntity.ChatRoom
-	err = json.Unmarshal([]byte(chatRoomJson), &chatRoom)
+	collection := r.mongo.Database("link").Collection("messages")
+	//TODO 조회 이후 다른 요청이 먼저 수정한 경우 이력이 꼬이지 않도록 이전 내용까지 일치할 때만 수정
+	filter := bson.M{"_id": chatMessageIDObject, "content": prevContent}
+	update := bson.M{
+		"$set": bson.M{"content": content, "search_tokens": _util.SearchTokens(content), "edited_at": editedAt},
+		"$push": bson.M{"edit_history": model.ChatEditHistory{
+			Content:  prevContent,
+			EditedAt: editedAt,
+		}},
+	}
+
+	result, err := collection.UpdateOne(context.Background(), filter, update)
 	if err != nil {
-		return nil, fmt.Errorf("채팅방 역직렬화 중 오류: %w", err)
+		return fmt.Errorf("채팅 메시지 수정 중 MongoDB 오류: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("채팅 메시지가 이미 변경되었습니다")
 	}
 
-	return &chatRoom, nil
+	return nil
 }
 
-// TODO 채팅방 나가기
-func (r *chatPersistence) LeaveChatRoom(userId uint, chatRoomId uint) error {
-	tx := r.db.Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("채팅방 나가기 중 DB 오류: %w", tx.Error)
+// TODO 스레드 답글 조회 - 오래된 순, created_at 커서 이후
+func (r *chatPersistence) GetThreadReplies(chatRoomID uint, parentMessageID string, queryOptions map[string]interface{}) (*chatEntity.ChatMeta, []*chatEntity.Chat, error) {
+	collection := r.mongo.Database("link").Collection("messages")
+	filter := bson.M{"chat_room_id": chatRoomID, "parent_message_id": parentMessageID}
+
+	limit, ok := queryOptions["limit"].(int)
+	if !ok || limit <= 0 {
+		limit = 20 // 기본값
 	}
 
-	// err := tx.Delete(&model.ChatRoomUser{}, "user_id = ? AND chat_room_id = ?", userId, chatRoomId).Error
-	// if err != nil {
-	// 	tx.Rollback()
-	// 	return fmt.Errorf("채팅방 나가기 중 DB 오류: %w", err)
-	// }
-	//TODO 삭제가 아니라 leftAt을 현재 시간으로 설정, JoinedAt은 null로 설정
-	err := tx.Model(&model.ChatRoomUser{}).
-		Where("user_id = ? AND chat_room_id = ?", userId, chatRoomId).
-		Update("left_at", time.Now()).
-		Update("joined_at", nil).Error
+	match := bson.M{"chat_room_id": chatRoomID, "parent_message_id": parentMessageID}
+	if cursor, ok := queryOptions["cursor"].(map[string]interface{}); ok {
+		if createdAt, exists := cursor["created_at"].(string); exists && createdAt != "" {
+			parsedTime, err := time.Parse(time.RFC3339Nano, createdAt)
+			if err != nil {
+				parsedTime, err = time.Parse("2006-01-02 15:04:05.999999999", createdAt)
+				if err != nil {
+					return nil, nil, fmt.Errorf("cursor 시간 파싱 실패: %w", err)
+				}
+			}
+			match["created_at"] = bson.M{"$gt": primitive.NewDateTimeFromTime(parsedTime.UTC())}
+		}
+	}
+
+	//TODO limit+1개 조회해서 다음 페이지 여부 판단
+	findOptions := options.Find().
+		SetSort(bson.M{"created_at": 1}).
+		SetLimit(int64(limit + 1))
+
+	cursor, err := collection.Find(context.Background(), match, findOptions)
 	if err != nil {
-		tx.Rollback()
-		return fmt.Errorf("채팅방 나가기 중 DB 오류: %w", err)
+		return nil, nil, fmt.Errorf("스레드 답글 조회 중 MongoDB 오류: %w", err)
 	}
+	defer cursor.Close(context.Background())
 
-	if err := tx.Commit().Error; err != nil {
-		return fmt.Errorf("트랜잭션 커밋 실패: %w", err)
+	var replies []model.Chat
+	if err = cursor.All(context.Background(), &replies); err != nil {
+		return nil, nil, fmt.Errorf("MongoDB 커서 처리 중 오류: %w", err)
 	}
 
-	return nil
-}
+	hasMore := len(replies) > limit
+	if hasMore {
+		replies = replies[:limit]
+	}
 
-// TODO 채팅방 사용자 추가
-// TODO 채팅방에 사용자 있는지 확인
-func (r *chatPersistence) IsUserInChatRoom(userId uint, chatRoomId uint) bool {
-	var chatRoomUser model.ChatRoomUser
+	totalCount, err := collection.CountDocuments(context.Background(), filter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("스레드 답글 카운트 조회 중 MongoDB 오류: %w", err)
+	}
 
-	// 채팅방에 사용자가 있는지 확인 (joined_at이 있고 left_at이 NULL인 경우)
-	err := r.db.
-		Where("user_id = ? AND chat_room_id = ?", userId, chatRoomId).
-		Where("joined_at IS NOT NULL AND left_at IS NULL").
-		First(&chatRoomUser).Error
+	entityReplies := make([]*chatEntity.Chat, len(replies))
+	for i, reply := range replies {
+		entityReplies[i] = &chatEntity.Chat{
+			ID:              reply.ID.Hex(),
+			Content:         reply.Content,
+			ChatRoomID:      reply.ChatRoomID,
+			SenderID:        reply.SenderID,
+			SenderName:      reply.SenderName,
+			SenderEmail:     reply.SenderEmail,
+			SenderImage:     reply.SenderImage,
+			CreatedAt:       reply.CreatedAt,
+			EditedAt:        reply.EditedAt,
+			ParentMessageID: reply.ParentMessageID,
+			ShowInRoom:      reply.ShowInRoom,
+			Reactions:       toChatReactionEntities(reply.Reactions),
+			Attachments:     toChatAttachmentEntities(reply.Attachments),
+		}
+	}
 
-	// 에러가 ErrRecordNotFound인 경우 나간사람임
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return false
+	var nextCursor string
+	if hasMore && len(entityReplies) > 0 {
+		nextCursor = entityReplies[len(entityReplies)-1].CreatedAt.Format(time.RFC3339Nano)
 	}
-	return err == nil
+
+	return &chatEntity.ChatMeta{
+		TotalCount: int(totalCount),
+		TotalPages: int(math.Ceil(float64(totalCount) / float64(limit))),
+		PageSize:   limit,
+		NextCursor: nextCursor,
+		HasMore:    &hasMore,
+	}, entityReplies, nil
 }
 
-// TODO 1:1 채팅방인지 확인
-func (r *chatPersistence) IsPrivateChatRoom(chatRoomId uint) bool {
-	var chatRoom model.ChatRoom
+// TODO 첨부파일이 있는 메시지 조회 - 최신순, created_at 커서 이전
+func (r *chatPersistence) GetChatAttachmentMessages(chatRoomID uint, queryOptions map[string]interface{}) (*chatEntity.ChatMeta, []*chatEntity.Chat, error) {
+	collection := r.mongo.Database("link").Collection("messages")
+	filter := bson.M{"chat_room_id": chatRoomID, "attachments.0": bson.M{"$exists": true}}
 
-	// 1:1 채팅방인지 확인
-	err := r.db.
-		Where("id = ? AND is_private = ?", chatRoomId, true).
-		First(&chatRoom).Error
+	limit, ok := queryOptions["limit"].(int)
+	if !ok || limit <= 0 {
+		limit = 20 // 기본값
+	}
 
-	// 에러가 ErrRecordNotFound인 경우 false 반환, 그 외에는 true
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return false
+	match := bson.M{"chat_room_id": chatRoomID, "attachments.0": bson.M{"$exists": true}}
+	if cursor, ok := queryOptions["cursor"].(map[string]interface{}); ok {
+		if createdAt, exists := cursor["created_at"].(string); exists && createdAt != "" {
+			parsedTime, err := time.Parse(time.RFC3339Nano, createdAt)
+			if err != nil {
+				parsedTime, err = time.Parse("2006-01-02 15:04:05.999999999", createdAt)
+				if err != nil {
+					return nil, nil, fmt.Errorf("cursor 시간 파싱 실패: %w", err)
+				}
+			}
+			match["created_at"] = bson.M{"$lt": primitive.NewDateTimeFromTime(parsedTime.UTC())}
+		}
 	}
-	return err == nil
-}
 
-// TODO 1:1 채팅방에 혼자만 있는 경우
-func (r *chatPersistence) AddUserToPrivateChatRoom(requestUserId uint, targetUserId uint, chatRoomId uint) error {
-	tx := r.db.Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("채팅방 사용자 추가 중 DB 오류: %w", tx.Error)
+	//TODO limit+1개 조회해서 다음 페이지 여부 판단
+	findOptions := options.Find().
+		SetSort(bson.M{"created_at": -1}).
+		SetLimit(int64(limit + 1))
+
+	cursor, err := collection.Find(context.Background(), match, findOptions)
+	if err != nil {
+		return nil, nil, fmt.Errorf("첨부파일 메시지 조회 중 MongoDB 오류: %w", err)
 	}
+	defer cursor.Close(context.Background())
 
-	chatRoom, err := r.GetChatRoomById(chatRoomId)
+	var chatMessages []model.Chat
+	if err = cursor.All(context.Background(), &chatMessages); err != nil {
+		return nil, nil, fmt.Errorf("MongoDB 커서 처리 중 오류: %w", err)
+	}
+
+	hasMore := len(chatMessages) > limit
+	if hasMore {
+		chatMessages = chatMessages[:limit]
+	}
+
+	totalCount, err := collection.CountDocuments(context.Background(), filter)
 	if err != nil {
-		tx.Rollback() // 트랜잭션 롤백 추가
-		return fmt.Errorf("채팅방 조회 중 DB 오류: %w", err)
+		return nil, nil, fmt.Errorf("첨부파일 메시지 카운트 조회 중 MongoDB 오류: %w", err)
 	}
 
-	//TODO 채팅방 이름도 변경
-	if chatRoom.IsPrivate && len(chatRoom.Users) == 2 {
-		// 채팅방 업데이트
-		if err := tx.Model(&model.ChatRoom{}).
-			Where("id = ?", chatRoomId).
-			Updates(map[string]interface{}{
-				"is_private": false,
-				"name":       fmt.Sprintf("%s님 외 %d명", *chatRoom.Users[0].Name, len(chatRoom.Users)),
-			}).Error; err != nil {
-			tx.Rollback() // 트랜잭션 롤백 추가
-			return fmt.Errorf("채팅방 업데이트 중 DB 오류: %w", err)
+	entityChatMessages := make([]*chatEntity.Chat, len(chatMessages))
+	for i, chatMessage := range chatMessages {
+		entityChatMessages[i] = &chatEntity.Chat{
+			ID:              chatMessage.ID.Hex(),
+			Content:         chatMessage.Content,
+			ChatRoomID:      chatMessage.ChatRoomID,
+			SenderID:        chatMessage.SenderID,
+			SenderName:      chatMessage.SenderName,
+			SenderEmail:     chatMessage.SenderEmail,
+			SenderImage:     chatMessage.SenderImage,
+			CreatedAt:       chatMessage.CreatedAt,
+			ParentMessageID: chatMessage.ParentMessageID,
+			ShowInRoom:      chatMessage.ShowInRoom,
+			Attachments:     toChatAttachmentEntities(chatMessage.Attachments),
 		}
+	}
 
-		if err := tx.Model(&model.ChatRoomUser{}).
-			Where("user_id = ? AND chat_room_id = ?", targetUserId, chatRoomId).
-			Updates(map[string]interface{}{
-				"joined_at":       time.Now(),
-				"left_at":         nil,
-				"chat_room_alias": fmt.Sprintf("%s님 외 %d명", *chatRoom.Users[0].Name, len(chatRoom.Users)),
-			}).Error; err != nil {
-			tx.Rollback() // 트랜잭션 롤백 추가
-			return fmt.Errorf("채팅방 사용자 업데이트 중 DB 오류: %w", err)
-		}
+	var nextCursor string
+	if hasMore && len(entityChatMessages) > 0 {
+		nextCursor = entityChatMessages[len(entityChatMessages)-1].CreatedAt.Format(time.RFC3339Nano)
+	}
+
+	return &chatEntity.ChatMeta{
+		TotalCount: int(totalCount),
+		TotalPages: int(math.Ceil(float64(totalCount) / float64(limit))),
+		PageSize:   limit,
+		NextCursor: nextCursor,
+		HasMore:    &hasMore,
+	}, entityChatMessages, nil
+}
 
+// TODO 채팅 검색 - 참여 중인 채팅방의 메시지, 최신순 (페이지네이션)
+// 한글이 포함된 검색어는 조사가 붙은 단어도 찾도록 부분 일치, 그 외는 텍스트 인덱스 사용
+func (r *chatPersistence) SearchChatMessages(chatRoomIDs []uint, queryOptions map[string]interface{}) (*chatEntity.ChatMeta, []*chatEntity.Chat, error) {
+	collection := r.mongo.Database("link").Collection("messages")
+
+	limit, ok := queryOptions["limit"].(int)
+	if !ok || limit <= 0 {
+		limit = 20 // 기본값
 	}
 
-	if err := tx.Commit().Error; err != nil {
-		return fmt.Errorf("트랜잭션 커밋 실패: %w", err)
+	page, ok := queryOptions["page"].(int)
+	if !ok || page <= 0 {
+		page = 1 // 기본값
 	}
 
-	return nil
-}
+	filter := bson.M{"chat_room_id": bson.M{"$in": chatRoomIDs}}
 
-// TODO 그룹 채팅방 사용자 추가
-func (r *chatPersistence) AddUserToGroupChatRoom(requestUserId uint, targetUserId uint, chatRoomId uint) error {
-	tx := r.db.Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("채팅방 사용자 추가 중 DB 오류: %w", tx.Error)
+	//TODO $text로 토큰 중 하나라도 있는 메시지를 인덱스에서 찾고, $all로 모든 토큰을 포함한 메시지만 남김
+	query, _ := queryOptions["query"].(string)
+	tokens := _util.SearchQueryTokens(query)
+	if len(tokens) == 0 {
+		hasMore := false
+		return &chatEntity.ChatMeta{PageSize: limit, HasMore: &hasMore}, []*chatEntity.Chat{}, nil
 	}
+	filter["$text"] = bson.M{"$search": strings.Join(tokens, " ")}
+	filter["search_tokens"] = bson.M{"$all": tokens}
 
-	chatRoom, err := r.GetChatRoomById(chatRoomId)
+	if senderID, ok := queryOptions["sender_id"].(uint); ok && senderID > 0 {
+		filter["sender_id"] = senderID
+	}
+
+	createdAt := bson.M{}
+	if from, ok := queryOptions["from"].(time.Time); ok {
+		createdAt["$gte"] = primitive.NewDateTimeFromTime(from.UTC())
+	}
+	if to, ok := queryOptions["to"].(time.Time); ok {
+		createdAt["$lt"] = primitive.NewDateTimeFromTime(to.UTC())
+	}
+	if len(createdAt) > 0 {
+		filter["created_at"] = createdAt
+	}
+
+	findOptions := options.Find().
+		SetSort(bson.M{"created_at": -1}).
+		SetSkip(int64((page - 1) * limit)).
+		SetLimit(int64(limit))
+
+	cursor, err := collection.Find(context.Background(), filter, findOptions)
 	if err != nil {
-		tx.Rollback()
-		return fmt.Errorf("채팅방 조회 중 DB 오류: %w", err)
+		return nil, nil, fmt.Errorf("채팅 검색 중 MongoDB 오류: %w", err)
 	}
+	defer cursor.Close(context.Background())
 
-	if chatRoom.IsPrivate {
-		tx.Rollback()
-		return fmt.Errorf("채팅방이 1:1 채팅방입니다")
+	var chatMessages []model.Chat
+	if err = cursor.All(context.Background(), &chatMessages); err != nil {
+		return nil, nil, fmt.Errorf("MongoDB 커서 처리 중 오류: %w", err)
 	}
 
-	if err := tx.Commit().Error; err != nil {
-		return fmt.Errorf("트랜잭션 커밋 실패: %w", err)
+	totalCount, err := collection.CountDocuments(context.Background(), filter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("채팅 검색 카운트 조회 중 MongoDB 오류: %w", err)
 	}
 
-	return nil
-}
+	entityChatMessages := make([]*chatEntity.Chat, len(chatMessages))
+	for i, chatMessage := range chatMessages {
+		entityChatMessages[i] = toChatEntity(&chatMessage)
+	}
 
-//TODO 그룹 채팅방일 때 초대하면 추가
+	totalPages := int(math.Ceil(float64(totalCount) / float64(limit)))
+	hasMore := page < totalPages
+
+	return &chatEntity.ChatMeta{
+		TotalCount: int(totalCount),
+		TotalPages: totalPages,
+		PageSize:   limit,
+		HasMore:    &hasMore,
+		PrevPage:   page - 1,
+		NextPage:   page + 1,
+	}, entityChatMessages, nil
+}
+
+// TODO 메시지 앞뒤 채팅 조회 - 검색 결과로 이동할 때 사용, 각각 오래된 순
+// 앞뒤로 1개씩 더 조회해서 더 있는지 판단할 수 있도록 반환
+func (r *chatPersistence) GetChatMessagesAround(anchor *chatEntity.Chat, before int, after int) ([]*chatEntity.Chat, []*chatEntity.Chat, error) {
+	collection := r.mongo.Database("link").Collection("messages")
+	anchorTime := primitive.NewDateTimeFromTime(anchor.CreatedAt.UTC())
+
+	find := func(createdAt bson.M, sort int, limit int) ([]*chatEntity.Chat, error) {
+		cursor, err := collection.Find(context.Background(), bson.M{
+			"chat_room_id": anchor.ChatRoomID,
+			"$or":          roomVisibleMessageFilter(),
+			"created_at":   createdAt,
+		}, options.Find().SetSort(bson.M{"created_at": sort}).SetLimit(int64(limit+1)))
+		if err != nil {
+			return nil, fmt.Errorf("주변 메시지 조회 중 MongoDB 오류: %w", err)
+		}
+		defer cursor.Close(context.Background())
+
+		var chatMessages []model.Chat
+		if err = cursor.All(context.Background(), &chatMessages); err != nil {
+			return nil, fmt.Errorf("MongoDB 커서 처리 중 오류: %w", err)
+		}
+
+		result := make([]*chatEntity.Chat, len(chatMessages))
+		for i, chatMessage := range chatMessages {
+			result[i] = toChatEntity(&chatMessage)
+		}
+		return result, nil
+	}
+
+	beforeMessages, err := find(bson.M{"$lt": anchorTime}, -1, before)
+	if err != nil {
+		return nil, nil, err
+	}
+	// 최신순으로 조회했으므로 오래된 순으로 뒤집기
+	for i, j := 0, len(beforeMessages)-1; i < j; i, j = i+1, j-1 {
+		beforeMessages[i], beforeMessages[j] = beforeMessages[j], beforeMessages[i]
+	}
+
+	afterMessages, err := find(bson.M{"$gt": anchorTime}, 1, after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return beforeMessages, afterMessages, nil
+}
+
+// TODO 스레드 답글 추가 시 원본 메시지의 답글 수, 마지막 답글 갱신 후 답글 수 반환
+func (r *chatPersistence) AddThreadReply(parentMessageID string, lastReply *chatEntity.ChatLastReply) (int, error) {
+	parentMessageIDObject, err := primitive.ObjectIDFromHex(parentMessageID)
+	if err != nil {
+		return 0, fmt.Errorf("채팅 메시지 ID 변환 중 오류: %w", err)
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	update := bson.M{
+		"$inc": bson.M{"reply_count": 1},
+		"$set": bson.M{"last_reply": model.ChatLastReply{
+			SenderID:   lastReply.SenderID,
+			SenderName: lastReply.SenderName,
+			Content:    lastReply.Content,
+			CreatedAt:  lastReply.CreatedAt,
+		}},
+	}
+
+	var parent model.Chat
+	err = collection.FindOneAndUpdate(context.Background(),
+		bson.M{"_id": parentMessageIDObject},
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&parent)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return 0, fmt.Errorf("채팅 메시지를 찾을 수 없습니다")
+		}
+		return 0, fmt.Errorf("스레드 답글 수 갱신 중 MongoDB 오류: %w", err)
+	}
+
+	return parent.ReplyCount, nil
+}
+
+// TODO 채팅방 메시지 고정 - 채팅방 행을 잠가 같은 채팅방의 고정 요청을 순서대로 처리 (개수 확인과 고정 사이에 다른 고정이 끼지 않도록)
+func (r *chatPersistence) PinChatMessage(chatRoomID uint, chatMessageID string, userId uint, pinnedAt time.Time, maxPinned int) error {
+	chatMessageIDObject, err := primitive.ObjectIDFromHex(chatMessageID)
+	if err != nil {
+		return fmt.Errorf("채팅 메시지 ID 변환 중 오류: %w", err)
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var chatRoom model.ChatRoom
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id").Where("id = ?", chatRoomID).First(&chatRoom).Error; err != nil {
+			return fmt.Errorf("채팅방 잠금 중 DB 오류: %w", err)
+		}
+
+		pinnedCount, err := collection.CountDocuments(context.Background(),
+			bson.M{"chat_room_id": chatRoomID, "pinned_at": bson.M{"$exists": true}})
+		if err != nil {
+			return fmt.Errorf("고정 메시지 수 조회 중 MongoDB 오류: %w", err)
+		}
+		if pinnedCount >= int64(maxPinned) {
+			return chatEntity.ErrChatPinLimitExceeded
+		}
+
+		result, err := collection.UpdateOne(context.Background(),
+			bson.M{"_id": chatMessageIDObject, "chat_room_id": chatRoomID, "pinned_at": bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{"pinned_at": pinnedAt, "pinned_by": userId}},
+		)
+		if err != nil {
+			return fmt.Errorf("채팅 메시지 고정 중 MongoDB 오류: %w", err)
+		}
+		if result.MatchedCount == 0 {
+			return chatEntity.ErrChatMessageAlreadyPinned
+		}
+		return nil
+	})
+}
+
+// TODO 채팅방 메시지 고정 해제
+func (r *chatPersistence) UnpinChatMessage(chatMessageID string) error {
+	chatMessageIDObject, err := primitive.ObjectIDFromHex(chatMessageID)
+	if err != nil {
+		return fmt.Errorf("채팅 메시지 ID 변환 중 오류: %w", err)
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	_, err = collection.UpdateOne(context.Background(),
+		bson.M{"_id": chatMessageIDObject},
+		bson.M{"$unset": bson.M{"pinned_at": "", "pinned_by": ""}},
+	)
+	if err != nil {
+		return fmt.Errorf("채팅 메시지 고정 해제 중 MongoDB 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO 채팅방 고정 메시지 조회 - 최근에 고정한 순
+func (r *chatPersistence) GetPinnedChatMessages(chatRoomID uint) ([]*chatEntity.Chat, error) {
+	collection := r.mongo.Database("link").Collection("messages")
+	cursor, err := collection.Find(context.Background(),
+		bson.M{"chat_room_id": chatRoomID, "pinned_at": bson.M{"$exists": true}},
+		options.Find().SetSort(bson.M{"pinned_at": -1}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("고정 메시지 조회 중 MongoDB 오류: %w", err)
+	}
+	defer cursor.Close(context.Background())
+
+	var chatMessages []model.Chat
+	if err = cursor.All(context.Background(), &chatMessages); err != nil {
+		return nil, fmt.Errorf("MongoDB 커서 처리 중 오류: %w", err)
+	}
+
+	result := make([]*chatEntity.Chat, len(chatMessages))
+	for i, chatMessage := range chatMessages {
+		result[i] = toChatEntity(&chatMessage)
+	}
+	return result, nil
+}
+
+// TODO 메시지 북마크 - 이미 북마크한 메시지면 무시
+func (r *chatPersistence) CreateChatBookmark(userId uint, chatRoomID uint, chatMessageID string) error {
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.ChatBookmark{
+		UserID:        userId,
+		ChatRoomID:    chatRoomID,
+		ChatMessageID: chatMessageID,
+	}).Error; err != nil {
+		return fmt.Errorf("북마크 저장 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO 메시지 북마크 해제
+func (r *chatPersistence) DeleteChatBookmark(userId uint, chatMessageID string) error {
+	if err := r.db.
+		Where("user_id = ? AND chat_message_id = ?", userId, chatMessageID).
+		Delete(&model.ChatBookmark{}).Error; err != nil {
+		return fmt.Errorf("북마크 삭제 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO 북마크 목록 - 참여 중인 채팅방만, 최근에 저장한 순 (페이지네이션)
+func (r *chatPersistence) GetChatBookmarks(userId uint, queryOptions map[string]interface{}) (*chatEntity.ChatMeta, []*chatEntity.ChatBookmark, error) {
+	limit, ok := queryOptions["limit"].(int)
+	if !ok || limit <= 0 {
+		limit = 20 // 기본값
+	}
+
+	page, ok := queryOptions["page"].(int)
+	if !ok || page <= 0 {
+		page = 1 // 기본값
+	}
+
+	// Count 이후 같은 쿼리를 재사용하지 않도록 매번 새로 생성
+	query := func() *gorm.DB {
+		return r.db.Table("chat_bookmarks").
+			Joins("JOIN chat_rooms ON chat_rooms.id = chat_bookmarks.chat_room_id").
+			Joins("JOIN chat_room_users ON chat_room_users.chat_room_id = chat_bookmarks.chat_room_id AND chat_room_users.user_id = chat_bookmarks.user_id").
+			Where("chat_bookmarks.user_id = ?", userId).
+			Where("chat_room_users.joined_at IS NOT NULL AND chat_room_users.left_at IS NULL")
+	}
+
+	var totalCount int64
+	if err := query().Count(&totalCount).Error; err != nil {
+		return nil, nil, fmt.Errorf("북마크 카운트 조회 중 DB 오류: %w", err)
+	}
+
+	var rows []struct {
+		ID            uint
+		UserID        uint
+		ChatRoomID    uint
+		ChatMessageID string
+		CreatedAt     time.Time
+		RoomName      string
+		RoomAlias     string
+		RoomImage     *string
+		IsPrivate     bool
+	}
+	if err := query().
+		Select("chat_bookmarks.id, chat_bookmarks.user_id, chat_bookmarks.chat_room_id, chat_bookmarks.chat_message_id, chat_bookmarks.created_at, " +
+			"chat_rooms.name AS room_name, chat_room_users.chat_room_alias AS room_alias, chat_rooms.image AS room_image, chat_rooms.is_private").
+		Order("chat_bookmarks.created_at DESC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, nil, fmt.Errorf("북마크 조회 중 DB 오류: %w", err)
+	}
+
+	//TODO 메시지는 mongo에서 한 번에 조회
+	messageIDs := make([]primitive.ObjectID, 0, len(rows))
+	for _, row := range rows {
+		if id, err := primitive.ObjectIDFromHex(row.ChatMessageID); err == nil {
+			messageIDs = append(messageIDs, id)
+		}
+	}
+	messages := make(map[string]*chatEntity.Chat)
+	if len(messageIDs) > 0 {
+		cursor, err := r.mongo.Database("link").Collection("messages").
+			Find(context.Background(), bson.M{"_id": bson.M{"$in": messageIDs}})
+		if err != nil {
+			return nil, nil, fmt.Errorf("북마크 메시지 조회 중 MongoDB 오류: %w", err)
+		}
+		defer cursor.Close(context.Background())
+
+		var chatMessages []model.Chat
+		if err = cursor.All(context.Background(), &chatMessages); err != nil {
+			return nil, nil, fmt.Errorf("MongoDB 커서 처리 중 오류: %w", err)
+		}
+		for _, chatMessage := range chatMessages {
+			messages[chatMessage.ID.Hex()] = toChatEntity(&chatMessage)
+		}
+	}
+
+	bookmarks := make([]*chatEntity.ChatBookmark, len(rows))
+	for i, row := range rows {
+		roomName := row.RoomAlias
+		if roomName == "" {
+			roomName = row.RoomName
+		}
+		bookmarks[i] = &chatEntity.ChatBookmark{
+			ID:            row.ID,
+			UserID:        row.UserID,
+			ChatRoomID:    row.ChatRoomID,
+			ChatRoomName:  roomName,
+			ChatRoomImage: row.RoomImage,
+			IsPrivate:     row.IsPrivate,
+			ChatMessageID: row.ChatMessageID,
+			CreatedAt:     row.CreatedAt,
+			Message:       messages[row.ChatMessageID],
+		}
+	}
+
+	totalPages := int(math.Ceil(float64(totalCount) / float64(limit)))
+	hasMore := page < totalPages
+
+	return &chatEntity.ChatMeta{
+		TotalCount: int(totalCount),
+		TotalPages: totalPages,
+		PageSize:   limit,
+		HasMore:    &hasMore,
+		PrevPage:   page - 1,
+		NextPage:   page + 1,
+	}, bookmarks, nil
+}
+
+// TODO 메시지 중 사용자가 북마크한 메시지 ID
+func (r *chatPersistence) GetBookmarkedChatMessageIDs(userId uint, chatMessageIDs []string) (map[string]bool, error) {
+	result := make(map[string]bool)
+	if len(chatMessageIDs) == 0 {
+		return result, nil
+	}
+
+	var bookmarkedIDs []string
+	if err := r.db.Model(&model.ChatBookmark{}).
+		Where("user_id = ? AND chat_message_id IN ?", userId, chatMessageIDs).
+		Pluck("chat_message_id", &bookmarkedIDs).Error; err != nil {
+		return nil, fmt.Errorf("북마크 조회 중 DB 오류: %w", err)
+	}
+	for _, id := range bookmarkedIDs {
+		result[id] = true
+	}
+	return result, nil
+}
+
+// TODO 채팅 메시지 이모지 반응 추가 - 같은 사용자의 같은 이모지는 한 번만
+func (r *chatPersistence) AddChatMessageReaction(chatMessageID string, reaction *chatEntity.ChatReaction) ([]*chatEntity.ChatReaction, error) {
+	chatMessageIDObject, err := primitive.ObjectIDFromHex(chatMessageID)
+	if err != nil {
+		return nil, fmt.Errorf("채팅 메시지 ID 변환 중 오류: %w", err)
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	filter := bson.M{
+		"_id": chatMessageIDObject,
+		"reactions": bson.M{"$not": bson.M{"$elemMatch": bson.M{
+			"emoji_id": reaction.EmojiID,
+			"user_id":  reaction.UserID,
+		}}},
+	}
+	update := bson.M{"$push": bson.M{"reactions": model.ChatReaction{
+		EmojiID:   reaction.EmojiID,
+		Unified:   reaction.Unified,
+		Content:   reaction.Content,
+		UserID:    reaction.UserID,
+		CreatedAt: reaction.CreatedAt,
+	}}}
+
+	var chatMessage model.Chat
+	err = collection.FindOneAndUpdate(context.Background(), filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&chatMessage)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("이미 동일한 이모지 반응이 존재합니다")
+		}
+		return nil, fmt.Errorf("채팅 메시지 반응 추가 중 MongoDB 오류: %w", err)
+	}
+
+	return toChatReactionEntities(chatMessage.Reactions), nil
+}
+
+// TODO 채팅 메시지 이모지 반응 취소
+func (r *chatPersistence) RemoveChatMessageReaction(chatMessageID string, userId uint, emojiId uint) ([]*chatEntity.ChatReaction, error) {
+	chatMessageIDObject, err := primitive.ObjectIDFromHex(chatMessageID)
+	if err != nil {
+		return nil, fmt.Errorf("채팅 메시지 ID 변환 중 오류: %w", err)
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	filter := bson.M{
+		"_id":       chatMessageIDObject,
+		"reactions": bson.M{"$elemMatch": bson.M{"emoji_id": emojiId, "user_id": userId}},
+	}
+	update := bson.M{"$pull": bson.M{"reactions": bson.M{"emoji_id": emojiId, "user_id": userId}}}
+
+	var chatMessage model.Chat
+	err = collection.FindOneAndUpdate(context.Background(), filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&chatMessage)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("해당하는 이모지 반응을 찾을 수 없습니다")
+		}
+		return nil, fmt.Errorf("채팅 메시지 반응 취소 중 MongoDB 오류: %w", err)
+	}
+
+	return toChatReactionEntities(chatMessage.Reactions), nil
+}
+
+// TODO 채팅방 최신 메시지 조회 (스레드 전용 답글 제외)
+func (r *chatPersistence) GetLatestChatMessage(chatRoomID uint) (*chatEntity.Chat, error) {
+	collection := r.mongo.Database("link").Collection("messages")
+	filter := bson.M{
+		"chat_room_id": chatRoomID,
+		"$or":          roomVisibleMessageFilter(),
+	}
+
+	var chatMessage model.Chat
+	err := collection.FindOne(context.Background(), filter,
+		options.FindOne().SetSort(bson.M{"created_at": -1}),
+	).Decode(&chatMessage)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("최신 메시지 조회 중 MongoDB 오류: %w", err)
+	}
+
+	return &chatEntity.Chat{
+		ID:         chatMessage.ID.Hex(),
+		Content:    chatMessage.Content,
+		ChatRoomID: chatMessage.ChatRoomID,
+		SenderID:   chatMessage.SenderID,
+		CreatedAt:  chatMessage.CreatedAt,
+	}, nil
+}
+
+// TODO 채팅방 참여자들의 읽음 커서 조회
+func (r *chatPersistence) GetChatRoomReadCursors(chatRoomID uint) ([]*chatEntity.ChatReadCursor, error) {
+	var chatRoomUsers []model.ChatRoomUser
+	if err := r.db.
+		Where("chat_room_id = ? AND joined_at IS NOT NULL AND left_at IS NULL", chatRoomID).
+		Find(&chatRoomUsers).Error; err != nil {
+		return nil, fmt.Errorf("읽음 커서 조회 중 DB 오류: %w", err)
+	}
+
+	cursors := make([]*chatEntity.ChatReadCursor, len(chatRoomUsers))
+	for i, chatRoomUser := range chatRoomUsers {
+		cursors[i] = toChatReadCursorEntity(&chatRoomUser)
+	}
+	return cursors, nil
+}
+
+func (r *chatPersistence) GetChatRoomReadCursor(userId uint, chatRoomID uint) (*chatEntity.ChatReadCursor, error) {
+	var chatRoomUser model.ChatRoomUser
+	if err := r.db.
+		Where("user_id = ? AND chat_room_id = ? AND joined_at IS NOT NULL AND left_at IS NULL", userId, chatRoomID).
+		First(&chatRoomUser).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("채팅방 참여 정보를 찾을 수 없습니다")
+		}
+		return nil, fmt.Errorf("읽음 커서 조회 중 DB 오류: %w", err)
+	}
+	return toChatReadCursorEntity(&chatRoomUser), nil
+}
+
+// TODO 읽음 커서 이동 - 기존 커서보다 최신 메시지일 때만 갱신
+func (r *chatPersistence) UpdateReadCursor(userId uint, chatRoomID uint, chatMessageID string, readAt time.Time) (bool, error) {
+	result := r.db.Model(&model.ChatRoomUser{}).
+		Where("user_id = ? AND chat_room_id = ?", userId, chatRoomID).
+		Where("last_read_at IS NULL OR last_read_at < ?", readAt).
+		Updates(map[string]interface{}{
+			"last_read_message_id": chatMessageID,
+			"last_read_at":         readAt,
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("읽음 커서 갱신 중 DB 오류: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// TODO 채팅방별 안 읽은 메시지 수 - 채팅방마다 읽음 커서(없으면 참여 시점) 이후 다른 사람이 보낸 메시지 수
+func (r *chatPersistence) GetUnreadCounts(userId uint, chatRoomIDs []uint) (map[uint]int, error) {
+	result := make(map[uint]int)
+	if len(chatRoomIDs) == 0 {
+		return result, nil
+	}
+
+	var chatRoomUsers []model.ChatRoomUser
+	if err := r.db.
+		Where("user_id = ? AND chat_room_id IN ? AND joined_at IS NOT NULL AND left_at IS NULL", userId, chatRoomIDs).
+		Find(&chatRoomUsers).Error; err != nil {
+		return nil, fmt.Errorf("읽음 커서 조회 중 DB 오류: %w", err)
+	}
+	if len(chatRoomUsers) == 0 {
+		return result, nil
+	}
+
+	sinceFilters := make([]bson.M, 0, len(chatRoomUsers))
+	for _, chatRoomUser := range chatRoomUsers {
+		cursor := toChatReadCursorEntity(&chatRoomUser)
+		since := cursor.JoinedAt
+		if cursor.LastReadAt != nil {
+			since = *cursor.LastReadAt
+		}
+		sinceFilters = append(sinceFilters, bson.M{"chat_room_id": cursor.ChatRoomID, "created_at": bson.M{"$gt": since}})
+		result[cursor.ChatRoomID] = 0
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"sender_id": bson.M{"$ne": userId},
+			"$and": []bson.M{
+				{"$or": sinceFilters},
+				{"$or": roomVisibleMessageFilter()},
+			},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   "$chat_room_id",
+			"count": bson.M{"$sum": 1},
+		}}},
+	}
+
+	cursor, err := collection.Aggregate(context.Background(), pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("안 읽은 메시지 수 조회 중 MongoDB 오류: %w", err)
+	}
+	defer cursor.Close(context.Background())
+
+	var unreadCounts []struct {
+		ChatRoomID uint `bson:"_id"`
+		Count      int  `bson:"count"`
+	}
+	if err := cursor.All(context.Background(), &unreadCounts); err != nil {
+		return nil, fmt.Errorf("안 읽은 메시지 수 디코딩 중 오류: %w", err)
+	}
+
+	for _, unreadCount := range unreadCounts {
+		result[unreadCount.ChatRoomID] = unreadCount.Count
+	}
+	return result, nil
+}
+
+// 채팅방에 표시되는 메시지 - 일반 메시지와 채팅방에도 보낸 스레드 답글
+func roomVisibleMessageFilter() []bson.M {
+	return []bson.M{
+		{"parent_message_id": bson.M{"$exists": false}},
+		{"show_in_room": true},
+	}
+}
+
+func toChatReadCursorEntity(chatRoomUser *model.ChatRoomUser) *chatEntity.ChatReadCursor {
+	return &chatEntity.ChatReadCursor{
+		ChatRoomID:        chatRoomUser.ChatRoomID,
+		UserID:            chatRoomUser.UserID,
+		LastReadMessageID: chatRoomUser.LastReadMessageID,
+		LastReadAt:        chatRoomUser.LastReadAt,
+		JoinedAt:          chatRoomUser.JoinedAt,
+	}
+}
+
+func toChatReactionEntities(reactions []model.ChatReaction) []*chatEntity.ChatReaction {
+	result := make([]*chatEntity.ChatReaction, len(reactions))
+	for i, reaction := range reactions {
+		result[i] = &chatEntity.ChatReaction{
+			EmojiID:   reaction.EmojiID,
+			Unified:   reaction.Unified,
+			Content:   reaction.Content,
+			UserID:    reaction.UserID,
+			CreatedAt: reaction.CreatedAt,
+		}
+	}
+	return result
+}
+
+func toChatEntity(chatMessage *model.Chat) *chatEntity.Chat {
+	return &chatEntity.Chat{
+		ID:              chatMessage.ID.Hex(),
+		Content:         chatMessage.Content,
+		ChatRoomID:      chatMessage.ChatRoomID,
+		SenderID:        chatMessage.SenderID,
+		SenderName:      chatMessage.SenderName,
+		SenderEmail:     chatMessage.SenderEmail,
+		SenderImage:     chatMessage.SenderImage,
+		CreatedAt:       chatMessage.CreatedAt,
+		EditedAt:        chatMessage.EditedAt,
+		MessageType:     chatMessage.MessageType,
+		ParentMessageID: chatMessage.ParentMessageID,
+		ShowInRoom:      chatMessage.ShowInRoom,
+		ReplyCount:      chatMessage.ReplyCount,
+		LastReply:       toChatLastReplyEntity(chatMessage.LastReply),
+		Reactions:       toChatReactionEntities(chatMessage.Reactions),
+		Attachments:     toChatAttachmentEntities(chatMessage.Attachments),
+		PinnedAt:        chatMessage.PinnedAt,
+		PinnedBy:        chatMessage.PinnedBy,
+		ClientMsgID:     chatMessage.ClientMsgID,
+		Seq:             chatMessage.Seq,
+	}
+}
+
+// TODO 회사 팀 채팅방 ID 조회 - 요금제 채팅 보관 기간 적용 대상
+func (r *chatPersistence) GetCompanyChatRoomIDs(companyID uint) ([]uint, error) {
+	chatRoomIds := make([]uint, 0)
+	if err := r.db.Model(&model.Team{}).
+		Where("company_id = ? AND chat_room_id IS NOT NULL", companyID).
+		Pluck("chat_room_id", &chatRoomIds).Error; err != nil {
+		return nil, fmt.Errorf("회사 팀 채팅방 조회 중 DB 오류: %w", err)
+	}
+	return chatRoomIds, nil
+}
+
+// TODO 보관 기간이 지난 메시지 삭제 - 파일 정리를 위해 지운 메시지의 첨부파일 반환
+func (r *chatPersistence) DeleteChatMessagesBefore(chatRoomIDs []uint, before time.Time) ([]*chatEntity.ChatAttachment, error) {
+	if len(chatRoomIDs) == 0 {
+		return nil, nil
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	filter := bson.M{
+		"chat_room_id": bson.M{"$in": chatRoomIDs},
+		"created_at":   bson.M{"$lt": primitive.NewDateTimeFromTime(before.UTC())},
+	}
+
+	attachmentFilter := bson.M{"attachments.0": bson.M{"$exists": true}}
+	for key, value := range filter {
+		attachmentFilter[key] = value
+	}
+	cursor, err := collection.Find(context.Background(), attachmentFilter, options.Find().SetProjection(bson.M{"attachments": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("첨부파일 메시지 조회 중 MongoDB 오류: %w", err)
+	}
+	defer cursor.Close(context.Background())
+
+	var chatMessages []model.Chat
+	if err = cursor.All(context.Background(), &chatMessages); err != nil {
+		return nil, fmt.Errorf("MongoDB 커서 처리 중 오류: %w", err)
+	}
+
+	if _, err := collection.DeleteMany(context.Background(), filter); err != nil {
+		return nil, fmt.Errorf("채팅 메시지 삭제 중 MongoDB 오류: %w", err)
+	}
+
+	attachments := make([]*chatEntity.ChatAttachment, 0)
+	for _, chatMessage := range chatMessages {
+		attachments = append(attachments, toChatAttachmentEntities(chatMessage.Attachments)...)
+	}
+	return attachments, nil
+}
+
+func toChatAttachmentModels(attachments []*chatEntity.ChatAttachment) []model.ChatAttachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+	result := make([]model.ChatAttachment, len(attachments))
+	for i, attachment := range attachments {
+		result[i] = model.ChatAttachment{
+			Name:         attachment.Name,
+			Size:         attachment.Size,
+			MimeType:     attachment.MimeType,
+			URL:          attachment.URL,
+			ThumbnailURL: attachment.ThumbnailURL,
+		}
+	}
+	return result
+}
+
+func toChatAttachmentEntities(attachments []model.ChatAttachment) []*chatEntity.ChatAttachment {
+	result := make([]*chatEntity.ChatAttachment, len(attachments))
+	for i, attachment := range attachments {
+		result[i] = &chatEntity.ChatAttachment{
+			Name:         attachment.Name,
+			Size:         attachment.Size,
+			MimeType:     attachment.MimeType,
+			URL:          attachment.URL,
+			ThumbnailURL: attachment.ThumbnailURL,
+		}
+	}
+	return result
+}
+
+func toChatLastReplyEntity(lastReply *model.ChatLastReply) *chatEntity.ChatLastReply {
+	if lastReply == nil {
+		return nil
+	}
+	return &chatEntity.ChatLastReply{
+		SenderID:   lastReply.SenderID,
+		SenderName: lastReply.SenderName,
+		Content:    lastReply.Content,
+		CreatedAt:  lastReply.CreatedAt,
+	}
+}
+
+// TODO 레디스 관련
+func (r *chatPersistence) SetChatRoomToRedis(roomId uint, chatRoomInfo map[string]interface{}) error {
+	//json으로 변환
+	chatRoomJson, err := json.Marshal(chatRoomInfo)
+	if err != nil {
+		return fmt.Errorf("채팅방 직렬화 중 오류: %w", err)
+	}
+
+	r.redis.Set(context.Background(), fmt.Sprintf("chatroom:%d", roomId), chatRoomJson, 0)
+
+	return nil
+}
+
+func (r *chatPersistence) GetChatRoomByIdFromRedis(roomId uint) (*chatEntity.ChatRoom, error) {
+
+	//redis에서 조회
+	chatRoomJson, err := r.redis.Get(context.Background(), fmt.Sprintf("chatroom:%d", roomId)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("채팅방 조회 중 Redis 오류: %w", err)
+	}
+
+	//역직렬화
+	var chatRoom chatEntity.ChatRoom
+	err = json.Unmarshal([]byte(chatRoomJson), &chatRoom)
+	if err != nil {
+		return nil, fmt.Errorf("채팅방 역직렬화 중 오류: %w", err)
+	}
+
+	return &chatRoom, nil
+}
+
+// TODO 채팅방 나가기
+func (r *chatPersistence) LeaveChatRoom(userId uint, chatRoomId uint) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("채팅방 나가기 중 DB 오류: %w", tx.Error)
+	}
+
+	// err := tx.Delete(&model.ChatRoomUser{}, "user_id = ? AND chat_room_id = ?", userId, chatRoomId).Error
+	// if err != nil {
+	// 	tx.Rollback()
+	// 	return fmt.Errorf("채팅방 나가기 중 DB 오류: %w", err)
+	// }
+	//TODO 삭제가 아니라 leftAt을 현재 시간으로 설정, JoinedAt은 null로 설정 - 권한도 초기화
+	err := tx.Model(&model.ChatRoomUser{}).
+		Where("user_id = ? AND chat_room_id = ?", userId, chatRoomId).
+		Updates(map[string]interface{}{
+			"left_at":     time.Now(),
+			"joined_at":   nil,
+			"role":        chatEntity.ChatRoomRoleMember,
+			"is_muted":    false,
+			"archived_at": nil,
+			"pinned_at":   nil,
+		}).Error
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("채팅방 나가기 중 DB 오류: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("트랜잭션 커밋 실패: %w", err)
+	}
+
+	return nil
+}
+
+// TODO 채팅방 사용자 추가
+// TODO 채팅방에 사용자 있는지 확인
+func (r *chatPersistence) IsUserInChatRoom(userId uint, chatRoomId uint) bool {
+	var chatRoomUser model.ChatRoomUser
+
+	// 채팅방에 사용자가 있는지 확인 (joined_at이 있고 left_at이 NULL인 경우)
+	err := r.db.
+		Where("user_id = ? AND chat_room_id = ?", userId, chatRoomId).
+		Where("joined_at IS NOT NULL AND left_at IS NULL").
+		First(&chatRoomUser).Error
+
+	// 에러가 ErrRecordNotFound인 경우 나간사람임
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false
+	}
+	return err == nil
+}
+
+// TODO 1:1 채팅방인지 확인
+func (r *chatPersistence) IsPrivateChatRoom(chatRoomId uint) bool {
+	var chatRoom model.ChatRoom
+
+	// 1:1 채팅방인지 확인
+	err := r.db.
+		Where("id = ? AND is_private = ?", chatRoomId, true).
+		First(&chatRoom).Error
+
+	// 에러가 ErrRecordNotFound인 경우 false 반환, 그 외에는 true
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false
+	}
+	return err == nil
+}
+
+// TODO 채팅방 참여 중인 사용자 - 먼저 참여한 순
+func (r *chatPersistence) GetChatRoomMembers(chatRoomId uint) ([]*chatEntity.ChatRoomMember, error) {
+	var chatRoomUsers []model.ChatRoomUser
+	if err := r.db.
+		Preload("User").
+		Preload("User.UserProfile").
+		Where("chat_room_id = ? AND joined_at IS NOT NULL AND left_at IS NULL", chatRoomId).
+		Order("joined_at ASC, user_id ASC").
+		Find(&chatRoomUsers).Error; err != nil {
+		return nil, fmt.Errorf("채팅방 참여자 조회 중 DB 오류: %w", err)
+	}
+
+	members := make([]*chatEntity.ChatRoomMember, len(chatRoomUsers))
+	for i, chatRoomUser := range chatRoomUsers {
+		members[i] = toChatRoomMemberEntity(&chatRoomUser)
+	}
+	return members, nil
+}
+
+// TODO 채팅방 참여 중인 사용자 조회 - 없으면 nil
+func (r *chatPersistence) GetChatRoomMember(userId uint, chatRoomId uint) (*chatEntity.ChatRoomMember, error) {
+	var chatRoomUser model.ChatRoomUser
+	err := r.db.
+		Preload("User").
+		Preload("User.UserProfile").
+		Where("user_id = ? AND chat_room_id = ? AND joined_at IS NOT NULL AND left_at IS NULL", userId, chatRoomId).
+		First(&chatRoomUser).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("채팅방 참여자 조회 중 DB 오류: %w", err)
+	}
+	return toChatRoomMemberEntity(&chatRoomUser), nil
+}
+
+// TODO 그룹 채팅방 이름, 이미지 변경 - 이름을 바꾸면 참여자별 채팅방 별칭도 변경
+func (r *chatPersistence) UpdateChatRoom(chatRoomId uint, name *string, image *string) error {
+	updates := make(map[string]interface{})
+	if name != nil {
+		updates["name"] = *name
+	}
+	if image != nil {
+		updates["image"] = *image
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.ChatRoom{}).Where("id = ?", chatRoomId).Updates(updates).Error; err != nil {
+			return fmt.Errorf("채팅방 수정 중 DB 오류: %w", err)
+		}
+		if name != nil {
+			if err := tx.Model(&model.ChatRoomUser{}).
+				Where("chat_room_id = ?", chatRoomId).
+				Update("chat_room_alias", *name).Error; err != nil {
+				return fmt.Errorf("채팅방 별칭 수정 중 DB 오류: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// TODO 그룹 채팅방 멤버 추가 - 나갔던 사용자는 다시 참여 처리 (읽음 커서는 초기화)
+func (r *chatPersistence) AddChatRoomMembers(chatRoomId uint, userIds []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var chatRoom model.ChatRoom
+		if err := tx.First(&chatRoom, chatRoomId).Error; err != nil {
+			return fmt.Errorf("채팅방 조회 중 DB 오류: %w", err)
+		}
+
+		now := time.Now()
+		for _, userId := range userIds {
+			result := tx.Model(&model.ChatRoomUser{}).
+				Where("chat_room_id = ? AND user_id = ?", chatRoomId, userId).
+				Updates(map[string]interface{}{
+					"joined_at":            now,
+					"left_at":              nil,
+					"role":                 chatEntity.ChatRoomRoleMember,
+					"chat_room_alias":      chatRoom.Name,
+					"last_read_message_id": "",
+					"last_read_at":         nil,
+				})
+			if result.Error != nil {
+				return fmt.Errorf("채팅방 멤버 추가 중 DB 오류: %w", result.Error)
+			}
+			if result.RowsAffected > 0 {
+				continue
+			}
+
+			if err := tx.Create(&model.ChatRoomUser{
+				ChatRoomID:    chatRoomId,
+				UserID:        userId,
+				JoinedAt:      now,
+				ChatRoomAlias: chatRoom.Name,
+				Role:          chatEntity.ChatRoomRoleMember,
+			}).Error; err != nil {
+				return fmt.Errorf("채팅방 멤버 추가 중 DB 오류: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// TODO 채팅방 멤버 권한 변경 (admin, member)
+func (r *chatPersistence) UpdateChatRoomMemberRole(chatRoomId uint, userId uint, role string) error {
+	if err := r.db.Model(&model.ChatRoomUser{}).
+		Where("chat_room_id = ? AND user_id = ?", chatRoomId, userId).
+		Update("role", role).Error; err != nil {
+		return fmt.Errorf("채팅방 멤버 권한 변경 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO 방장 위임 - 기존 방장은 관리자로
+func (r *chatPersistence) TransferChatRoomOwnership(chatRoomId uint, ownerId uint, newOwnerId uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.ChatRoomUser{}).
+			Where("chat_room_id = ? AND user_id = ?", chatRoomId, ownerId).
+			Update("role", chatEntity.ChatRoomRoleAdmin).Error; err != nil {
+			return fmt.Errorf("방장 위임 중 DB 오류: %w", err)
+		}
+		if err := tx.Model(&model.ChatRoomUser{}).
+			Where("chat_room_id = ? AND user_id = ?", chatRoomId, newOwnerId).
+			Update("role", chatEntity.ChatRoomRoleOwner).Error; err != nil {
+			return fmt.Errorf("방장 위임 중 DB 오류: %w", err)
+		}
+		return nil
+	})
+}
+
+func toChatRoomMemberEntity(chatRoomUser *model.ChatRoomUser) *chatEntity.ChatRoomMember {
+	member := &chatEntity.ChatRoomMember{
+		ChatRoomID: chatRoomUser.ChatRoomID,
+		UserID:     chatRoomUser.UserID,
+		Role:       chatRoomUser.Role,
+		JoinedAt:   chatRoomUser.JoinedAt,
+	}
+	if chatRoomUser.User != nil {
+		member.Name = chatRoomUser.User.Name
+		member.Email = chatRoomUser.User.Email
+		if chatRoomUser.User.UserProfile != nil {
+			member.Image = chatRoomUser.User.UserProfile.Image
+		}
+	}
+	return member
+}
+
+// TODO 1:1 채팅방에 혼자만 있는 경우
+func (r *chatPersistence) AddUserToPrivateChatRoom(requestUserId uint, targetUserId uint, chatRoomId uint) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("채팅방 사용자 추가 중 DB 오류: %w", tx.Error)
+	}
+
+	chatRoom, err := r.GetChatRoomById(chatRoomId)
+	if err != nil {
+		tx.Rollback() // 트랜잭션 롤백 추가
+		return fmt.Errorf("채팅방 조회 중 DB 오류: %w", err)
+	}
+
+	//TODO 채팅방 이름도 변경
+	if chatRoom.IsPrivate && len(chatRoom.Users) == 2 {
+		// 채팅방 업데이트
+		if err := tx.Model(&model.ChatRoom{}).
+			Where("id = ?", chatRoomId).
+			Updates(map[string]interface{}{
+				"is_private": false,
+				"name":       fmt.Sprintf("%s님 외 %d명", *chatRoom.Users[0].Name, len(chatRoom.Users)),
+			}).Error; err != nil {
+			tx.Rollback() // 트랜잭션 롤백 추가
+			return fmt.Errorf("채팅방 업데이트 중 DB 오류: %w", err)
+		}
+
+		if err := tx.Model(&model.ChatRoomUser{}).
+			Where("user_id = ? AND chat_room_id = ?", targetUserId, chatRoomId).
+			Updates(map[string]interface{}{
+				"joined_at":       time.Now(),
+				"left_at":         nil,
+				"chat_room_alias": fmt.Sprintf("%s님 외 %d명", *chatRoom.Users[0].Name, len(chatRoom.Users)),
+			}).Error; err != nil {
+			tx.Rollback() // 트랜잭션 롤백 추가
+			return fmt.Errorf("채팅방 사용자 업데이트 중 DB 오류: %w", err)
+		}
+
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("트랜잭션 커밋 실패: %w", err)
+	}
+
+	return nil
+}
+
+// TODO 그룹 채팅방 사용자 추가
+func (r *chatPersistence) AddUserToGroupChatRoom(requestUserId uint, targetUserId uint, chatRoomId uint) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("채팅방 사용자 추가 중 DB 오류: %w", tx.Error)
+	}
+
+	chatRoom, err := r.GetChatRoomById(chatRoomId)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("채팅방 조회 중 DB 오류: %w", err)
+	}
+
+	if chatRoom.IsPrivate {
+		tx.Rollback()
+		return fmt.Errorf("채팅방이 1:1 채팅방입니다")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("트랜잭션 커밋 실패: %w", err)
+	}
+
+	return nil
+}
+
+//TODO 그룹 채팅방일 때 초대하면 추가
+
+// TODO 사용자별 채팅방 설정 변경 (is_muted, archived_at, pinned_at)
+func (r *chatPersistence) UpdateChatRoomSettings(userId uint, chatRoomId uint, settings map[string]interface{}) error {
+	if len(settings) == 0 {
+		return nil
+	}
+	if err := r.db.Model(&model.ChatRoomUser{}).
+		Where("chat_room_id = ? AND user_id = ? AND left_at IS NULL", chatRoomId, userId).
+		Updates(settings).Error; err != nil {
+		return fmt.Errorf("채팅방 설정 변경 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO 채팅방 알림을 끈 참여자 ID 목록
+func (r *chatPersistence) GetMutedChatRoomUserIDs(chatRoomId uint) ([]uint, error) {
+	var userIds []uint
+	if err := r.db.Model(&model.ChatRoomUser{}).
+		Where("chat_room_id = ? AND left_at IS NULL AND is_muted = ?", chatRoomId, true).
+		Pluck("user_id", &userIds).Error; err != nil {
+		return nil, fmt.Errorf("알림 끈 참여자 조회 중 DB 오류: %w", err)
+	}
+	return userIds, nil
+}
+
+// TODO 채팅방별 마지막 메시지 (채팅방에 표시되는 메시지만)
+func (r *chatPersistence) GetLastChatMessages(chatRoomIDs []uint) (map[uint]*chatEntity.Chat, error) {
+	result := make(map[uint]*chatEntity.Chat)
+	if len(chatRoomIDs) == 0 {
+		return result, nil
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"chat_room_id": bson.M{"$in": chatRoomIDs},
+			"$or":          roomVisibleMessageFilter(),
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "chat_room_id", Value: 1}, {Key: "created_at", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":     "$chat_room_id",
+			"message": bson.M{"$first": "$$ROOT"},
+		}}},
+	}
+
+	cursor, err := collection.Aggregate(context.Background(), pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("마지막 메시지 조회 중 MongoDB 오류: %w", err)
+	}
+	defer cursor.Close(context.Background())
+
+	var lastMessages []struct {
+		ChatRoomID uint       `bson:"_id"`
+		Message    model.Chat `bson:"message"`
+	}
+	if err := cursor.All(context.Background(), &lastMessages); err != nil {
+		return nil, fmt.Errorf("마지막 메시지 디코딩 중 오류: %w", err)
+	}
+
+	for _, lastMessage := range lastMessages {
+		result[lastMessage.ChatRoomID] = toChatEntity(&lastMessage.Message)
+	}
+	return result, nil
+}
+
+// 전송 중(sending) 상태로 이 시간이 지나면 다시 전송 대상으로 (서버 재시작 등) - client_msg_id로 중복 저장 방지
+const scheduledChatSendingTimeout = 5 * time.Minute
+
+// TODO 예약 메시지 저장 - 저장 후 ID 채움
+func (r *chatPersistence) CreateScheduledChatMessage(scheduledMessage *chatEntity.ScheduledChatMessage) error {
+	scheduledModel := &model.ScheduledChatMessage{
+		SenderID:        scheduledMessage.SenderID,
+		ChatRoomID:      scheduledMessage.ChatRoomID,
+		Content:         scheduledMessage.Content,
+		ParentMessageID: scheduledMessage.ParentMessageID,
+		ShowInRoom:      scheduledMessage.ShowInRoom,
+		SendAt:          scheduledMessage.SendAt,
+		Status:          chatEntity.ScheduledChatStatusPending,
+	}
+	if err := r.db.Create(scheduledModel).Error; err != nil {
+		return fmt.Errorf("예약 메시지 저장 중 DB 오류: %w", err)
+	}
+
+	*scheduledMessage = *toScheduledChatMessageEntity(scheduledModel)
+	return nil
+}
+
+// TODO 작성자의 대기 중인 예약 메시지 - 전송 시각순
+func (r *chatPersistence) GetScheduledChatMessages(senderID uint, chatRoomID uint) ([]*chatEntity.ScheduledChatMessage, error) {
+	var scheduledModels []model.ScheduledChatMessage
+	if err := r.db.
+		Where("sender_id = ? AND chat_room_id = ? AND status = ?", senderID, chatRoomID, chatEntity.ScheduledChatStatusPending).
+		Order("send_at ASC").
+		Find(&scheduledModels).Error; err != nil {
+		return nil, fmt.Errorf("예약 메시지 조회 중 DB 오류: %w", err)
+	}
+
+	result := make([]*chatEntity.ScheduledChatMessage, len(scheduledModels))
+	for i := range scheduledModels {
+		result[i] = toScheduledChatMessageEntity(&scheduledModels[i])
+	}
+	return result, nil
+}
+
+// TODO 예약 메시지 단건 조회 - 없으면 nil
+func (r *chatPersistence) GetScheduledChatMessageByID(scheduledMessageID uint) (*chatEntity.ScheduledChatMessage, error) {
+	var scheduledModel model.ScheduledChatMessage
+	if err := r.db.Where("id = ?", scheduledMessageID).First(&scheduledModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("예약 메시지 조회 중 DB 오류: %w", err)
+	}
+	return toScheduledChatMessageEntity(&scheduledModel), nil
+}
+
+// TODO 대기 중인 예약 메시지만 수정/취소 - 이미 전송이 시작됐으면 false
+func (r *chatPersistence) UpdatePendingScheduledChatMessage(scheduledMessageID uint, updates map[string]interface{}) (bool, error) {
+	result := r.db.Model(&model.ScheduledChatMessage{}).
+		Where("id = ? AND status = ?", scheduledMessageID, chatEntity.ScheduledChatStatusPending).
+		Updates(updates)
+	if result.Error != nil {
+		return false, fmt.Errorf("예약 메시지 수정 중 DB 오류: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// TODO 전송 시각이 된 예약 메시지를 sending으로 바꾸고 가져옴 - 서버가 여러 대여도 한 번만 가져가도록 SKIP LOCKED
+func (r *chatPersistence) ClaimDueScheduledChatMessages(now time.Time, limit int) ([]*chatEntity.ScheduledChatMessage, error) {
+	var scheduledModels []model.ScheduledChatMessage
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("(status = ? AND send_at <= ?) OR (status = ? AND updated_at <= ?)",
+				chatEntity.ScheduledChatStatusPending, now,
+				chatEntity.ScheduledChatStatusSending, now.Add(-scheduledChatSendingTimeout)).
+			Order("send_at ASC").
+			Limit(limit).
+			Find(&scheduledModels).Error; err != nil {
+			return fmt.Errorf("전송할 예약 메시지 조회 중 DB 오류: %w", err)
+		}
+		if len(scheduledModels) == 0 {
+			return nil
+		}
+
+		ids := make([]uint, len(scheduledModels))
+		for i, scheduledModel := range scheduledModels {
+			ids[i] = scheduledModel.ID
+		}
+		if err := tx.Model(&model.ScheduledChatMessage{}).
+			Where("id IN ?", ids).
+			Update("status", chatEntity.ScheduledChatStatusSending).Error; err != nil {
+			return fmt.Errorf("예약 메시지 상태 변경 중 DB 오류: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*chatEntity.ScheduledChatMessage, len(scheduledModels))
+	for i := range scheduledModels {
+		scheduledModels[i].Status = chatEntity.ScheduledChatStatusSending
+		result[i] = toScheduledChatMessageEntity(&scheduledModels[i])
+	}
+	return result, nil
+}
+
+// TODO 예약 메시지 전송 결과 기록 (sent, failed)
+func (r *chatPersistence) CompleteScheduledChatMessage(scheduledMessageID uint, status string, chatMessageID string, failReason string) error {
+	updates := map[string]interface{}{
+		"status":      status,
+		"fail_reason": failReason,
+	}
+	if status == chatEntity.ScheduledChatStatusSent {
+		updates["chat_message_id"] = chatMessageID
+		updates["sent_at"] = time.Now()
+	}
+
+	if err := r.db.Model(&model.ScheduledChatMessage{}).
+		Where("id = ?", scheduledMessageID).
+		Updates(updates).Error; err != nil {
+		return fmt.Errorf("예약 메시지 전송 결과 기록 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+func toScheduledChatMessageEntity(scheduledModel *model.ScheduledChatMessage) *chatEntity.ScheduledChatMessage {
+	return &chatEntity.ScheduledChatMessage{
+		ID:              scheduledModel.ID,
+		SenderID:        scheduledModel.SenderID,
+		ChatRoomID:      scheduledModel.ChatRoomID,
+		Content:         scheduledModel.Content,
+		ParentMessageID: scheduledModel.ParentMessageID,
+		ShowInRoom:      scheduledModel.ShowInRoom,
+		SendAt:          scheduledModel.SendAt,
+		Status:          scheduledModel.Status,
+		ChatMessageID:   scheduledModel.ChatMessageID,
+		FailReason:      scheduledModel.FailReason,
+		SentAt:          scheduledModel.SentAt,
+		CreatedAt:       scheduledModel.CreatedAt,
+		UpdatedAt:       scheduledModel.UpdatedAt,
+	}
+}
@@ -0,0 +1,150 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+	"link/infrastructure/model"
+	"link/internal/company/entity"
+	"link/internal/company/repository"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type companyEmailDomainPersistence struct {
+	db *gorm.DB
+}
+
+func NewCompanyEmailDomainPersistence(db *gorm.DB) repository.CompanyEmailDomainRepository {
+	return &companyEmailDomainPersistence{db: db}
+}
+
+func (r *companyEmailDomainPersistence) CreateCompanyEmailDomain(domain *entity.CompanyEmailDomain) error {
+	modelDomain := &model.CompanyEmailDomain{
+		CompanyID:         domain.CompanyID,
+		Domain:            domain.Domain,
+		VerificationToken: domain.VerificationToken,
+		JoinPolicy:        domain.JoinPolicy,
+	}
+
+	if err := r.db.Create(modelDomain).Error; err != nil {
+		return fmt.Errorf("이메일 도메인 등록 중 DB 오류: %w", err)
+	}
+
+	domain.ID = modelDomain.ID
+	domain.CreatedAt = modelDomain.CreatedAt
+	return nil
+}
+
+func (r *companyEmailDomainPersistence) GetCompanyEmailDomainByID(domainID uint) (*entity.CompanyEmailDomain, error) {
+	var domain model.CompanyEmailDomain
+	if err := r.emailDomainQuery().Where("id = ?", domainID).First(&domain).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("이메일 도메인을 찾을 수 없습니다: ID %d", domainID)
+		}
+		return nil, fmt.Errorf("이메일 도메인 조회 중 DB 오류: %w", err)
+	}
+	return toCompanyEmailDomainEntity(domain), nil
+}
+
+func (r *companyEmailDomainPersistence) GetCompanyEmailDomainsByCompany(companyID uint) ([]entity.CompanyEmailDomain, error) {
+	var domains []model.CompanyEmailDomain
+	if err := r.emailDomainQuery().
+		Where("company_id = ?", companyID).
+		Order("domain ASC").
+		Find(&domains).Error; err != nil {
+		return nil, fmt.Errorf("이메일 도메인 목록 조회 중 DB 오류: %w", err)
+	}
+
+	result := make([]entity.CompanyEmailDomain, len(domains))
+	for i, domain := range domains {
+		result[i] = *toCompanyEmailDomainEntity(domain)
+	}
+	return result, nil
+}
+
+func (r *companyEmailDomainPersistence) ExistsCompanyEmailDomain(companyID uint, domain string) (bool, error) {
+	var count int64
+	if err := r.db.Model(&model.CompanyEmailDomain{}).
+		Where("company_id = ? AND domain = ?", companyID, domain).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("이메일 도메인 조회 중 DB 오류: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (r *companyEmailDomainPersistence) UpdateCompanyEmailDomainPolicy(domainID uint, joinPolicy string) error {
+	if err := r.db.Model(&model.CompanyEmailDomain{}).
+		Where("id = ?", domainID).
+		Update("join_policy", joinPolicy).Error; err != nil {
+		return fmt.Errorf("이메일 도메인 가입 정책 변경 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+func (r *companyEmailDomainPersistence) DeleteCompanyEmailDomain(domainID uint) error {
+	if err := r.db.Where("id = ?", domainID).Delete(&model.CompanyEmailDomain{}).Error; err != nil {
+		return fmt.Errorf("이메일 도메인 삭제 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+func (r *companyEmailDomainPersistence) GetVerifiedCompanyEmailDomain(domain string) (*entity.CompanyEmailDomain, error) {
+	var emailDomain model.CompanyEmailDomain
+	err := r.emailDomainQuery().
+		Where("domain = ? AND verified_at IS NOT NULL", domain).
+		First(&emailDomain).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("이메일 도메인 조회 중 DB 오류: %w", err)
+	}
+	return toCompanyEmailDomainEntity(emailDomain), nil
+}
+
+func (r *companyEmailDomainPersistence) VerifyCompanyEmailDomain(domainID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var domain model.CompanyEmailDomain
+		if err := tx.Where("id = ?", domainID).First(&domain).Error; err != nil {
+			return fmt.Errorf("이메일 도메인 조회 중 DB 오류: %w", err)
+		}
+
+		// 같은 도메인을 다른 회사가 먼저 인증했는지 확인 (부분 유니크 인덱스로도 막힘)
+		var count int64
+		if err := tx.Model(&model.CompanyEmailDomain{}).
+			Where("domain = ? AND id <> ? AND verified_at IS NOT NULL", domain.Domain, domainID).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("이메일 도메인 조회 중 DB 오류: %w", err)
+		}
+		if count > 0 {
+			return fmt.Errorf("다른 회사가 인증한 도메인입니다: %s", domain.Domain)
+		}
+
+		if err := tx.Model(&model.CompanyEmailDomain{}).
+			Where("id = ?", domainID).
+			Update("verified_at", time.Now()).Error; err != nil {
+			return fmt.Errorf("이메일 도메인 인증 처리 중 DB 오류: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *companyEmailDomainPersistence) emailDomainQuery() *gorm.DB {
+	return r.db.Preload("Company", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id, cp_name")
+	})
+}
+
+func toCompanyEmailDomainEntity(domain model.CompanyEmailDomain) *entity.CompanyEmailDomain {
+	return &entity.CompanyEmailDomain{
+		ID:                domain.ID,
+		CompanyID:         domain.CompanyID,
+		CompanyName:       domain.Company.CpName,
+		Domain:            domain.Domain,
+		VerificationToken: domain.VerificationToken,
+		JoinPolicy:        domain.JoinPolicy,
+		VerifiedAt:        domain.VerifiedAt,
+		CreatedAt:         domain.CreatedAt,
+	}
+}
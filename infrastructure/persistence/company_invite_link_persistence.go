@@ -0,0 +1,230 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"link/infrastructure/model"
+	"link/internal/company/entity"
+	"link/internal/company/repository"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type companyInviteLinkPersistence struct {
+	db          *gorm.DB
+	redisClient *redis.Client
+}
+
+func NewCompanyInviteLinkPersistence(db *gorm.DB, redisClient *redis.Client) repository.CompanyInviteLinkRepository {
+	return &companyInviteLinkPersistence{db: db, redisClient: redisClient}
+}
+
+func (r *companyInviteLinkPersistence) CreateCompanyInviteLink(link *entity.CompanyInviteLink) error {
+	modelLink := &model.CompanyInviteLink{
+		Code:         link.Code,
+		CompanyID:    link.CompanyID,
+		CreatorID:    link.CreatorID,
+		TargetType:   link.TargetType,
+		DepartmentID: link.DepartmentID,
+		ProjectID:    link.ProjectID,
+		Role:         model.UserRole(link.Role),
+		EmailDomain:  link.EmailDomain,
+		MaxUses:      link.MaxUses,
+		ExpiresAt:    link.ExpiresAt,
+	}
+
+	if err := r.db.Create(modelLink).Error; err != nil {
+		return fmt.Errorf("초대 링크 생성 중 DB 오류: %w", err)
+	}
+
+	link.ID = modelLink.ID
+	link.CreatedAt = modelLink.CreatedAt
+	return nil
+}
+
+func (r *companyInviteLinkPersistence) GetCompanyInviteLinkByID(linkID uint) (*entity.CompanyInviteLink, error) {
+	var link model.CompanyInviteLink
+	if err := r.inviteLinkQuery().Where("id = ?", linkID).First(&link).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("초대 링크를 찾을 수 없습니다: ID %d", linkID)
+		}
+		return nil, fmt.Errorf("초대 링크 조회 중 DB 오류: %w", err)
+	}
+	return toCompanyInviteLinkEntity(link), nil
+}
+
+func (r *companyInviteLinkPersistence) GetCompanyInviteLinkByCode(code string) (*entity.CompanyInviteLink, error) {
+	var link model.CompanyInviteLink
+	if err := r.inviteLinkQuery().Where("code = ?", code).First(&link).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("초대 링크를 찾을 수 없습니다: 코드 %s", code)
+		}
+		return nil, fmt.Errorf("초대 링크 조회 중 DB 오류: %w", err)
+	}
+	return toCompanyInviteLinkEntity(link), nil
+}
+
+func (r *companyInviteLinkPersistence) GetCompanyInviteLinksByCompany(companyID uint) ([]entity.CompanyInviteLink, error) {
+	var links []model.CompanyInviteLink
+	if err := r.inviteLinkQuery().
+		Where("company_id = ?", companyID).
+		Order("created_at DESC").
+		Find(&links).Error; err != nil {
+		return nil, fmt.Errorf("초대 링크 목록 조회 중 DB 오류: %w", err)
+	}
+
+	result := make([]entity.CompanyInviteLink, len(links))
+	for i, link := range links {
+		result[i] = *toCompanyInviteLinkEntity(link)
+	}
+	return result, nil
+}
+
+func (r *companyInviteLinkPersistence) RevokeCompanyInviteLink(linkID uint) error {
+	result := r.db.Model(&model.CompanyInviteLink{}).
+		Where("id = ? AND revoked_at IS NULL", linkID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return fmt.Errorf("초대 링크 폐기 중 DB 오류: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("이미 폐기된 초대 링크입니다: ID %d", linkID)
+	}
+	return nil
+}
+
+func (r *companyInviteLinkPersistence) ExistsCompanyInviteLinkRedemption(linkID uint, userID uint) (bool, error) {
+	var count int64
+	if err := r.db.Model(&model.CompanyInviteLinkRedemption{}).
+		Where("invite_link_id = ? AND user_id = ?", linkID, userID).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("초대 링크 사용 이력 조회 중 DB 오류: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (r *companyInviteLinkPersistence) RedeemCompanyInviteLink(linkID uint, userID uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var link model.CompanyInviteLink
+		if err := tx.Where("id = ?", linkID).First(&link).Error; err != nil {
+			return fmt.Errorf("초대 링크 조회 중 DB 오류: %w", err)
+		}
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.CompanyInviteLinkRedemption{
+			InviteLinkID: linkID,
+			UserID:       userID,
+		})
+		if result.Error != nil {
+			return fmt.Errorf("초대 링크 사용 이력 저장 중 DB 오류: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("이미 사용한 초대 링크입니다: 링크 ID %d, 사용자 ID %d", linkID, userID)
+		}
+
+		// 동시에 여러 명이 사용해도 최대 사용 횟수를 넘지 않도록 조건부 증가
+		result = tx.Model(&model.CompanyInviteLink{}).
+			Where("id = ? AND revoked_at IS NULL", linkID).
+			Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+			Where("max_uses = 0 OR use_count < max_uses").
+			Update("use_count", gorm.Expr("use_count + 1"))
+		if result.Error != nil {
+			return fmt.Errorf("초대 링크 사용 횟수 갱신 중 DB 오류: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("사용할 수 없는 초대 링크입니다: ID %d", linkID)
+		}
+
+		// 이미 소속된 회사면 기존 역할 유지
+		result = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.CompanyMembership{
+			UserID:    userID,
+			CompanyID: link.CompanyID,
+			Role:      link.Role,
+		})
+		if result.Error != nil {
+			return fmt.Errorf("회사 소속 추가 중 DB 오류: %w", result.Error)
+		}
+		if result.RowsAffected > 0 {
+			if err := tx.Model(&model.UserProfile{}).
+				Where("user_id = ? AND company_id IS NULL", userID).
+				Update("company_id", link.CompanyID).Error; err != nil {
+				return fmt.Errorf("대표 회사 지정 중 DB 오류: %w", err)
+			}
+		}
+
+		if link.DepartmentID != nil {
+			if err := tx.Exec(`
+				INSERT INTO user_profile_departments (user_profile_user_id, department_id)
+				VALUES (?, ?)
+				ON CONFLICT DO NOTHING`, userID, *link.DepartmentID).Error; err != nil {
+				return fmt.Errorf("부서 할당 중 DB 오류: %w", err)
+			}
+		}
+
+		if link.ProjectID != nil {
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.ProjectUser{
+				ProjectID: *link.ProjectID,
+				UserID:    userID,
+				Role:      model.ProjectRoleUser,
+			}).Error; err != nil {
+				return fmt.Errorf("프로젝트 참여 중 DB 오류: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 회사, 부서 정보가 바뀌었으므로 캐시된 부서 정보 제거
+	r.redisClient.HDel(context.Background(), fmt.Sprintf("user:%d", userID), "departments")
+	return nil
+}
+
+func (r *companyInviteLinkPersistence) inviteLinkQuery() *gorm.DB {
+	return r.db.
+		Preload("Company", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, cp_name")
+		}).
+		Preload("Creator", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, name")
+		}).
+		Preload("Department", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, name")
+		}).
+		Preload("Project", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, name")
+		})
+}
+
+func toCompanyInviteLinkEntity(link model.CompanyInviteLink) *entity.CompanyInviteLink {
+	result := &entity.CompanyInviteLink{
+		ID:           link.ID,
+		Code:         link.Code,
+		CompanyID:    link.CompanyID,
+		CompanyName:  link.Company.CpName,
+		CreatorID:    link.CreatorID,
+		CreatorName:  link.Creator.Name,
+		TargetType:   link.TargetType,
+		DepartmentID: link.DepartmentID,
+		ProjectID:    link.ProjectID,
+		Role:         int(link.Role),
+		EmailDomain:  link.EmailDomain,
+		MaxUses:      link.MaxUses,
+		UseCount:     link.UseCount,
+		ExpiresAt:    link.ExpiresAt,
+		RevokedAt:    link.RevokedAt,
+		CreatedAt:    link.CreatedAt,
+	}
+	if link.Department != nil {
+		result.DepartmentName = link.Department.Name
+	}
+	if link.Project != nil {
+		result.ProjectName = link.Project.Name
+	}
+	return result
+}
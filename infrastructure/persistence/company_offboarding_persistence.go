@@ -0,0 +1,339 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"gorm.io/gorm"
+
+	"link/infrastructure/model"
+	"link/internal/company/entity"
+	"link/internal/company/repository"
+	_util "link/pkg/util"
+)
+
+type companyOffboardingPersistence struct {
+	db    *gorm.DB
+	mongo *mongo.Client
+	redis *redis.Client
+}
+
+func NewCompanyOffboardingPersistence(db *gorm.DB, mongo *mongo.Client, redis *redis.Client) repository.CompanyOffboardingRepository {
+	return &companyOffboardingPersistence{db: db, mongo: mongo, redis: redis}
+}
+
+// companyPurgeTargets 영구 삭제 시 Postgres 밖에서 같이 정리해야 하는 대상
+type companyPurgeTargets struct {
+	memberIds   []uint
+	primaryIds  []uint // 이 회사가 대표 회사인 사용자
+	postIds     []uint
+	commentIds  []uint
+	projectIds  []uint
+	boardIds    []uint
+	chatRoomIds []uint // 팀 채팅방
+	fileURLs    []string
+}
+
+func (r *companyOffboardingPersistence) GetCompanyPurgeScheduledAt(companyID uint) (*time.Time, error) {
+	var company model.Company
+	if err := r.db.Select("id, purge_scheduled_at").Where("id = ?", companyID).First(&company).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("회사 삭제 예정 조회 중 DB 오류: %w", err)
+	}
+	return company.PurgeScheduledAt, nil
+}
+
+// TODO 회사 전체 데이터 내보내기 - users.password 등 민감 정보는 제외
+func (r *companyOffboardingPersistence) GetCompanyExport(companyID uint) (*entity.CompanyExport, error) {
+	company := map[string]interface{}{}
+	if err := r.db.Table("companies").Where("id = ?", companyID).Take(&company).Error; err != nil {
+		return nil, fmt.Errorf("회사 조회 중 DB 오류: %w", err)
+	}
+
+	targets, err := r.getPurgeTargets(companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &entity.CompanyExport{Company: company, ExportedAt: time.Now()}
+
+	queries := []struct {
+		dest  *[]map[string]interface{}
+		query string
+		args  []interface{}
+	}{
+		{&export.Users, `
+			SELECT u.id, u.name, u.email, u.nickname, u.phone, u.status,
+				m.role, m.position_id, m.custom_role_id, m.manager_id, m.entry_date, m.created_at AS joined_at,
+				p.image, p.birthday
+			FROM company_memberships m
+			JOIN users u ON u.id = m.user_id
+			LEFT JOIN user_profiles p ON p.user_id = u.id
+			WHERE m.company_id = ? ORDER BY u.id`, []interface{}{companyID}},
+		{&export.Organization.Departments, `SELECT * FROM departments WHERE company_id = ? ORDER BY id`, []interface{}{companyID}},
+		{&export.Organization.DepartmentMembers, `
+			SELECT upd.user_profile_user_id AS user_id, upd.department_id
+			FROM user_profile_departments upd JOIN departments d ON d.id = upd.department_id
+			WHERE d.company_id = ? ORDER BY upd.department_id, upd.user_profile_user_id`, []interface{}{companyID}},
+		{&export.Organization.Positions, `SELECT * FROM positions WHERE company_id = ? ORDER BY id`, []interface{}{companyID}},
+		{&export.Organization.Roles, `SELECT * FROM company_roles WHERE company_id = ? ORDER BY id`, []interface{}{companyID}},
+		{&export.Organization.Teams, `SELECT * FROM teams WHERE company_id = ? ORDER BY id`, []interface{}{companyID}},
+		{&export.Organization.TeamMembers, `
+			SELECT tm.* FROM team_members tm JOIN teams t ON t.id = tm.team_id
+			WHERE t.company_id = ? ORDER BY tm.team_id, tm.user_id`, []interface{}{companyID}},
+		{&export.Posts.Posts, `SELECT * FROM posts WHERE id IN ? ORDER BY id`, []interface{}{targets.postIds}},
+		{&export.Posts.PostImages, `SELECT * FROM post_images WHERE post_id IN ? ORDER BY id`, []interface{}{targets.postIds}},
+		{&export.Posts.PostDepartments, `SELECT * FROM post_departments WHERE post_id IN ?`, []interface{}{targets.postIds}},
+		{&export.Posts.Comments, `SELECT * FROM comments WHERE id IN ? ORDER BY id`, []interface{}{targets.commentIds}},
+		{&export.Boards.Projects, `SELECT * FROM projects WHERE id IN ? ORDER BY id`, []interface{}{targets.projectIds}},
+		{&export.Boards.ProjectUsers, `SELECT * FROM project_users WHERE project_id IN ?`, []interface{}{targets.projectIds}},
+		{&export.Boards.Boards, `SELECT * FROM boards WHERE id IN ? ORDER BY id`, []interface{}{targets.boardIds}},
+		{&export.Boards.BoardColumns, `SELECT * FROM board_columns WHERE board_id IN ? ORDER BY board_id, position`, []interface{}{targets.boardIds}},
+		{&export.Boards.BoardCards, `SELECT * FROM board_cards WHERE board_id IN ? ORDER BY board_id, position`, []interface{}{targets.boardIds}},
+		{&export.Boards.CardAssignees, `
+			SELECT ca.* FROM card_assignees ca JOIN board_cards bc ON bc.id = ca.card_id
+			WHERE bc.board_id IN ?`, []interface{}{targets.boardIds}},
+		{&export.Chats.ChatRooms, `SELECT * FROM chat_rooms WHERE id IN ? ORDER BY id`, []interface{}{targets.chatRoomIds}},
+		{&export.Chats.ChatRoomUsers, `SELECT * FROM chat_room_users WHERE chat_room_id IN ?`, []interface{}{targets.chatRoomIds}},
+	}
+
+	for _, q := range queries {
+		rows := make([]map[string]interface{}, 0)
+		if len(q.args) == 1 {
+			if ids, ok := q.args[0].([]uint); ok && len(ids) == 0 {
+				*q.dest = rows
+				continue
+			}
+		}
+		if err := r.db.Raw(q.query, q.args...).Scan(&rows).Error; err != nil {
+			return nil, fmt.Errorf("회사 데이터 내보내기 중 DB 오류: %w", err)
+		}
+		*q.dest = rows
+	}
+
+	export.Chats.Messages = make([]map[string]interface{}, 0)
+	if len(targets.chatRoomIds) > 0 {
+		collection := r.mongo.Database("link").Collection("messages")
+		cursor, err := collection.Find(context.Background(),
+			bson.M{"chat_room_id": bson.M{"$in": targets.chatRoomIds}},
+			options.Find().SetSort(bson.D{{Key: "chat_room_id", Value: 1}, {Key: "created_at", Value: 1}}))
+		if err != nil {
+			return nil, fmt.Errorf("채팅 메시지 조회 중 MongoDB 오류: %w", err)
+		}
+		defer cursor.Close(context.Background())
+
+		for cursor.Next(context.Background()) {
+			var message bson.M
+			if err := cursor.Decode(&message); err != nil {
+				return nil, fmt.Errorf("채팅 메시지 디코딩 중 오류: %w", err)
+			}
+			export.Chats.Messages = append(export.Chats.Messages, map[string]interface{}(message))
+		}
+		if err := cursor.Err(); err != nil {
+			return nil, fmt.Errorf("채팅 메시지 조회 중 MongoDB 오류: %w", err)
+		}
+	}
+
+	return export, nil
+}
+
+func (r *companyOffboardingPersistence) GetCompanyIdsToPurge(before time.Time) ([]uint, error) {
+	var companyIds []uint
+	if err := r.db.Model(&model.Company{}).
+		Where("purge_scheduled_at IS NOT NULL AND purge_scheduled_at <= ?", before).
+		Order("purge_scheduled_at ASC").
+		Pluck("id", &companyIds).Error; err != nil {
+		return nil, fmt.Errorf("영구 삭제 대상 회사 조회 중 DB 오류: %w", err)
+	}
+	return companyIds, nil
+}
+
+// TODO 회사 영구 삭제
+// MongoDB -> Postgres -> Redis -> 파일 순서로 정리
+// 중간에 실패하면 회사가 남아 있으므로 다음 실행에서 다시 시도됨 (각 단계는 여러 번 실행해도 결과가 같음)
+func (r *companyOffboardingPersistence) PurgeCompany(companyID uint) error {
+	targets, err := r.getPurgeTargets(companyID)
+	if err != nil {
+		return err
+	}
+
+	//TODO 1. MongoDB - 팀 채팅방 메시지와 메시지 순번, 회사 알림
+	if len(targets.chatRoomIds) > 0 {
+		if _, err := r.mongo.Database("link").Collection("messages").
+			DeleteMany(context.Background(), bson.M{"chat_room_id": bson.M{"$in": targets.chatRoomIds}}); err != nil {
+			return fmt.Errorf("채팅 메시지 삭제 중 MongoDB 오류: %w", err)
+		}
+		if _, err := r.mongo.Database("link").Collection("chat_sequences").
+			DeleteMany(context.Background(), bson.M{"_id": bson.M{"$in": targets.chatRoomIds}}); err != nil {
+			return fmt.Errorf("채팅 메시지 순번 삭제 중 MongoDB 오류: %w", err)
+		}
+	}
+	if _, err := r.mongo.Database("link").Collection("notifications").
+		DeleteMany(context.Background(), bson.M{"company_id": companyID}); err != nil {
+		return fmt.Errorf("회사 알림 삭제 중 MongoDB 오류: %w", err)
+	}
+
+	//TODO 2. Postgres - CASCADE가 없는 관계(댓글, 좋아요, 프로젝트, 팀 채팅방, 대표 회사)는 직접 정리
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		if len(targets.postIds) > 0 {
+			if err := tx.Exec("DELETE FROM likes WHERE UPPER(target_type) = 'POST' AND target_id IN ?", targets.postIds).Error; err != nil {
+				return fmt.Errorf("좋아요 삭제 중 DB 오류: %w", err)
+			}
+		}
+		if len(targets.commentIds) > 0 {
+			if err := tx.Exec("DELETE FROM likes WHERE UPPER(target_type) = 'COMMENT' AND target_id IN ?", targets.commentIds).Error; err != nil {
+				return fmt.Errorf("좋아요 삭제 중 DB 오류: %w", err)
+			}
+			if err := tx.Exec("DELETE FROM comments WHERE id IN ?", targets.commentIds).Error; err != nil {
+				return fmt.Errorf("댓글 삭제 중 DB 오류: %w", err)
+			}
+		}
+		if len(targets.projectIds) > 0 {
+			if err := tx.Exec("DELETE FROM projects WHERE id IN ?", targets.projectIds).Error; err != nil {
+				return fmt.Errorf("프로젝트 삭제 중 DB 오류: %w", err)
+			}
+		}
+		if len(targets.chatRoomIds) > 0 {
+			if err := tx.Exec("DELETE FROM chat_rooms WHERE id IN ?", targets.chatRoomIds).Error; err != nil {
+				return fmt.Errorf("팀 채팅방 삭제 중 DB 오류: %w", err)
+			}
+		}
+
+		//TODO 이 회사가 대표 회사인 사용자는 남은 소속 중 가장 먼저 가입한 회사를 대표 회사로, 없으면 미소속
+		if len(targets.primaryIds) > 0 {
+			if err := tx.Exec(`
+				UPDATE user_profiles SET company_id = NULL, position_id = NULL, manager_id = NULL, updated_at = NOW()
+				WHERE user_id IN ?`, targets.primaryIds).Error; err != nil {
+				return fmt.Errorf("대표 회사 정리 중 DB 오류: %w", err)
+			}
+			if err := tx.Exec(`
+				UPDATE user_profiles SET company_id = m.company_id, position_id = m.position_id, manager_id = m.manager_id
+				FROM (
+					SELECT DISTINCT ON (user_id) user_id, company_id, position_id, manager_id
+					FROM company_memberships
+					WHERE company_id <> ? AND user_id IN ?
+					ORDER BY user_id, created_at
+				) m
+				WHERE user_profiles.user_id = m.user_id`, companyID, targets.primaryIds).Error; err != nil {
+				return fmt.Errorf("대표 회사 변경 중 DB 오류: %w", err)
+			}
+			if err := tx.Exec(`
+				UPDATE users SET role = COALESCE((
+					SELECT m.role FROM company_memberships m
+					JOIN user_profiles p ON p.user_id = m.user_id AND p.company_id = m.company_id
+					WHERE m.user_id = users.id
+				), ?)
+				WHERE id IN ? AND role >= ?`,
+				model.RoleUser, targets.primaryIds, model.RoleCompanyManager).Error; err != nil {
+				return fmt.Errorf("사용자 역할 정리 중 DB 오류: %w", err)
+			}
+		}
+
+		//TODO 회사 삭제 - 부서, 직책, 역할, 팀, 소속, 게시물, 초대 링크, 도메인, 인증 신청은 CASCADE
+		if err := tx.Where("company_id = ?", companyID).Delete(&model.Department{}).Error; err != nil {
+			return fmt.Errorf("부서 삭제 중 DB 오류: %w", err)
+		}
+		result := tx.Where("id = ?", companyID).Delete(&model.Company{})
+		if result.Error != nil {
+			return fmt.Errorf("회사 삭제 중 DB 오류: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("해당 ID의 회사를 찾을 수 없습니다: %d", companyID)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	//TODO 3. Redis - 캐시는 다시 채워지므로 실패해도 진행
+	keys := make([]string, 0)
+	for _, userId := range targets.memberIds {
+		keys = append(keys, fmt.Sprintf("user:%d", userId))
+	}
+	for _, chatRoomId := range targets.chatRoomIds {
+		keys = append(keys, fmt.Sprintf("chatroom:%d", chatRoomId))
+	}
+	for _, postId := range targets.postIds {
+		keys = append(keys, fmt.Sprintf("post:views:%d", postId), fmt.Sprintf("post:views:diff:%d", postId))
+	}
+	for _, boardId := range targets.boardIds {
+		keys = append(keys, fmt.Sprintf("board:%d:online_users", boardId))
+	}
+	for start := 0; start < len(keys); start += 500 {
+		end := min(start+500, len(keys))
+		if err := r.redis.Del(context.Background(), keys[start:end]...).Err(); err != nil {
+			log.Printf("회사 캐시 삭제 실패: 회사 ID %d, %v", companyID, err)
+		}
+	}
+
+	//TODO 4. 저장된 파일 - DB에서 지운 뒤라 실패해도 접근 불가, 로그만 남김
+	for _, fileURL := range targets.fileURLs {
+		path, ok := _util.StaticFilePath(fileURL)
+		if !ok {
+			continue
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			log.Printf("회사 파일 삭제 실패: %s, %v", path, err)
+		}
+	}
+	//TODO 팀 채팅방 첨부파일은 채팅방 폴더째 삭제
+	for _, chatRoomId := range targets.chatRoomIds {
+		path := filepath.Join("static", "chats", fmt.Sprint(chatRoomId))
+		if err := os.RemoveAll(path); err != nil {
+			log.Printf("채팅방 파일 삭제 실패: %s, %v", path, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *companyOffboardingPersistence) getPurgeTargets(companyID uint) (*companyPurgeTargets, error) {
+	targets := &companyPurgeTargets{}
+
+	plucks := []struct {
+		dest  *[]uint
+		query string
+	}{
+		{&targets.memberIds, "SELECT user_id FROM company_memberships WHERE company_id = ?"},
+		{&targets.primaryIds, "SELECT user_id FROM user_profiles WHERE company_id = ?"},
+		{&targets.postIds, "SELECT id FROM posts WHERE company_id = ?"},
+		{&targets.commentIds, "SELECT c.id FROM comments c JOIN posts p ON p.id = c.post_id WHERE p.company_id = ?"},
+		{&targets.projectIds, "SELECT id FROM projects WHERE company_id = ?"},
+		{&targets.boardIds, "SELECT b.id FROM boards b JOIN projects p ON p.id = b.project_id WHERE p.company_id = ?"},
+		{&targets.chatRoomIds, "SELECT chat_room_id FROM teams WHERE company_id = ? AND chat_room_id IS NOT NULL"},
+	}
+	for _, p := range plucks {
+		ids := make([]uint, 0)
+		if err := r.db.Raw(p.query, companyID).Scan(&ids).Error; err != nil {
+			return nil, fmt.Errorf("회사 데이터 조회 중 DB 오류: %w", err)
+		}
+		*p.dest = ids
+	}
+
+	var fileURLs []string
+	if err := r.db.Raw(`
+		SELECT pi.image_url FROM post_images pi JOIN posts p ON p.id = pi.post_id WHERE p.company_id = ?
+		UNION ALL
+		SELECT d.file_url FROM company_verification_documents d
+		JOIN company_verifications v ON v.id = d.verification_id WHERE v.company_id = ?
+		UNION ALL
+		SELECT cp_logo FROM companies WHERE id = ? AND cp_logo <> ''`,
+		companyID, companyID, companyID).Scan(&fileURLs).Error; err != nil {
+		return nil, fmt.Errorf("회사 파일 조회 중 DB 오류: %w", err)
+	}
+	targets.fileURLs = fileURLs
+
+	return targets, nil
+}
@@ -7,6 +7,7 @@ import (
 	"link/internal/company/entity"
 	"link/internal/company/repository"
 	"reflect"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -70,15 +71,30 @@ func (r *companyPersistence) CreateCompany(company *entity.Company) (*entity.Com
 	return createdCompany, nil
 }
 
-// TODO 회사 삭제
-func (r *companyPersistence) DeleteCompany(companyID uint) error {
-	company := model.Company{ID: companyID}
-	result := r.db.Model(&company).Delete(&company)
+// TODO 회사 삭제 예약 - 이미 예약된 회사는 그대로
+func (r *companyPersistence) ScheduleCompanyDeletion(companyID uint, requestedAt time.Time, purgeAt time.Time) error {
+	result := r.db.Model(&model.Company{}).
+		Where("id = ? AND purge_scheduled_at IS NULL", companyID).
+		Updates(map[string]interface{}{"deletion_requested_at": requestedAt, "purge_scheduled_at": purgeAt})
 	if result.Error != nil {
-		return fmt.Errorf("회사 삭제 중 오류 발생: %w", result.Error)
+		return fmt.Errorf("회사 삭제 예약 중 오류 발생: %w", result.Error)
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("해당 ID의 회사를 찾을 수 없습니다: %d", companyID)
+		return fmt.Errorf("삭제 예약할 수 있는 회사를 찾을 수 없습니다: %d", companyID)
+	}
+	return nil
+}
+
+// TODO 회사 삭제 예약 취소
+func (r *companyPersistence) RestoreCompany(companyID uint) error {
+	result := r.db.Model(&model.Company{}).
+		Where("id = ? AND purge_scheduled_at IS NOT NULL", companyID).
+		Updates(map[string]interface{}{"deletion_requested_at": nil, "purge_scheduled_at": nil})
+	if result.Error != nil {
+		return fmt.Errorf("회사 복구 중 오류 발생: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("삭제 예정인 회사를 찾을 수 없습니다: %d", companyID)
 	}
 	return nil
 }
@@ -114,6 +130,8 @@ func (r *companyPersistence) GetCompanyByID(companyID uint) (*entity.Company, er
 		Departments:               departmentsMaps,
 		CreatedAt:                 company.CreatedAt,
 		UpdatedAt:                 company.UpdatedAt,
+		DeletionRequestedAt:       company.DeletionRequestedAt,
+		PurgeScheduledAt:          company.PurgeScheduledAt,
 	}
 
 	return &companyEntity, nil
@@ -139,7 +157,8 @@ func (r *companyPersistence) UpdateCompany(companyID uint, company *entity.Compa
 
 	//TODO 회사 정보 업데이트 하면 사용자정보에 레디스에 저장된 내용들도 변경되어야함
 
-	err = r.db.Save(&modelCompany).Error
+	// 업로드 용량은 IncreaseCompanyStorageUsage로만 변경
+	err = r.db.Omit("StorageUsedBytes").Save(&modelCompany).Error
 	if err != nil {
 		return fmt.Errorf("회사 업데이트 중 오류 발생: %w", err)
 	}
@@ -149,7 +168,7 @@ func (r *companyPersistence) UpdateCompany(companyID uint, company *entity.Compa
 
 func (r *companyPersistence) GetAllCompanies() ([]entity.Company, error) {
 	var companies []model.Company
-	err := r.db.Find(&companies).Error
+	err := r.db.Where("purge_scheduled_at IS NULL").Find(&companies).Error
 	if err != nil {
 		return nil, fmt.Errorf("회사 전체 조회 중 오류 발생: %w", err)
 	}
@@ -182,14 +201,14 @@ func (r *companyPersistence) SearchCompany(companyName string) ([]entity.Company
 
 	if len(companyName) <= 2 && len(companyName) > 0 {
 		err := r.db.
-			Where("cp_name ILIKE ?", "%"+companyName+"%").
+			Where("cp_name ILIKE ? AND purge_scheduled_at IS NULL", "%"+companyName+"%").
 			Find(&companies).Error
 		if err != nil {
 			return nil, fmt.Errorf("회사 검색 중 오류 발생: %w", err)
 		}
 	} else if len(companyName) > 2 {
 		err := r.db.
-			Where("cp_name % ?", companyName).
+			Where("cp_name % ? AND purge_scheduled_at IS NULL", companyName).
 			Find(&companies).Error
 		if err != nil {
 			return nil, fmt.Errorf("회사 검색 중 오류 발생: %w", err)
@@ -213,18 +232,86 @@ func (r *companyPersistence) SearchCompany(companyName string) ([]entity.Company
 	return companiesEntities, nil
 }
 
+// TODO 회사 요금제 사용량 조회
+func (r *companyPersistence) GetCompanyUsage(companyID uint) (*entity.CompanyUsage, error) {
+	var company model.Company
+	if err := r.db.Select("id, storage_used_bytes").Where("id = ?", companyID).First(&company).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("회사를 찾을 수 없습니다: ID %d", companyID)
+		}
+		return nil, fmt.Errorf("회사 조회 중 오류 발생: %w", err)
+	}
+
+	usage := &entity.CompanyUsage{StorageUsedBytes: company.StorageUsedBytes}
+
+	if err := r.db.Model(&model.CompanyMembership{}).
+		Where("company_id = ?", companyID).
+		Count(&usage.MemberCount).Error; err != nil {
+		return nil, fmt.Errorf("회사 인원 수 조회 중 오류 발생: %w", err)
+	}
+
+	if err := r.db.Model(&model.Project{}).
+		Where("company_id = ?", companyID).
+		Count(&usage.ProjectCount).Error; err != nil {
+		return nil, fmt.Errorf("회사 프로젝트 수 조회 중 오류 발생: %w", err)
+	}
+
+	if err := r.db.Raw(`
+		SELECT COALESCE(MAX(board_count), 0) FROM (
+			SELECT COUNT(boards.id) AS board_count
+			FROM boards
+			JOIN projects ON projects.id = boards.project_id
+			WHERE projects.company_id = ?
+			GROUP BY boards.project_id
+		) AS project_boards`, companyID).
+		Scan(&usage.MaxProjectBoardCount).Error; err != nil {
+		return nil, fmt.Errorf("프로젝트별 보드 수 조회 중 오류 발생: %w", err)
+	}
+
+	return usage, nil
+}
+
+// TODO 회사 업로드 용량 증가
+func (r *companyPersistence) IncreaseCompanyStorageUsage(companyID uint, bytes int64) error {
+	result := r.db.Model(&model.Company{}).
+		Where("id = ?", companyID).
+		UpdateColumn("storage_used_bytes", gorm.Expr("storage_used_bytes + ?", bytes))
+	if result.Error != nil {
+		return fmt.Errorf("회사 업로드 용량 갱신 중 오류 발생: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("회사를 찾을 수 없습니다: ID %d", companyID)
+	}
+	return nil
+}
+
+// TODO 회사 업로드 용량 감소 - 0 아래로 내려가지 않음
+func (r *companyPersistence) DecreaseCompanyStorageUsage(companyID uint, bytes int64) error {
+	result := r.db.Model(&model.Company{}).
+		Where("id = ?", companyID).
+		UpdateColumn("storage_used_bytes", gorm.Expr("GREATEST(storage_used_bytes - ?, 0)", bytes))
+	if result.Error != nil {
+		return fmt.Errorf("회사 업로드 용량 갱신 중 오류 발생: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("회사를 찾을 수 없습니다: ID %d", companyID)
+	}
+	return nil
+}
+
 func (r *companyPersistence) GetCompanyPositionByID(positionID uint) (*entity.Position, error) {
 	var position model.Position
 	err := r.db.Where("id = ?", positionID).First(&position).Error
 	if err != nil {
 		return nil, fmt.Errorf("회사 직책 조회 중 오류 발생: %w", err)
 	}
-	return &entity.Position{ID: position.ID, Name: position.Name, CompanyID: position.CompanyID, CreatedAt: position.CreatedAt, UpdatedAt: position.UpdatedAt}, nil
+	return &entity.Position{ID: position.ID, Name: position.Name, CompanyID: position.CompanyID, CreatedAt: position.CreatedAt, UpdatedAt: position.UpdatedAt, Rank: position.Rank}, nil
 }
 
 func (r *companyPersistence) GetCompanyPositionList(companyID uint) ([]entity.Position, error) {
 	var positions []model.Position
-	err := r.db.Where("company_id = ?", companyID).Find(&positions).Error
+	//TODO 높은 직급부터
+	err := r.db.Where("company_id = ?", companyID).Order("rank DESC, id ASC").Find(&positions).Error
 	if err != nil {
 		return nil, fmt.Errorf("회사 직책 리스트 조회 중 오류 발생: %w", err)
 	}
@@ -237,6 +324,7 @@ func (r *companyPersistence) GetCompanyPositionList(companyID uint) ([]entity.Po
 			CompanyID: position.CompanyID,
 			CreatedAt: position.CreatedAt,
 			UpdatedAt: position.UpdatedAt,
+			Rank:      position.Rank,
 		}
 	}
 
@@ -248,6 +336,7 @@ func (r *companyPersistence) CreateCompanyPosition(position *entity.Position) er
 	modelPosition := &model.Position{
 		Name:      position.Name,
 		CompanyID: position.CompanyID,
+		Rank:      position.Rank,
 	}
 
 	err := r.db.Create(modelPosition).Error
@@ -0,0 +1,191 @@
+package persistence
+
+import (
+	"fmt"
+	"link/infrastructure/model"
+	"link/internal/company/entity"
+	"link/internal/company/repository"
+
+	"gorm.io/gorm"
+)
+
+type companyReportingPersistence struct {
+	db *gorm.DB
+}
+
+func NewCompanyReportingPersistence(db *gorm.DB) repository.CompanyReportingRepository {
+	return &companyReportingPersistence{db: db}
+}
+
+type reportingMemberRow struct {
+	UserID       uint
+	Name         string
+	Nickname     string
+	Email        string
+	Image        *string
+	PositionID   *uint
+	PositionName string
+	PositionRank int
+	ManagerID    *uint
+}
+
+// 높은 직급부터, 같은 직급은 이름순
+const reportingMemberOrder = "position_rank DESC, users.name ASC, company_memberships.user_id ASC"
+
+func reportingMemberQuery(db *gorm.DB) *gorm.DB {
+	return db.Table("company_memberships").
+		Select(`company_memberships.user_id, users.name, users.nickname, users.email, user_profiles.image,
+			company_memberships.position_id, COALESCE(positions.name, '') AS position_name,
+			COALESCE(positions.rank, 0) AS position_rank, company_memberships.manager_id`).
+		Joins("JOIN users ON users.id = company_memberships.user_id").
+		Joins("LEFT JOIN user_profiles ON user_profiles.user_id = company_memberships.user_id").
+		Joins("LEFT JOIN positions ON positions.id = company_memberships.position_id")
+}
+
+func (r *companyReportingPersistence) GetReportingMember(companyID uint, userID uint) (*entity.ReportingMember, error) {
+	var rows []reportingMemberRow
+	if err := reportingMemberQuery(r.db).
+		Where("company_memberships.company_id = ? AND company_memberships.user_id = ?", companyID, userID).
+		Limit(1).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("회사 구성원 조회 중 DB 오류: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	member := toReportingMemberEntity(rows[0])
+	return &member, nil
+}
+
+func (r *companyReportingPersistence) GetReportingMembers(companyID uint) ([]entity.ReportingMember, error) {
+	var rows []reportingMemberRow
+	if err := reportingMemberQuery(r.db).
+		Where("company_memberships.company_id = ?", companyID).
+		Order(reportingMemberOrder).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("회사 구성원 조회 중 DB 오류: %w", err)
+	}
+	return toReportingMemberEntities(rows), nil
+}
+
+func (r *companyReportingPersistence) GetDirectReports(companyID uint, managerID uint) ([]entity.ReportingMember, error) {
+	var rows []reportingMemberRow
+	if err := reportingMemberQuery(r.db).
+		Where("company_memberships.company_id = ? AND company_memberships.manager_id = ?", companyID, managerID).
+		Order(reportingMemberOrder).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("직속 부하 조회 중 DB 오류: %w", err)
+	}
+	return toReportingMemberEntities(rows), nil
+}
+
+// GetManagementChain 직속 상급자부터 최상위 상급자까지 (본인 제외)
+func (r *companyReportingPersistence) GetManagementChain(companyID uint, userID uint) ([]entity.ReportingMember, error) {
+	userIds, err := getManagementChainIds(r.db, companyID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(userIds) == 0 {
+		return []entity.ReportingMember{}, nil
+	}
+
+	var rows []reportingMemberRow
+	if err := reportingMemberQuery(r.db).
+		Where("company_memberships.company_id = ? AND company_memberships.user_id IN ?", companyID, userIds).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("상급자 조회 중 DB 오류: %w", err)
+	}
+
+	byId := make(map[uint]reportingMemberRow, len(rows))
+	for _, row := range rows {
+		byId[row.UserID] = row
+	}
+	chain := make([]entity.ReportingMember, 0, len(userIds))
+	for _, id := range userIds {
+		if row, ok := byId[id]; ok {
+			chain = append(chain, toReportingMemberEntity(row))
+		}
+	}
+	return chain, nil
+}
+
+func getManagementChainIds(db *gorm.DB, companyID uint, userID uint) ([]uint, error) {
+	var ids []uint
+	// 데이터가 꼬여 순환이 생겨도 depth 제한으로 무한 재귀하지 않음
+	if err := db.Raw(`
+		WITH RECURSIVE chain AS (
+			SELECT manager_id AS user_id, 1 AS depth FROM company_memberships
+			WHERE company_id = ? AND user_id = ? AND manager_id IS NOT NULL
+			UNION
+			SELECT m.manager_id, c.depth + 1 FROM company_memberships m
+			JOIN chain c ON m.user_id = c.user_id
+			WHERE m.company_id = ? AND m.manager_id IS NOT NULL AND c.depth < 100
+		)
+		SELECT user_id FROM chain GROUP BY user_id ORDER BY MIN(depth)
+	`, companyID, userID, companyID).Scan(&ids).Error; err != nil {
+		return nil, fmt.Errorf("상급자 조회 중 DB 오류: %w", err)
+	}
+	return ids, nil
+}
+
+func (r *companyReportingPersistence) UpdateManager(companyID uint, userID uint, managerID *uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// 동시에 다른 변경 요청이 들어와 순환이 생기지 않도록 회사 구성원 행 잠금
+		if err := tx.Exec("SELECT user_id FROM company_memberships WHERE company_id = ? FOR UPDATE", companyID).Error; err != nil {
+			return fmt.Errorf("회사 구성원 잠금 중 DB 오류: %w", err)
+		}
+
+		if managerID != nil {
+			chainIds, err := getManagementChainIds(tx, companyID, *managerID)
+			if err != nil {
+				return err
+			}
+			for _, id := range chainIds {
+				if id == userID {
+					return fmt.Errorf("보고 라인에 순환이 생깁니다: 사용자 ID %d, 상급자 ID %d", userID, *managerID)
+				}
+			}
+		}
+
+		result := tx.Model(&model.CompanyMembership{}).
+			Where("company_id = ? AND user_id = ?", companyID, userID).
+			Update("manager_id", managerID)
+		if result.Error != nil {
+			return fmt.Errorf("상급자 변경 중 DB 오류: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("회사 구성원을 찾을 수 없습니다: 사용자 ID %d", userID)
+		}
+
+		//TODO 대표 회사면 user_profiles 에도 반영
+		if err := tx.Model(&model.UserProfile{}).
+			Where("user_id = ? AND company_id = ?", userID, companyID).
+			Update("manager_id", managerID).Error; err != nil {
+			return fmt.Errorf("사용자 프로필 상급자 변경 중 DB 오류: %w", err)
+		}
+		return nil
+	})
+}
+
+func toReportingMemberEntities(rows []reportingMemberRow) []entity.ReportingMember {
+	members := make([]entity.ReportingMember, len(rows))
+	for i, row := range rows {
+		members[i] = toReportingMemberEntity(row)
+	}
+	return members
+}
+
+func toReportingMemberEntity(row reportingMemberRow) entity.ReportingMember {
+	return entity.ReportingMember{
+		UserID:       row.UserID,
+		Name:         row.Name,
+		Nickname:     row.Nickname,
+		Email:        row.Email,
+		Image:        row.Image,
+		PositionID:   row.PositionID,
+		PositionName: row.PositionName,
+		PositionRank: row.PositionRank,
+		ManagerID:    row.ManagerID,
+	}
+}
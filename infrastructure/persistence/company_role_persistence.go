@@ -0,0 +1,184 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+	"link/infrastructure/model"
+	"link/internal/company/entity"
+	"link/internal/company/repository"
+
+	"gorm.io/gorm"
+)
+
+type companyRolePersistence struct {
+	db *gorm.DB
+}
+
+func NewCompanyRolePersistence(db *gorm.DB) repository.CompanyRoleRepository {
+	return &companyRolePersistence{db: db}
+}
+
+func (r *companyRolePersistence) CreateCompanyRole(role *entity.CompanyRole) error {
+	modelRole := &model.CompanyRole{
+		CompanyID:   role.CompanyID,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: toCompanyRolePermissionModels(0, role.Permissions),
+	}
+
+	if err := r.db.Create(modelRole).Error; err != nil {
+		return fmt.Errorf("회사 역할 생성 중 DB 오류: %w", err)
+	}
+
+	role.ID = modelRole.ID
+	role.CreatedAt = modelRole.CreatedAt
+	role.UpdatedAt = modelRole.UpdatedAt
+	return nil
+}
+
+func (r *companyRolePersistence) GetCompanyRoleByID(roleID uint) (*entity.CompanyRole, error) {
+	var role model.CompanyRole
+	if err := r.db.Preload("Permissions").Where("id = ?", roleID).First(&role).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("회사 역할을 찾을 수 없습니다: ID %d", roleID)
+		}
+		return nil, fmt.Errorf("회사 역할 조회 중 DB 오류: %w", err)
+	}
+
+	memberCounts, err := r.getMemberCounts([]uint{role.ID})
+	if err != nil {
+		return nil, err
+	}
+	return toCompanyRoleEntity(role, memberCounts[role.ID]), nil
+}
+
+func (r *companyRolePersistence) GetCompanyRolesByCompany(companyID uint) ([]entity.CompanyRole, error) {
+	var roles []model.CompanyRole
+	if err := r.db.Preload("Permissions").
+		Where("company_id = ?", companyID).
+		Order("name ASC").
+		Find(&roles).Error; err != nil {
+		return nil, fmt.Errorf("회사 역할 목록 조회 중 DB 오류: %w", err)
+	}
+
+	roleIds := make([]uint, len(roles))
+	for i, role := range roles {
+		roleIds[i] = role.ID
+	}
+	memberCounts, err := r.getMemberCounts(roleIds)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]entity.CompanyRole, len(roles))
+	for i, role := range roles {
+		result[i] = *toCompanyRoleEntity(role, memberCounts[role.ID])
+	}
+	return result, nil
+}
+
+func (r *companyRolePersistence) ExistsCompanyRoleName(companyID uint, name string, excludeRoleID uint) (bool, error) {
+	var count int64
+	if err := r.db.Model(&model.CompanyRole{}).
+		Where("company_id = ? AND LOWER(name) = LOWER(?) AND id <> ?", companyID, name, excludeRoleID).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("회사 역할 이름 조회 중 DB 오류: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (r *companyRolePersistence) UpdateCompanyRole(role *entity.CompanyRole) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.CompanyRole{}).
+			Where("id = ?", role.ID).
+			Updates(map[string]interface{}{
+				"name":        role.Name,
+				"description": role.Description,
+			}).Error; err != nil {
+			return fmt.Errorf("회사 역할 수정 중 DB 오류: %w", err)
+		}
+
+		// 권한 목록은 통째로 교체
+		if err := tx.Where("role_id = ?", role.ID).Delete(&model.CompanyRolePermission{}).Error; err != nil {
+			return fmt.Errorf("회사 역할 권한 삭제 중 DB 오류: %w", err)
+		}
+		permissions := toCompanyRolePermissionModels(role.ID, role.Permissions)
+		if len(permissions) > 0 {
+			if err := tx.Create(&permissions).Error; err != nil {
+				return fmt.Errorf("회사 역할 권한 저장 중 DB 오류: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *companyRolePersistence) DeleteCompanyRole(roleID uint) error {
+	// 역할을 부여받은 사용자는 FK(ON DELETE SET NULL)로 역할이 해제됨
+	if err := r.db.Where("id = ?", roleID).Delete(&model.CompanyRole{}).Error; err != nil {
+		return fmt.Errorf("회사 역할 삭제 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+func (r *companyRolePersistence) AssignCompanyRole(userID uint, companyID uint, roleID *uint) error {
+	result := r.db.Model(&model.CompanyMembership{}).
+		Where("user_id = ? AND company_id = ?", userID, companyID).
+		Update("custom_role_id", roleID)
+	if result.Error != nil {
+		return fmt.Errorf("회사 역할 부여 중 DB 오류: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("회사에 소속되지 않은 사용자입니다: 사용자 ID %d, 회사 ID %d", userID, companyID)
+	}
+	return nil
+}
+
+func (r *companyRolePersistence) getMemberCounts(roleIds []uint) (map[uint]int64, error) {
+	result := make(map[uint]int64)
+	if len(roleIds) == 0 {
+		return result, nil
+	}
+
+	var counts []struct {
+		CustomRoleID uint
+		Count        int64
+	}
+	if err := r.db.Model(&model.CompanyMembership{}).
+		Select("custom_role_id, COUNT(*) AS count").
+		Where("custom_role_id IN ?", roleIds).
+		Group("custom_role_id").
+		Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("회사 역할 사용자 수 조회 중 DB 오류: %w", err)
+	}
+
+	for _, count := range counts {
+		result[count.CustomRoleID] = count.Count
+	}
+	return result, nil
+}
+
+func toCompanyRolePermissionModels(roleID uint, permissions []string) []model.CompanyRolePermission {
+	result := make([]model.CompanyRolePermission, len(permissions))
+	for i, permission := range permissions {
+		result[i] = model.CompanyRolePermission{RoleID: roleID, Permission: permission}
+	}
+	return result
+}
+
+func toCompanyRoleEntity(role model.CompanyRole, memberCount int64) *entity.CompanyRole {
+	permissions := make([]string, len(role.Permissions))
+	for i, permission := range role.Permissions {
+		permissions[i] = permission.Permission
+	}
+
+	return &entity.CompanyRole{
+		ID:          role.ID,
+		CompanyID:   role.CompanyID,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: permissions,
+		MemberCount: memberCount,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
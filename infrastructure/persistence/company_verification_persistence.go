@@ -0,0 +1,233 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+	"link/infrastructure/model"
+	"link/internal/company/entity"
+	"link/internal/company/repository"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type companyVerificationPersistence struct {
+	db *gorm.DB
+}
+
+func NewCompanyVerificationPersistence(db *gorm.DB) repository.CompanyVerificationRepository {
+	return &companyVerificationPersistence{db: db}
+}
+
+func (r *companyVerificationPersistence) CreateCompanyVerification(verification *entity.CompanyVerification) error {
+	documents := make([]model.CompanyVerificationDocument, len(verification.Documents))
+	for i, document := range verification.Documents {
+		documents[i] = model.CompanyVerificationDocument{
+			FileURL:  document.FileURL,
+			FileName: document.FileName,
+		}
+	}
+
+	modelVerification := &model.CompanyVerification{
+		CompanyID:   verification.CompanyID,
+		RequesterID: verification.RequesterID,
+		CpNumber:    verification.CpNumber,
+		Status:      model.CompanyVerificationPending,
+		Documents:   documents,
+	}
+
+	if err := r.db.Create(modelVerification).Error; err != nil {
+		return fmt.Errorf("회사 인증 요청 생성 중 DB 오류: %w", err)
+	}
+
+	verification.ID = modelVerification.ID
+	verification.Status = string(modelVerification.Status)
+	verification.CreatedAt = modelVerification.CreatedAt
+	verification.UpdatedAt = modelVerification.UpdatedAt
+	return nil
+}
+
+func (r *companyVerificationPersistence) GetCompanyVerificationByID(verificationID uint) (*entity.CompanyVerification, error) {
+	var verification model.CompanyVerification
+	err := r.verificationQuery().Where("id = ?", verificationID).First(&verification).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("회사 인증 요청을 찾을 수 없습니다: ID %d", verificationID)
+		}
+		return nil, fmt.Errorf("회사 인증 요청 조회 중 DB 오류: %w", err)
+	}
+
+	return toCompanyVerificationEntity(verification), nil
+}
+
+// GetPendingCompanyVerification 대기중인 요청이 없으면 nil 반환
+func (r *companyVerificationPersistence) GetPendingCompanyVerification(companyID uint) (*entity.CompanyVerification, error) {
+	var verification model.CompanyVerification
+	err := r.verificationQuery().
+		Where("company_id = ? AND status = ?", companyID, model.CompanyVerificationPending).
+		First(&verification).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("회사 인증 요청 조회 중 DB 오류: %w", err)
+	}
+
+	return toCompanyVerificationEntity(verification), nil
+}
+
+func (r *companyVerificationPersistence) GetCompanyVerificationsByCompany(companyID uint) ([]entity.CompanyVerification, error) {
+	var verifications []model.CompanyVerification
+	if err := r.verificationQuery().
+		Where("company_id = ?", companyID).
+		Order("created_at DESC").
+		Find(&verifications).Error; err != nil {
+		return nil, fmt.Errorf("회사 인증 요청 목록 조회 중 DB 오류: %w", err)
+	}
+
+	result := make([]entity.CompanyVerification, len(verifications))
+	for i, verification := range verifications {
+		result[i] = *toCompanyVerificationEntity(verification)
+	}
+	return result, nil
+}
+
+// GetCompanyVerificationsByStatus 운영자 검토 목록 - 오래된 요청부터
+func (r *companyVerificationPersistence) GetCompanyVerificationsByStatus(status string, page int, limit int) ([]entity.CompanyVerification, int64, error) {
+	var totalCount int64
+	if err := r.db.Model(&model.CompanyVerification{}).
+		Where("status = ?", status).
+		Count(&totalCount).Error; err != nil {
+		return nil, 0, fmt.Errorf("회사 인증 요청 개수 조회 중 DB 오류: %w", err)
+	}
+
+	var verifications []model.CompanyVerification
+	if err := r.verificationQuery().
+		Where("status = ?", status).
+		Order("created_at ASC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&verifications).Error; err != nil {
+		return nil, 0, fmt.Errorf("회사 인증 요청 목록 조회 중 DB 오류: %w", err)
+	}
+
+	result := make([]entity.CompanyVerification, len(verifications))
+	for i, verification := range verifications {
+		result[i] = *toCompanyVerificationEntity(verification)
+	}
+	return result, totalCount, nil
+}
+
+func (r *companyVerificationPersistence) GetCompanyVerificationDocumentByID(documentID uint) (*entity.CompanyVerificationDocument, error) {
+	var document model.CompanyVerificationDocument
+	if err := r.db.Where("id = ?", documentID).First(&document).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("회사 인증 서류를 찾을 수 없습니다: ID %d", documentID)
+		}
+		return nil, fmt.Errorf("회사 인증 서류 조회 중 DB 오류: %w", err)
+	}
+
+	return &entity.CompanyVerificationDocument{
+		ID:             document.ID,
+		VerificationID: document.VerificationID,
+		FileURL:        document.FileURL,
+		FileName:       document.FileName,
+	}, nil
+}
+
+func (r *companyVerificationPersistence) ExistsCpNumber(cpNumber string, excludeCompanyID uint) (bool, error) {
+	var count int64
+	if err := r.db.Model(&model.Company{}).
+		Where("cp_number = ? AND id <> ?", cpNumber, excludeCompanyID).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("사업자등록번호 조회 중 DB 오류: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (r *companyVerificationPersistence) ReviewCompanyVerification(verificationID uint, reviewerID uint, status string, reason string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var verification model.CompanyVerification
+		if err := tx.Where("id = ?", verificationID).First(&verification).Error; err != nil {
+			return fmt.Errorf("회사 인증 요청 조회 중 DB 오류: %w", err)
+		}
+
+		now := time.Now()
+		// 동시에 두 운영자가 처리하지 않도록 대기중인 요청만 변경
+		result := tx.Model(&model.CompanyVerification{}).
+			Where("id = ? AND status = ?", verificationID, model.CompanyVerificationPending).
+			Updates(map[string]interface{}{
+				"status":      status,
+				"reason":      reason,
+				"reviewer_id": reviewerID,
+				"reviewed_at": now,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("회사 인증 요청 처리 중 DB 오류: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("이미 처리된 회사 인증 요청입니다: ID %d", verificationID)
+		}
+
+		if status != string(model.CompanyVerificationApproved) {
+			return nil
+		}
+
+		if err := tx.Model(&model.Company{}).
+			Where("id = ?", verification.CompanyID).
+			Updates(map[string]interface{}{
+				"is_verified": true,
+				"cp_number":   verification.CpNumber,
+			}).Error; err != nil {
+			return fmt.Errorf("회사 인증 처리 중 DB 오류: %w", err)
+		}
+
+		// 이미 Pro 등급인 회사는 낮추지 않음
+		if err := tx.Model(&model.Company{}).
+			Where("id = ? AND grade < ?", verification.CompanyID, model.CompanyGradeBasic).
+			Update("grade", model.CompanyGradeBasic).Error; err != nil {
+			return fmt.Errorf("회사 등급 변경 중 DB 오류: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *companyVerificationPersistence) verificationQuery() *gorm.DB {
+	return r.db.
+		Preload("Documents").
+		Preload("Company", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, cp_name")
+		}).
+		Preload("Requester", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, name")
+		})
+}
+
+func toCompanyVerificationEntity(verification model.CompanyVerification) *entity.CompanyVerification {
+	documents := make([]entity.CompanyVerificationDocument, len(verification.Documents))
+	for i, document := range verification.Documents {
+		documents[i] = entity.CompanyVerificationDocument{
+			ID:             document.ID,
+			VerificationID: document.VerificationID,
+			FileURL:        document.FileURL,
+			FileName:       document.FileName,
+		}
+	}
+
+	return &entity.CompanyVerification{
+		ID:            verification.ID,
+		CompanyID:     verification.CompanyID,
+		CompanyName:   verification.Company.CpName,
+		RequesterID:   verification.RequesterID,
+		RequesterName: verification.Requester.Name,
+		CpNumber:      verification.CpNumber,
+		Status:        string(verification.Status),
+		Reason:        verification.Reason,
+		ReviewerID:    verification.ReviewerID,
+		ReviewedAt:    verification.ReviewedAt,
+		Documents:     documents,
+		CreatedAt:     verification.CreatedAt,
+		UpdatedAt:     verification.UpdatedAt,
+	}
+}
@@ -1,30 +1,36 @@
 package persistence
 
 import (
+	"context"
 	"fmt"
 	"link/infrastructure/model"
 	"link/internal/department/entity"
 	"link/internal/department/repository"
+	"log"
 
+	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
 )
 
 type departmentPersistence struct {
-	db *gorm.DB
+	db          *gorm.DB
+	redisClient *redis.Client
 }
 
-func NewDepartmentPersistence(db *gorm.DB) repository.DepartmentRepository {
-	return &departmentPersistence{db: db}
+func NewDepartmentPersistence(db *gorm.DB, redisClient *redis.Client) repository.DepartmentRepository {
+	return &departmentPersistence{db: db, redisClient: redisClient}
 }
 
 func (p *departmentPersistence) CreateDepartment(department *entity.Department) error {
 	var departmentModel model.Department
 	departmentModel.Name = department.Name
 	departmentModel.CompanyID = department.CompanyID
+	departmentModel.ParentID = department.ParentID
 
 	if err := p.db.Create(&departmentModel).Error; err != nil {
 		return fmt.Errorf("department 생성 중 DB 오류: %w", err)
 	}
+	department.ID = departmentModel.ID
 	return nil
 }
 
@@ -42,6 +48,7 @@ func (p *departmentPersistence) GetDepartments(companyId uint) ([]entity.Departm
 			Name:               dept.Name,
 			CompanyID:          dept.CompanyID,
 			DepartmentLeaderID: dept.DepartmentLeaderID,
+			ParentID:           dept.ParentID,
 			CreatedAt:          dept.CreatedAt,
 			UpdatedAt:          dept.UpdatedAt,
 		})
@@ -106,12 +113,272 @@ func (p *departmentPersistence) UpdateDepartment(companyId uint, departmentID ui
 }
 
 func (p *departmentPersistence) DeleteDepartment(companyId uint, departmentID uint) error {
-	if err := p.db.Where("id = ?", departmentID).Where("company_id = ?", companyId).Delete(&model.Department{}).Error; err != nil {
-		return fmt.Errorf("department 삭제 중 DB 오류: %w", err)
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		var department model.Department
+		if err := tx.Where("id = ? AND company_id = ?", departmentID, companyId).First(&department).Error; err != nil {
+			return fmt.Errorf("department 조회 중 DB 오류: %w", err)
+		}
+
+		// 하위 부서는 삭제되는 부서의 상위 부서로 올림
+		if err := tx.Model(&model.Department{}).
+			Where("parent_id = ? AND company_id = ?", departmentID, companyId).
+			Update("parent_id", department.ParentID).Error; err != nil {
+			return fmt.Errorf("하위 department 이동 중 DB 오류: %w", err)
+		}
+
+		if err := tx.Delete(&department).Error; err != nil {
+			return fmt.Errorf("department 삭제 중 DB 오류: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetSubDepartmentIds 해당 부서와 모든 하위 부서 ID (자기 자신 포함)
+func (p *departmentPersistence) GetSubDepartmentIds(companyId uint, departmentID uint) ([]uint, error) {
+	return getSubDepartmentIds(p.db, companyId, departmentID)
+}
+
+func getSubDepartmentIds(db *gorm.DB, companyId uint, departmentID uint) ([]uint, error) {
+	var ids []uint
+	// UNION은 중복을 제거하므로 데이터가 꼬여 순환이 생겨도 무한 재귀하지 않음
+	if err := db.Raw(`
+		WITH RECURSIVE sub_departments AS (
+			SELECT id FROM departments WHERE id = ? AND company_id = ?
+			UNION
+			SELECT d.id FROM departments d
+			JOIN sub_departments s ON d.parent_id = s.id
+			WHERE d.company_id = ?
+		)
+		SELECT id FROM sub_departments
+	`, departmentID, companyId, companyId).Scan(&ids).Error; err != nil {
+		return nil, fmt.Errorf("하위 department 조회 중 DB 오류: %w", err)
+	}
+	return ids, nil
+}
+
+func (p *departmentPersistence) MoveDepartment(companyId uint, departmentID uint, parentID *uint) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		// 동시에 다른 이동 요청이 들어와 순환이 생기지 않도록 회사 부서 행 잠금
+		if err := tx.Exec("SELECT id FROM departments WHERE company_id = ? FOR UPDATE", companyId).Error; err != nil {
+			return fmt.Errorf("department 잠금 중 DB 오류: %w", err)
+		}
+
+		if parentID != nil {
+			subIds, err := getSubDepartmentIds(tx, companyId, departmentID)
+			if err != nil {
+				return err
+			}
+			for _, id := range subIds {
+				if id == *parentID {
+					return fmt.Errorf("하위 부서로 이동할 수 없습니다: %d", *parentID)
+				}
+			}
+		}
+
+		result := tx.Model(&model.Department{}).
+			Where("id = ? AND company_id = ?", departmentID, companyId).
+			Update("parent_id", parentID)
+		if result.Error != nil {
+			return fmt.Errorf("department 이동 중 DB 오류: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("department을 찾을 수 없습니다: %d", departmentID)
+		}
+		return nil
+	})
+}
+
+func (p *departmentPersistence) ExistsDepartmentName(companyId uint, name string, excludeDepartmentID uint) (bool, error) {
+	var count int64
+	if err := p.db.Model(&model.Department{}).
+		Where("company_id = ? AND name = ? AND id <> ?", companyId, name, excludeDepartmentID).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("department 이름 조회 중 DB 오류: %w", err)
 	}
+	return count > 0, nil
+}
+
+func (p *departmentPersistence) GetDepartmentUserIds(departmentID uint) ([]uint, error) {
+	var userIds []uint
+	if err := p.db.Table("user_profile_departments").
+		Where("department_id = ?", departmentID).
+		Order("user_profile_user_id").
+		Pluck("user_profile_user_id", &userIds).Error; err != nil {
+		return nil, fmt.Errorf("department 구성원 조회 중 DB 오류: %w", err)
+	}
+	return userIds, nil
+}
+
+func (p *departmentPersistence) GetDepartmentPostIds(departmentID uint) ([]uint, error) {
+	var postIds []uint
+	if err := p.db.Table("post_departments").
+		Where("department_id = ?", departmentID).
+		Order("post_id").
+		Pluck("post_id", &postIds).Error; err != nil {
+		return nil, fmt.Errorf("department 게시물 조회 중 DB 오류: %w", err)
+	}
+	return postIds, nil
+}
+
+func (p *departmentPersistence) GetChildDepartmentIds(companyId uint, departmentID uint) ([]uint, error) {
+	var ids []uint
+	if err := p.db.Model(&model.Department{}).
+		Where("parent_id = ? AND company_id = ?", departmentID, companyId).
+		Order("id").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("하위 department 조회 중 DB 오류: %w", err)
+	}
+	return ids, nil
+}
+
+// MergeDepartment source 부서의 구성원, 게시물, 하위 부서를 target 부서로 옮기고 source 부서 삭제
+func (p *departmentPersistence) MergeDepartment(companyId uint, sourceDepartmentID uint, targetDepartmentID uint) error {
+	var movedUserIds []uint
+
+	err := p.db.Transaction(func(tx *gorm.DB) error {
+		var source model.Department
+		if err := tx.Where("id = ? AND company_id = ?", sourceDepartmentID, companyId).First(&source).Error; err != nil {
+			return fmt.Errorf("통합할 department 조회 중 DB 오류: %w", err)
+		}
+		var target model.Department
+		if err := tx.Where("id = ? AND company_id = ?", targetDepartmentID, companyId).First(&target).Error; err != nil {
+			return fmt.Errorf("대상 department 조회 중 DB 오류: %w", err)
+		}
+
+		if err := tx.Table("user_profile_departments").
+			Where("department_id = ?", sourceDepartmentID).
+			Pluck("user_profile_user_id", &movedUserIds).Error; err != nil {
+			return fmt.Errorf("department 구성원 조회 중 DB 오류: %w", err)
+		}
+
+		// 1. 구성원 이동 (이미 target 소속인 사용자는 중복 생성하지 않음)
+		if err := tx.Exec(`
+			INSERT INTO user_profile_departments (user_profile_user_id, department_id)
+			SELECT user_profile_user_id, ? FROM user_profile_departments WHERE department_id = ?
+			ON CONFLICT DO NOTHING
+		`, targetDepartmentID, sourceDepartmentID).Error; err != nil {
+			return fmt.Errorf("department 구성원 이동 중 DB 오류: %w", err)
+		}
+		if err := tx.Exec("DELETE FROM user_profile_departments WHERE department_id = ?", sourceDepartmentID).Error; err != nil {
+			return fmt.Errorf("department 구성원 삭제 중 DB 오류: %w", err)
+		}
+
+		// 2. 부서 게시물 대상 변경
+		if err := tx.Exec(`
+			INSERT INTO post_departments (post_id, department_id)
+			SELECT post_id, ? FROM post_departments WHERE department_id = ?
+			ON CONFLICT DO NOTHING
+		`, targetDepartmentID, sourceDepartmentID).Error; err != nil {
+			return fmt.Errorf("department 게시물 이동 중 DB 오류: %w", err)
+		}
+		if err := tx.Exec("DELETE FROM post_departments WHERE department_id = ?", sourceDepartmentID).Error; err != nil {
+			return fmt.Errorf("department 게시물 삭제 중 DB 오류: %w", err)
+		}
+
+		// 3. 하위 부서는 target 밑으로
+		if err := tx.Model(&model.Department{}).
+			Where("parent_id = ? AND company_id = ?", sourceDepartmentID, companyId).
+			Update("parent_id", targetDepartmentID).Error; err != nil {
+			return fmt.Errorf("하위 department 이동 중 DB 오류: %w", err)
+		}
+
+		// 4. target에 부서장이 없으면 source 부서장을 이어받음
+		if target.DepartmentLeaderID == nil && source.DepartmentLeaderID != nil {
+			if err := tx.Model(&target).Update("department_leader_id", source.DepartmentLeaderID).Error; err != nil {
+				return fmt.Errorf("department 부서장 변경 중 DB 오류: %w", err)
+			}
+		}
+
+		if err := tx.Delete(&source).Error; err != nil {
+			return fmt.Errorf("department 삭제 중 DB 오류: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	p.invalidateUserDepartmentsCache(movedUserIds)
+	return nil
+}
+
+// SplitDepartment source 부서에서 일부 구성원과 게시물을 새 부서로 분리
+func (p *departmentPersistence) SplitDepartment(companyId uint, sourceDepartmentID uint, department *entity.Department, userIds []uint, postIds []uint) error {
+	err := p.db.Transaction(func(tx *gorm.DB) error {
+		var source model.Department
+		if err := tx.Where("id = ? AND company_id = ?", sourceDepartmentID, companyId).First(&source).Error; err != nil {
+			return fmt.Errorf("분리할 department 조회 중 DB 오류: %w", err)
+		}
+
+		newDepartment := model.Department{
+			Name:               department.Name,
+			CompanyID:          companyId,
+			ParentID:           department.ParentID,
+			DepartmentLeaderID: department.DepartmentLeaderID,
+		}
+		if err := tx.Create(&newDepartment).Error; err != nil {
+			return fmt.Errorf("department 생성 중 DB 오류: %w", err)
+		}
+		department.ID = newDepartment.ID
+		department.CompanyID = companyId
+
+		if len(userIds) > 0 {
+			result := tx.Exec(`
+				UPDATE user_profile_departments SET department_id = ?
+				WHERE department_id = ? AND user_profile_user_id IN (?)
+			`, newDepartment.ID, sourceDepartmentID, userIds)
+			if result.Error != nil {
+				return fmt.Errorf("department 구성원 이동 중 DB 오류: %w", result.Error)
+			}
+			if result.RowsAffected != int64(len(userIds)) {
+				return fmt.Errorf("분리할 구성원 중 해당 부서 소속이 아닌 사용자가 있습니다")
+			}
+
+			// 분리되는 부서장은 source 부서장에서 해제
+			if source.DepartmentLeaderID != nil {
+				for _, userId := range userIds {
+					if userId == *source.DepartmentLeaderID {
+						if err := tx.Model(&source).Update("department_leader_id", nil).Error; err != nil {
+							return fmt.Errorf("department 부서장 변경 중 DB 오류: %w", err)
+						}
+						break
+					}
+				}
+			}
+		}
+
+		if len(postIds) > 0 {
+			result := tx.Exec(`
+				UPDATE post_departments SET department_id = ?
+				WHERE department_id = ? AND post_id IN (?)
+			`, newDepartment.ID, sourceDepartmentID, postIds)
+			if result.Error != nil {
+				return fmt.Errorf("department 게시물 이동 중 DB 오류: %w", result.Error)
+			}
+			if result.RowsAffected != int64(len(postIds)) {
+				return fmt.Errorf("분리할 게시물 중 해당 부서 게시물이 아닌 게시물이 있습니다")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	p.invalidateUserDepartmentsCache(userIds)
 	return nil
 }
 
+// 캐시된 부서 정보를 지우면 다음 조회 때 DB에서 다시 채워짐 (온라인 상태는 유지)
+func (p *departmentPersistence) invalidateUserDepartmentsCache(userIds []uint) {
+	for _, userId := range userIds {
+		if err := p.redisClient.HDel(context.Background(), fmt.Sprintf("user:%d", userId), "departments").Err(); err != nil {
+			log.Printf("Redis 사용자 부서 캐시 삭제 실패: 사용자 ID %d, %v", userId, err)
+		}
+	}
+}
+
 func (p *departmentPersistence) DeleteUserDepartment(userId uint) error {
 	p.db.Exec(`
 		DELETE FROM user_profile_departments
@@ -120,3 +387,25 @@ func (p *departmentPersistence) DeleteUserDepartment(userId uint) error {
 
 	return nil
 }
+
+// GetTopRankedUserID 회사 직급 순위가 가장 높은 사용자 (같으면 입사일, ID 순)
+func (p *departmentPersistence) GetTopRankedUserID(companyId uint, userIds []uint) (*uint, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+
+	var ids []uint
+	if err := p.db.Raw(`
+		SELECT company_memberships.user_id FROM company_memberships
+		LEFT JOIN positions ON positions.id = company_memberships.position_id
+		WHERE company_memberships.company_id = ? AND company_memberships.user_id IN ?
+		ORDER BY COALESCE(positions.rank, 0) DESC, company_memberships.entry_date ASC NULLS LAST, company_memberships.user_id ASC
+		LIMIT 1
+	`, companyId, userIds).Scan(&ids).Error; err != nil {
+		return nil, fmt.Errorf("직급 순위 조회 중 DB 오류: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return &ids[0], nil
+}
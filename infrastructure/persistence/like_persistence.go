@@ -221,3 +221,19 @@ func (r *likePersistence) DeleteCommentLike(likeId uint) error {
 	}
 	return nil
 }
+
+// TODO 이모지 조회 없으면 생성
+func (r *likePersistence) GetOrCreateEmoji(unified string, content string) (*entity.Like, error) {
+	var emoji model.Emoji
+	if err := r.db.Where(model.Emoji{Unified: unified}).
+		Attrs(model.Emoji{Content: content}).
+		FirstOrCreate(&emoji).Error; err != nil {
+		return nil, fmt.Errorf("이모지 조회 실패: %w", err)
+	}
+
+	return &entity.Like{
+		EmojiID: emoji.ID,
+		Unified: emoji.Unified,
+		Content: emoji.Content,
+	}, nil
+}
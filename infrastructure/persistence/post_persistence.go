@@ -38,6 +38,7 @@ func (r *postPersistence) CreatePost(authorId uint, post *entity.Post) error {
 		Visibility:  strings.ToLower(post.Visibility),
 		IsAnonymous: post.IsAnonymous,
 		CompanyID:   post.CompanyID,
+		TeamID:      post.TeamID,
 	}
 	if err := tx.Create(dbPost).Error; err != nil {
 		tx.Rollback()
@@ -122,10 +123,17 @@ func (r *postPersistence) GetPosts(requestUserId uint, queryOptions map[string]i
 				query = query.Where("company_id = ? AND visibility = ?", companyId, strings.ToLower("company")) //TODO 회사 소속 게시물만 조회
 			}
 		case "department":
-			if departmentId, exists := queryOptions["department_id"].(uint); exists {
+			if departmentIds, exists := queryOptions["department_ids"].([]uint); exists {
+				// 여러 부서에 걸친 게시물이 중복되지 않도록 서브쿼리 사용
+				query = query.Where("posts.id IN (SELECT post_id FROM post_departments WHERE department_id IN ?) AND visibility = ?", departmentIds, strings.ToLower("department"))
+			} else if departmentId, exists := queryOptions["department_id"].(uint); exists {
 				query = query.Joins("JOIN post_departments ON posts.id = post_departments.post_id").
 					Where("post_departments.department_id = ? AND visibility = ?", departmentId, strings.ToLower("department"))
 			}
+		case "team":
+			if teamId, exists := queryOptions["team_id"].(uint); exists {
+				query = query.Where("team_id = ? AND visibility = ?", teamId, strings.ToLower("team"))
+			}
 		}
 	}
 
@@ -269,6 +277,7 @@ func (r *postPersistence) GetPosts(requestUserId uint, queryOptions map[string]i
 			Departments: &departments,
 			Author:      authorMap,
 			ViewCount:   post.Views + viewDiffCount,
+			TeamID:      post.TeamID,
 		})
 	}
 
@@ -340,6 +349,7 @@ func (r *postPersistence) GetPost(requestUserId uint, postId uint) (*entity.Post
 		UpdatedAt:   post.UpdatedAt,
 		Departments: &departments,
 		Author:      authorMap,
+		TeamID:      post.TeamID,
 	}, nil
 }
 
@@ -389,6 +399,25 @@ func (r *postPersistence) UpdatePost(requestUserId uint, postId uint, post *enti
 					return err
 				}
 			}
+		case "team":
+			// Update `company_id`, `team_id`
+			if err := tx.Exec("DELETE FROM post_departments WHERE post_id = ?", postId).Error; err != nil {
+				tx.Rollback()
+				return err
+			}
+			if err := tx.Model(&model.Post{}).Where("id = ?", postId).
+				Updates(map[string]interface{}{"company_id": post.CompanyID, "team_id": post.TeamID}).Error; err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+
+		//TODO 팀 공개가 아니면 team_id 해제
+		if strings.ToLower(post.Visibility) != "team" {
+			if err := tx.Model(&model.Post{}).Where("id = ?", postId).Update("team_id", nil).Error; err != nil {
+				tx.Rollback()
+				return err
+			}
 		}
 
 		// Update `visibility`
@@ -495,6 +524,7 @@ func (r *postPersistence) GetPostByID(postId uint) (*entity.Post, error) {
 		UpdatedAt:   post.UpdatedAt,
 		Departments: &departments,
 		Author:      authorMap,
+		TeamID:      post.TeamID,
 	}, nil
 }
 
@@ -545,6 +575,7 @@ func (r *postPersistence) GetPostByCommentID(commentId uint) (*entity.Post, erro
 		UpdatedAt:   post.UpdatedAt,
 		Departments: &departments,
 		Author:      authorMap,
+		TeamID:      post.TeamID,
 	}, nil
 }
 
@@ -604,3 +635,33 @@ func (r *postPersistence) GetPostViewCount(postId uint) (int, error) {
 	fmt.Printf("조회수 조회: postId=%d, DB count=%d, diff=%d, total=%d\n", postId, count-diff, diff, count)
 	return count, nil
 }
+
+// TODO 이미지 URL로 해당 이미지를 소유한 게시물 조회 (미디어 접근 권한 확인용)
+func (r *postPersistence) GetPostByImageURL(imageUrl string) (*entity.Post, error) {
+	post := &model.Post{}
+	if err := r.db.Joins("JOIN post_images ON posts.id = post_images.post_id").
+		Where("post_images.image_url = ?", imageUrl).
+		Preload("Departments", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, name")
+		}).
+		First(post).Error; err != nil {
+		return nil, fmt.Errorf("이미지 게시물 조회 실패: %w", err)
+	}
+
+	departmentIds := make([]*uint, 0, len(post.Departments))
+	for _, dept := range post.Departments {
+		departmentId := dept.ID
+		departmentIds = append(departmentIds, &departmentId)
+	}
+
+	return &entity.Post{
+		ID:            post.ID,
+		UserID:        post.UserID,
+		Visibility:    post.Visibility,
+		CompanyID:     post.CompanyID,
+		DepartmentIds: departmentIds,
+		CreatedAt:     post.CreatedAt,
+		UpdatedAt:     post.UpdatedAt,
+		TeamID:        post.TeamID,
+	}, nil
+}
@@ -0,0 +1,419 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+
+	"link/infrastructure/model"
+	"link/internal/team/entity"
+	"link/internal/team/repository"
+)
+
+type teamPersistence struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewTeamPersistence(db *gorm.DB, redis *redis.Client) repository.TeamRepository {
+	return &teamPersistence{db: db, redis: redis}
+}
+
+func (r *teamPersistence) CreateTeam(team *entity.Team, memberIds []uint, leadIds []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		//TODO 팀 채팅방 (그룹 채팅방)
+		chatRoom := &model.ChatRoom{Name: team.Name, IsPrivate: false}
+		if err := tx.Create(chatRoom).Error; err != nil {
+			return fmt.Errorf("팀 채팅방 생성 중 DB 오류: %w", err)
+		}
+
+		modelTeam := &model.Team{
+			CompanyID:    team.CompanyID,
+			DepartmentID: team.DepartmentID,
+			Name:         team.Name,
+			Handle:       team.Handle,
+			Description:  team.Description,
+			ChatRoomID:   &chatRoom.ID,
+		}
+		if err := tx.Create(modelTeam).Error; err != nil {
+			return fmt.Errorf("팀 생성 중 DB 오류: %w", err)
+		}
+
+		leads := make(map[uint]bool, len(leadIds))
+		for _, id := range leadIds {
+			leads[id] = true
+		}
+		for _, userId := range memberIds {
+			if err := addTeamMember(tx, modelTeam.ID, chatRoom.ID, team.Name, userId, leads[userId]); err != nil {
+				return err
+			}
+		}
+
+		team.ID = modelTeam.ID
+		team.ChatRoomID = modelTeam.ChatRoomID
+		team.MemberCount = len(memberIds)
+		team.CreatedAt = modelTeam.CreatedAt
+		team.UpdatedAt = modelTeam.UpdatedAt
+		return nil
+	})
+}
+
+func (r *teamPersistence) GetTeamByID(companyId uint, teamId uint) (*entity.Team, error) {
+	var team model.Team
+	if err := r.db.Preload("Department").
+		Where("id = ? AND company_id = ?", teamId, companyId).
+		First(&team).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("팀을 찾을 수 없습니다: ID %d", teamId)
+		}
+		return nil, fmt.Errorf("팀 조회 중 DB 오류: %w", err)
+	}
+
+	memberCounts, err := r.getMemberCounts([]uint{team.ID})
+	if err != nil {
+		return nil, err
+	}
+	return toTeamEntity(team, memberCounts[team.ID]), nil
+}
+
+func (r *teamPersistence) GetTeamByHandle(companyId uint, handle string) (*entity.Team, error) {
+	var teams []model.Team
+	if err := r.db.Preload("Department").
+		Where("company_id = ? AND handle = ?", companyId, handle).
+		Limit(1).
+		Find(&teams).Error; err != nil {
+		return nil, fmt.Errorf("팀 조회 중 DB 오류: %w", err)
+	}
+	if len(teams) == 0 {
+		return nil, nil
+	}
+
+	memberCounts, err := r.getMemberCounts([]uint{teams[0].ID})
+	if err != nil {
+		return nil, err
+	}
+	return toTeamEntity(teams[0], memberCounts[teams[0].ID]), nil
+}
+
+func (r *teamPersistence) GetTeams(companyId uint) ([]entity.Team, error) {
+	var teams []model.Team
+	if err := r.db.Preload("Department").
+		Where("company_id = ?", companyId).
+		Order("name ASC").
+		Find(&teams).Error; err != nil {
+		return nil, fmt.Errorf("팀 목록 조회 중 DB 오류: %w", err)
+	}
+
+	teamIds := make([]uint, len(teams))
+	for i, team := range teams {
+		teamIds[i] = team.ID
+	}
+	memberCounts, err := r.getMemberCounts(teamIds)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]entity.Team, len(teams))
+	for i, team := range teams {
+		result[i] = *toTeamEntity(team, memberCounts[team.ID])
+	}
+	return result, nil
+}
+
+func (r *teamPersistence) GetTeamIdsByUser(companyId uint, userId uint) ([]uint, error) {
+	var teamIds []uint
+	if err := r.db.Model(&model.TeamMember{}).
+		Joins("JOIN teams ON teams.id = team_members.team_id").
+		Where("teams.company_id = ? AND team_members.user_id = ?", companyId, userId).
+		Pluck("team_members.team_id", &teamIds).Error; err != nil {
+		return nil, fmt.Errorf("사용자 팀 조회 중 DB 오류: %w", err)
+	}
+	return teamIds, nil
+}
+
+func (r *teamPersistence) ExistsTeamHandle(companyId uint, handle string, excludeTeamId uint) (bool, error) {
+	var count int64
+	if err := r.db.Model(&model.Team{}).
+		Where("company_id = ? AND handle = ? AND id <> ?", companyId, handle, excludeTeamId).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("팀 핸들 조회 중 DB 오류: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (r *teamPersistence) UpdateTeam(companyId uint, teamId uint, updates map[string]interface{}) error {
+	var team model.Team
+	if err := r.db.Select("id, chat_room_id").Where("id = ? AND company_id = ?", teamId, companyId).First(&team).Error; err != nil {
+		return fmt.Errorf("팀 조회 중 DB 오류: %w", err)
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.Team{}).Where("id = ?", teamId).Updates(updates).Error; err != nil {
+			return fmt.Errorf("팀 수정 중 DB 오류: %w", err)
+		}
+
+		//TODO 팀 이름이 바뀌면 팀 채팅방 이름도 변경
+		if name, ok := updates["name"]; ok && team.ChatRoomID != nil {
+			if err := tx.Model(&model.ChatRoom{}).Where("id = ?", *team.ChatRoomID).Update("name", name).Error; err != nil {
+				return fmt.Errorf("팀 채팅방 이름 변경 중 DB 오류: %w", err)
+			}
+			if err := tx.Model(&model.ChatRoomUser{}).Where("chat_room_id = ?", *team.ChatRoomID).Update("chat_room_alias", name).Error; err != nil {
+				return fmt.Errorf("팀 채팅방 이름 변경 중 DB 오류: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.clearChatRoomCache(team.ChatRoomID)
+	return nil
+}
+
+// TODO 팀 삭제 - 팀 게시물, 구성원은 cascade, 팀 채팅방은 같이 삭제
+func (r *teamPersistence) DeleteTeam(companyId uint, teamId uint) error {
+	var team model.Team
+	if err := r.db.Select("id, chat_room_id").Where("id = ? AND company_id = ?", teamId, companyId).First(&team).Error; err != nil {
+		return fmt.Errorf("팀 조회 중 DB 오류: %w", err)
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&model.Team{}, teamId).Error; err != nil {
+			return fmt.Errorf("팀 삭제 중 DB 오류: %w", err)
+		}
+		if team.ChatRoomID != nil {
+			if err := tx.Delete(&model.ChatRoom{}, *team.ChatRoomID).Error; err != nil {
+				return fmt.Errorf("팀 채팅방 삭제 중 DB 오류: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.clearChatRoomCache(team.ChatRoomID)
+	return nil
+}
+
+func (r *teamPersistence) GetTeamMembers(teamId uint) ([]entity.TeamMember, error) {
+	var members []model.TeamMember
+	if err := r.db.Preload("User.UserProfile").
+		Where("team_id = ?", teamId).
+		Order("is_lead DESC, joined_at ASC").
+		Find(&members).Error; err != nil {
+		return nil, fmt.Errorf("팀 구성원 조회 중 DB 오류: %w", err)
+	}
+
+	result := make([]entity.TeamMember, len(members))
+	for i, member := range members {
+		result[i] = toTeamMemberEntity(member)
+	}
+	return result, nil
+}
+
+func (r *teamPersistence) GetTeamMemberIds(teamId uint) ([]uint, error) {
+	var userIds []uint
+	if err := r.db.Model(&model.TeamMember{}).Where("team_id = ?", teamId).Pluck("user_id", &userIds).Error; err != nil {
+		return nil, fmt.Errorf("팀 구성원 조회 중 DB 오류: %w", err)
+	}
+	return userIds, nil
+}
+
+func (r *teamPersistence) GetTeamMember(teamId uint, userId uint) (*entity.TeamMember, error) {
+	var members []model.TeamMember
+	if err := r.db.Preload("User.UserProfile").
+		Where("team_id = ? AND user_id = ?", teamId, userId).
+		Limit(1).
+		Find(&members).Error; err != nil {
+		return nil, fmt.Errorf("팀 구성원 조회 중 DB 오류: %w", err)
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	member := toTeamMemberEntity(members[0])
+	return &member, nil
+}
+
+func (r *teamPersistence) AddTeamMembers(teamId uint, userIds []uint, isLead bool) error {
+	var team model.Team
+	if err := r.db.Select("id, name, chat_room_id").Where("id = ?", teamId).First(&team).Error; err != nil {
+		return fmt.Errorf("팀 조회 중 DB 오류: %w", err)
+	}
+
+	var chatRoomId uint
+	if team.ChatRoomID != nil {
+		chatRoomId = *team.ChatRoomID
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for _, userId := range userIds {
+			if err := addTeamMember(tx, teamId, chatRoomId, team.Name, userId, isLead); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.clearChatRoomCache(team.ChatRoomID)
+	return nil
+}
+
+func (r *teamPersistence) UpdateTeamMemberLead(teamId uint, userId uint, isLead bool) error {
+	result := r.db.Model(&model.TeamMember{}).
+		Where("team_id = ? AND user_id = ?", teamId, userId).
+		Update("is_lead", isLead)
+	if result.Error != nil {
+		return fmt.Errorf("팀장 변경 중 DB 오류: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("팀 구성원을 찾을 수 없습니다: 사용자 ID %d", userId)
+	}
+	return nil
+}
+
+func (r *teamPersistence) RemoveTeamMember(teamId uint, userId uint) error {
+	var team model.Team
+	if err := r.db.Select("id, chat_room_id").Where("id = ?", teamId).First(&team).Error; err != nil {
+		return fmt.Errorf("팀 조회 중 DB 오류: %w", err)
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("team_id = ? AND user_id = ?", teamId, userId).Delete(&model.TeamMember{})
+		if result.Error != nil {
+			return fmt.Errorf("팀 구성원 삭제 중 DB 오류: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("팀 구성원을 찾을 수 없습니다: 사용자 ID %d", userId)
+		}
+
+		//TODO 팀 채팅방에서도 나감 (채팅방 나가기와 같은 방식)
+		if team.ChatRoomID != nil {
+			if err := tx.Model(&model.ChatRoomUser{}).
+				Where("chat_room_id = ? AND user_id = ?", *team.ChatRoomID, userId).
+				Updates(map[string]interface{}{"left_at": time.Now(), "joined_at": nil}).Error; err != nil {
+				return fmt.Errorf("팀 채팅방 나가기 중 DB 오류: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.clearChatRoomCache(team.ChatRoomID)
+	return nil
+}
+
+func (r *teamPersistence) GetCompanyMemberIds(companyId uint, userIds []uint) ([]uint, error) {
+	if len(userIds) == 0 {
+		return []uint{}, nil
+	}
+
+	var memberIds []uint
+	if err := r.db.Model(&model.CompanyMembership{}).
+		Where("company_id = ? AND user_id IN ?", companyId, userIds).
+		Pluck("user_id", &memberIds).Error; err != nil {
+		return nil, fmt.Errorf("회사 구성원 조회 중 DB 오류: %w", err)
+	}
+	return memberIds, nil
+}
+
+// addTeamMember 팀 구성원 추가 - 이미 구성원이면 팀장 여부만 갱신, 나갔던 팀 채팅방은 다시 참여
+func addTeamMember(tx *gorm.DB, teamId uint, chatRoomId uint, teamName string, userId uint, isLead bool) error {
+	if err := tx.Exec(`
+		INSERT INTO team_members (team_id, user_id, is_lead, joined_at) VALUES (?, ?, ?, NOW())
+		ON CONFLICT (team_id, user_id) DO UPDATE SET is_lead = team_members.is_lead OR EXCLUDED.is_lead`,
+		teamId, userId, isLead).Error; err != nil {
+		return fmt.Errorf("팀 구성원 추가 중 DB 오류: %w", err)
+	}
+
+	if chatRoomId == 0 {
+		return nil
+	}
+	if err := tx.Exec(`
+		INSERT INTO chat_room_users (chat_room_id, user_id, joined_at, chat_room_alias) VALUES (?, ?, NOW(), ?)
+		ON CONFLICT (chat_room_id, user_id) DO UPDATE SET joined_at = NOW(), left_at = NULL`,
+		chatRoomId, userId, teamName).Error; err != nil {
+		return fmt.Errorf("팀 채팅방 참여 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+func (r *teamPersistence) getMemberCounts(teamIds []uint) (map[uint]int, error) {
+	counts := make(map[uint]int, len(teamIds))
+	if len(teamIds) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		TeamID uint
+		Count  int
+	}
+	if err := r.db.Model(&model.TeamMember{}).
+		Select("team_id, COUNT(*) AS count").
+		Where("team_id IN ?", teamIds).
+		Group("team_id").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("팀 구성원 수 조회 중 DB 오류: %w", err)
+	}
+	for _, row := range rows {
+		counts[row.TeamID] = row.Count
+	}
+	return counts, nil
+}
+
+// 채팅방 캐시는 웹소켓 접속 시 DB에서 다시 채움
+func (r *teamPersistence) clearChatRoomCache(chatRoomId *uint) {
+	if chatRoomId == nil {
+		return
+	}
+	r.redis.Del(context.Background(), fmt.Sprintf("chatroom:%d", *chatRoomId))
+}
+
+func toTeamEntity(team model.Team, memberCount int) *entity.Team {
+	var departmentName string
+	if team.Department != nil {
+		departmentName = team.Department.Name
+	}
+
+	return &entity.Team{
+		ID:             team.ID,
+		CompanyID:      team.CompanyID,
+		DepartmentID:   team.DepartmentID,
+		DepartmentName: departmentName,
+		Name:           team.Name,
+		Handle:         team.Handle,
+		Description:    team.Description,
+		ChatRoomID:     team.ChatRoomID,
+		MemberCount:    memberCount,
+		CreatedAt:      team.CreatedAt,
+		UpdatedAt:      team.UpdatedAt,
+	}
+}
+
+func toTeamMemberEntity(member model.TeamMember) entity.TeamMember {
+	var image *string
+	if member.User.UserProfile != nil {
+		image = member.User.UserProfile.Image
+	}
+
+	return entity.TeamMember{
+		UserID:   member.UserID,
+		Name:     member.User.Name,
+		Nickname: member.User.Nickname,
+		Email:    member.User.Email,
+		Image:    image,
+		IsLead:   member.IsLead,
+		JoinedAt: member.JoinedAt,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"link/infrastructure/model"
 
@@ -498,6 +500,12 @@ func (r *userPersistence) UpdateUser(id uint, updates map[string]interface{}, pr
 		return fmt.Errorf("트랜잭션 시작 중 DB 오류: %w", tx.Error)
 	}
 
+	var before model.UserProfile
+	if err := tx.Select("user_id, company_id").Where("user_id = ?", id).First(&before).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("사용자 프로필 조회 중 DB 오류: %w", err)
+	}
+
 	if len(updates) > 0 {
 		if err := tx.Model(&model.User{}).Where("id = ?", id).Updates(updates).Error; err != nil {
 			tx.Rollback()
@@ -514,6 +522,18 @@ func (r *userPersistence) UpdateUser(id uint, updates map[string]interface{}, pr
 		}
 	}
 
+	//TODO 대표 회사 정보가 바뀌면 회사 소속 정보도 같이 맞춤
+	_, roleChanged := updates["role"]
+	_, positionChanged := profileUpdates["position_id"]
+	companyChanged, err := r.syncPrimaryCompanyMembership(tx, id, before.CompanyID, roleChanged || positionChanged)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if companyChanged {
+		r.redisClient.HDel(context.Background(), fmt.Sprintf("user:%d", id), "departments")
+	}
+
 	//TODO 캐시 업데이트 - updates와 profileUpdates 둘 다 업데이트
 	// Redis 캐시 비동기 업데이트
 	go func() {
@@ -555,7 +575,7 @@ func (r *userPersistence) SearchUser(companyId uint, searchTerm string) ([]entit
 
 	if err := r.db.
 		Preload("UserProfile.Company").
-		Where("company_id = ? AND (name LIKE ? OR email LIKE ? OR nickname LIKE ?) AND (users.role = ? OR users.role = ?)", companyId, "%"+searchTerm+"%", "%"+searchTerm+"%", "%"+searchTerm+"%", entity.RoleUser, entity.RoleCompanyManager).
+		Where("users.id IN (SELECT user_id FROM company_memberships WHERE company_id = ?) AND (name LIKE ? OR email LIKE ? OR nickname LIKE ?) AND (users.role = ? OR users.role = ?)", companyId, "%"+searchTerm+"%", "%"+searchTerm+"%", "%"+searchTerm+"%", entity.RoleUser, entity.RoleCompanyManager).
 		Find(&users).Error; err != nil {
 		return nil, fmt.Errorf("사용자 검색 중 DB 오류: %w", err)
 	}
@@ -573,7 +593,7 @@ func (r *userPersistence) SearchUser(companyId uint, searchTerm string) ([]entit
 			CreatedAt: &user.CreatedAt,
 			UpdatedAt: &user.UpdatedAt,
 			UserProfile: &entity.UserProfile{
-				CompanyID: user.UserProfile.CompanyID,
+				CompanyID: &companyId,
 			},
 		}
 	}
@@ -588,17 +608,19 @@ func (r *userPersistence) GetUsersByCompany(companyId uint, queryOptions *entity
 	// UserProfile의 company_id 필드를 사용하여 조건을 설정
 	dbQuery := r.db.
 		Table("users").
-		Select("users.id", "users.name", "users.email", "users.nickname", "users.role", "users.phone", "users.status", "users.created_at", "users.updated_at",
-			"user_profiles.birthday", "user_profiles.is_subscribed", "user_profiles.entry_date", "user_profiles.image",
+		Select("users.id", "users.name", "users.email", "users.nickname", fmt.Sprintf("CASE WHEN users.role <= %d THEN users.role ELSE company_memberships.role END AS role", entity.RoleSubAdmin), "users.phone", "users.status", "users.created_at", "users.updated_at",
+			"user_profiles.birthday", "user_profiles.is_subscribed", "COALESCE(company_memberships.entry_date, user_profiles.entry_date) AS entry_date", "user_profiles.image",
 			"companies.id as company_id", "companies.cp_name as company_name",
 			"departments.id as department_id", "departments.name as department_name",
 			"positions.id as position_id", "positions.name as position_name").
 		Joins("JOIN user_profiles ON user_profiles.user_id = users.id").
-		Joins("JOIN companies ON companies.id = user_profiles.company_id").
+		Joins("JOIN company_memberships ON company_memberships.user_id = users.id").
+		Joins("JOIN companies ON companies.id = company_memberships.company_id").
 		Joins("LEFT JOIN user_profile_departments ON user_profile_departments.user_profile_user_id = users.id").
-		Joins("LEFT JOIN departments ON departments.id = user_profile_departments.department_id").
-		Joins("LEFT JOIN positions ON positions.id = user_profiles.position_id").
-		Where("user_profiles.company_id = ? AND (users.role >= ? AND users.role <= ?)", companyId, entity.RoleSubAdmin, entity.RoleUser)
+		// 다른 회사의 부서는 제외
+		Joins("LEFT JOIN departments ON departments.id = user_profile_departments.department_id AND departments.company_id = company_memberships.company_id").
+		Joins("LEFT JOIN positions ON positions.id = company_memberships.position_id").
+		Where("company_memberships.company_id = ? AND (users.role >= ? AND users.role <= ?)", companyId, entity.RoleSubAdmin, entity.RoleUser)
 
 	if queryOptions == nil {
 		queryOptions = &entity.UserQueryOptions{
@@ -711,12 +733,346 @@ func (r *userPersistence) GetUsersByCompany(companyId uint, queryOptions *entity
 // TODO 회사 사용자 ID 조회
 func (r *userPersistence) GetUsersIdsByCompany(companyId uint) ([]uint, error) {
 	var users []uint
-	if err := r.db.Model(&model.UserProfile{}).Select("user_id").Where("company_id = ?", companyId).Pluck("user_id", &users).Error; err != nil {
+	if err := r.db.Model(&model.CompanyMembership{}).Where("company_id = ?", companyId).Pluck("user_id", &users).Error; err != nil {
 		return nil, fmt.Errorf("회사 사용자 ID 조회 중 DB 오류: %w", err)
 	}
 	return users, nil
 }
 
+// ! 회사 소속 (다중 회사)
+
+// TODO 활성 회사 기준 사용자 조회 - companyId가 0이면 대표 회사 기준
+func (r *userPersistence) GetUserByIDInCompany(id uint, companyId uint) (*entity.User, error) {
+	user, err := r.GetUserByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if companyId == 0 {
+		return user, nil
+	}
+
+	membership, err := r.GetCompanyMembership(id, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 소속되지 않은 회사라면 회사 정보를 비운 사용자로 반환
+	user.ApplyCompanyMembership(membership)
+	return user, nil
+}
+
+// TODO 회사 소속 추가 - 대표 회사가 없으면 대표 회사로 지정
+func (r *userPersistence) CreateCompanyMembership(membership *entity.CompanyMembership) error {
+	primaryChanged := false
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		modelMembership := &model.CompanyMembership{
+			UserID:     membership.UserID,
+			CompanyID:  membership.CompanyID,
+			Role:       model.UserRole(membership.Role),
+			PositionID: membership.PositionID,
+			EntryDate:  membership.EntryDate,
+		}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(modelMembership)
+		if result.Error != nil {
+			return fmt.Errorf("회사 소속 추가 중 DB 오류: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("이미 소속된 회사입니다: 사용자 ID %d, 회사 ID %d", membership.UserID, membership.CompanyID)
+		}
+
+		result = tx.Model(&model.UserProfile{}).
+			Where("user_id = ? AND company_id IS NULL", membership.UserID).
+			Update("company_id", membership.CompanyID)
+		if result.Error != nil {
+			return fmt.Errorf("대표 회사 지정 중 DB 오류: %w", result.Error)
+		}
+		primaryChanged = result.RowsAffected > 0
+
+		membership.CreatedAt = modelMembership.CreatedAt
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if primaryChanged {
+		membership.IsPrimary = true
+		r.redisClient.HDel(context.Background(), fmt.Sprintf("user:%d", membership.UserID), "departments")
+	}
+	return nil
+}
+
+// TODO 이메일 확인 토큰 저장 - 재발송이면 토큰, 주소, 만료 시간을 교체하고 확인 상태 초기화
+func (r *userPersistence) SaveEmailVerification(verification *entity.EmailVerification) error {
+	err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"email": verification.Email, "token": verification.Token, "expires_at": verification.ExpiresAt, "verified_at": nil, "updated_at": time.Now()}),
+	}).Create(&model.EmailVerification{
+		UserID:    verification.UserID,
+		Email:     verification.Email,
+		Token:     verification.Token,
+		ExpiresAt: verification.ExpiresAt,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("이메일 확인 토큰 저장 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO 만료되지 않은 토큰이면 확인 처리 - 같은 토큰으로 두 번 확인할 수 없음, 없으면 nil
+func (r *userPersistence) ConfirmEmailVerification(token string) (*entity.EmailVerification, error) {
+	var verification model.EmailVerification
+	now := time.Now()
+	result := r.db.Model(&verification).
+		Clauses(clause.Returning{}).
+		Where("token = ? AND verified_at IS NULL AND expires_at > ?", token, now).
+		Update("verified_at", now)
+	if result.Error != nil {
+		return nil, fmt.Errorf("이메일 확인 처리 중 DB 오류: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return &entity.EmailVerification{
+		UserID:     verification.UserID,
+		Email:      verification.Email,
+		Token:      verification.Token,
+		ExpiresAt:  verification.ExpiresAt,
+		VerifiedAt: verification.VerifiedAt,
+	}, nil
+}
+
+// TODO 현재 이메일 주소로 확인을 마쳤는지
+func (r *userPersistence) IsEmailVerified(userId uint, email string) (bool, error) {
+	var count int64
+	err := r.db.Model(&model.EmailVerification{}).
+		Where("user_id = ? AND LOWER(email) = LOWER(?) AND verified_at IS NOT NULL", userId, email).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("이메일 확인 여부 조회 중 DB 오류: %w", err)
+	}
+	return count > 0, nil
+}
+
+// TODO 회사 소속 조회 - 소속이 없으면 nil 반환
+func (r *userPersistence) GetCompanyMembership(userId uint, companyId uint) (*entity.CompanyMembership, error) {
+	var membership model.CompanyMembership
+	err := r.companyMembershipQuery().
+		Where("user_id = ? AND company_id = ?", userId, companyId).
+		First(&membership).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("회사 소속 조회 중 DB 오류: %w", err)
+	}
+
+	departments, err := r.getMembershipDepartments(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	primaryCompanyID, err := r.getPrimaryCompanyID(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCompanyMembershipEntity(membership, departments[companyId], primaryCompanyID), nil
+}
+
+// TODO 사용자가 소속된 회사 목록
+func (r *userPersistence) GetCompanyMemberships(userId uint) ([]entity.CompanyMembership, error) {
+	var memberships []model.CompanyMembership
+	if err := r.companyMembershipQuery().
+		Where("user_id = ?", userId).
+		Order("created_at ASC").
+		Find(&memberships).Error; err != nil {
+		return nil, fmt.Errorf("회사 소속 목록 조회 중 DB 오류: %w", err)
+	}
+
+	departments, err := r.getMembershipDepartments(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	primaryCompanyID, err := r.getPrimaryCompanyID(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]entity.CompanyMembership, len(memberships))
+	for i, membership := range memberships {
+		result[i] = *toCompanyMembershipEntity(membership, departments[membership.CompanyID], primaryCompanyID)
+	}
+	return result, nil
+}
+
+// TODO 회사 역할로 부여받은 권한 목록 - 역할이 없으면 빈 목록
+func (r *userPersistence) GetCompanyPermissions(userId uint, companyId uint) ([]string, error) {
+	var permissions []string
+	if err := r.db.Model(&model.CompanyRolePermission{}).
+		Joins("JOIN company_memberships ON company_memberships.custom_role_id = company_role_permissions.role_id").
+		Where("company_memberships.user_id = ? AND company_memberships.company_id = ?", userId, companyId).
+		Pluck("company_role_permissions.permission", &permissions).Error; err != nil {
+		return nil, fmt.Errorf("회사 역할 권한 조회 중 DB 오류: %w", err)
+	}
+	return permissions, nil
+}
+
+func (r *userPersistence) companyMembershipQuery() *gorm.DB {
+	return r.db.
+		Preload("Company", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, cp_name")
+		}).
+		Preload("Position").
+		Preload("CustomRole", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, name")
+		})
+}
+
+// getMembershipDepartments 회사 ID별 사용자 부서 목록
+func (r *userPersistence) getMembershipDepartments(userId uint) (map[uint][]*map[string]interface{}, error) {
+	var departments []model.Department
+	if err := r.db.
+		Select("departments.id, departments.name, departments.company_id").
+		Joins("JOIN user_profile_departments ON user_profile_departments.department_id = departments.id").
+		Where("user_profile_departments.user_profile_user_id = ?", userId).
+		Find(&departments).Error; err != nil {
+		return nil, fmt.Errorf("사용자 부서 조회 중 DB 오류: %w", err)
+	}
+
+	result := make(map[uint][]*map[string]interface{})
+	for _, department := range departments {
+		result[department.CompanyID] = append(result[department.CompanyID], &map[string]interface{}{
+			"id":   department.ID,
+			"name": department.Name,
+		})
+	}
+	return result, nil
+}
+
+func (r *userPersistence) getPrimaryCompanyID(userId uint) (*uint, error) {
+	var profile model.UserProfile
+	if err := r.db.Select("user_id, company_id").Where("user_id = ?", userId).First(&profile).Error; err != nil {
+		return nil, fmt.Errorf("사용자 프로필 조회 중 DB 오류: %w", err)
+	}
+	return profile.CompanyID, nil
+}
+
+// syncPrimaryCompanyMembership 대표 회사가 바뀌면 이전 회사 소속(부서 포함)을 지우고 새 회사 소속을 만듦
+// 대표 회사가 그대로면 역할, 직책 변경만 반영
+func (r *userPersistence) syncPrimaryCompanyMembership(tx *gorm.DB, userId uint, beforeCompanyID *uint, syncRolePosition bool) (bool, error) {
+	var after model.UserProfile
+	if err := tx.Select("user_id, company_id, position_id, entry_date").Where("user_id = ?", userId).First(&after).Error; err != nil {
+		return false, fmt.Errorf("사용자 프로필 조회 중 DB 오류: %w", err)
+	}
+
+	if sameCompanyID(beforeCompanyID, after.CompanyID) {
+		if after.CompanyID == nil || !syncRolePosition {
+			return false, nil
+		}
+		if err := tx.Exec(`
+			UPDATE company_memberships SET role = GREATEST(users.role, ?), position_id = ?, updated_at = NOW()
+			FROM users
+			WHERE users.id = company_memberships.user_id AND company_memberships.user_id = ? AND company_memberships.company_id = ?`,
+			model.RoleCompanyManager, after.PositionID, userId, *after.CompanyID).Error; err != nil {
+			return false, fmt.Errorf("회사 소속 정보 갱신 중 DB 오류: %w", err)
+		}
+		return false, nil
+	}
+
+	//TODO 대표 회사가 바뀌면 이전 회사 보고 라인은 끊음
+	if err := tx.Model(&model.UserProfile{}).Where("user_id = ?", userId).Update("manager_id", nil).Error; err != nil {
+		return false, fmt.Errorf("사용자 상급자 정리 중 DB 오류: %w", err)
+	}
+
+	if beforeCompanyID != nil {
+		if err := clearCompanySubordinates(tx, *beforeCompanyID, userId); err != nil {
+			return false, err
+		}
+		if err := tx.Exec(`
+			DELETE FROM user_profile_departments
+			WHERE user_profile_user_id = ? AND department_id IN (SELECT id FROM departments WHERE company_id = ?)`,
+			userId, *beforeCompanyID).Error; err != nil {
+			return false, fmt.Errorf("이전 회사 부서 정리 중 DB 오류: %w", err)
+		}
+		if err := tx.Where("user_id = ? AND company_id = ?", userId, *beforeCompanyID).
+			Delete(&model.CompanyMembership{}).Error; err != nil {
+			return false, fmt.Errorf("이전 회사 소속 삭제 중 DB 오류: %w", err)
+		}
+	}
+
+	if after.CompanyID != nil {
+		var entryDate *time.Time
+		if !after.EntryDate.IsZero() {
+			entryDate = &after.EntryDate
+		}
+		if err := tx.Exec(`
+			INSERT INTO company_memberships (user_id, company_id, role, position_id, entry_date, created_at, updated_at)
+			SELECT users.id, ?, GREATEST(users.role, ?), ?, ?, NOW(), NOW() FROM users WHERE users.id = ?
+			ON CONFLICT (user_id, company_id) DO NOTHING`,
+			*after.CompanyID, model.RoleCompanyManager, after.PositionID, entryDate, userId).Error; err != nil {
+			return false, fmt.Errorf("회사 소속 추가 중 DB 오류: %w", err)
+		}
+	}
+
+	return true, nil
+}
+
+// clearCompanySubordinates 회사를 떠나는 사용자를 상급자로 둔 구성원의 보고 라인을 끊음
+func clearCompanySubordinates(tx *gorm.DB, companyId uint, managerId uint) error {
+	if err := tx.Model(&model.CompanyMembership{}).
+		Where("company_id = ? AND manager_id = ?", companyId, managerId).
+		Update("manager_id", nil).Error; err != nil {
+		return fmt.Errorf("하위 구성원 보고 라인 정리 중 DB 오류: %w", err)
+	}
+	if err := tx.Model(&model.UserProfile{}).
+		Where("company_id = ? AND manager_id = ?", companyId, managerId).
+		Update("manager_id", nil).Error; err != nil {
+		return fmt.Errorf("하위 구성원 보고 라인 정리 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+func sameCompanyID(a *uint, b *uint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func toCompanyMembershipEntity(membership model.CompanyMembership, departments []*map[string]interface{}, primaryCompanyID *uint) *entity.CompanyMembership {
+	var positionName string
+	if membership.Position != nil {
+		positionName = membership.Position.Name
+	}
+
+	var customRoleName string
+	if membership.CustomRole != nil {
+		customRoleName = membership.CustomRole.Name
+	}
+
+	return &entity.CompanyMembership{
+		UserID:       membership.UserID,
+		CompanyID:    membership.CompanyID,
+		CompanyName:  membership.Company.CpName,
+		Role:         entity.UserRole(membership.Role),
+		PositionID:   membership.PositionID,
+		PositionName: positionName,
+		EntryDate:    membership.EntryDate,
+		Departments:  departments,
+		IsPrimary:    primaryCompanyID != nil && *primaryCompanyID == membership.CompanyID,
+		CreatedAt:    membership.CreatedAt,
+
+		CustomRoleID:   membership.CustomRoleID,
+		CustomRoleName: customRoleName,
+
+		ManagerID: membership.ManagerID,
+	}
+}
+
 // // TODO 회사 조직도 조회
 // func (r *userPersistence) GetOrganizationByCompany(companyId uint) ([]entity.User, error) {
 // 	//TODO 회사 안에 여러 부서가 있고, 부서안의 사용자 정보 리스트
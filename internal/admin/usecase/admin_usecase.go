@@ -35,7 +35,8 @@ type AdminUsecase interface {
 	//Company관련
 	AdminCreateCompany(requestUserID uint, request *req.AdminCreateCompanyRequest) (*res.AdminRegisterCompanyResponse, error)
 	AdminUpdateCompany(requestUserID uint, request *req.AdminUpdateCompanyRequest) error
-	AdminDeleteCompany(requestUserID uint, companyID uint) error
+	AdminDeleteCompany(requestUserID uint, companyID uint) (*res.AdminDeleteCompanyResponse, error) // 유예 기간 후 영구 삭제
+	AdminRestoreCompany(requestUserID uint, companyID uint) error
 	AdminAddUserToCompany(adminUserId uint, targetUserId uint, companyID uint) error
 	AdminUpdateUserDepartment(adminUserId uint, targetUserId uint, request *req.AdminUpdateUserDepartmentRequest) error
 
@@ -255,7 +256,7 @@ func (c *adminUsecase) AdminGetUsersByCompany(adminUserId uint, companyID uint,
 				Name:         *user.Name,
 				Phone:        *user.Phone,
 				Nickname:     *user.Nickname,
-				Image:        user.UserProfile.Image,
+				Image:        util.SignProfileImageURLPtr(user.UserProfile.Image),
 				CompanyID:    *user.UserProfile.CompanyID,
 				CompanyName:  companyName,
 				Departments:  departments,
@@ -376,35 +377,77 @@ func (u *adminUsecase) AdminUpdateUser(adminUserId uint, targetUserId uint, requ
 	return nil
 }
 
-// TODO 회사 삭제 - ADMIN
-func (c *adminUsecase) AdminDeleteCompany(requestUserID uint, companyID uint) error {
+// TODO 회사 삭제 - ADMIN, 바로 삭제하지 않고 유예 기간 동안 읽기 전용으로 둠
+func (c *adminUsecase) AdminDeleteCompany(requestUserID uint, companyID uint) (*res.AdminDeleteCompanyResponse, error) {
 	//TODO 관리자 계정인지 확인
 	admin, err := c.userRepository.GetUserByID(requestUserID)
 	if err != nil {
 		log.Printf("관리자 계정 조회 중 오류 발생: %v", err)
-		return common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
+		return nil, common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
 	}
 
 	if admin.Role > _userEntity.RoleSubAdmin {
 		log.Printf("권한이 없는 사용자가 회사를 삭제하려 했습니다: 요청자 ID %d", requestUserID)
-		return common.NewError(http.StatusForbidden, "관리자 계정이 아닙니다", err)
+		return nil, common.NewError(http.StatusForbidden, "관리자 계정이 아닙니다", err)
 	}
 
 	company, err := c.companyRepository.GetCompanyByID(companyID)
 	if err != nil {
 		log.Printf("존재하지 않는 회사는 삭제할 수 없습니다: %v", err)
-		return common.NewError(http.StatusBadRequest, "존재하지 않는 회사는 삭제할 수 없습니다", err)
+		return nil, common.NewError(http.StatusBadRequest, "존재하지 않는 회사는 삭제할 수 없습니다", err)
 	}
 
 	if company.ID == 1 {
 		log.Printf("Link 회사는 삭제할 수 없습니다: 요청자 ID %d", requestUserID)
-		return common.NewError(http.StatusForbidden, "Link 회사는 삭제할 수 없습니다", err)
+		return nil, common.NewError(http.StatusForbidden, "Link 회사는 삭제할 수 없습니다", err)
+	}
+
+	if company.PurgeScheduledAt != nil {
+		log.Printf("이미 삭제 예정인 회사입니다: 회사 ID %d", companyID)
+		return nil, common.NewError(http.StatusConflict, "이미 삭제 예정인 회사입니다", nil)
+	}
+
+	requestedAt := time.Now()
+	purgeAt := requestedAt.Add(_companyEntity.CompanyDeletionGracePeriod)
+	err = c.companyRepository.ScheduleCompanyDeletion(companyID, requestedAt, purgeAt)
+	if err != nil {
+		log.Printf("회사 삭제 예약 중 오류 발생: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 삭제 예약 중 오류 발생", err)
+	}
+
+	return &res.AdminDeleteCompanyResponse{
+		CompanyID:           companyID,
+		DeletionRequestedAt: util.ParseKst(requestedAt).Format(time.DateTime),
+		PurgeScheduledAt:    util.ParseKst(purgeAt).Format(time.DateTime),
+	}, nil
+}
+
+// TODO 회사 복구 - ADMIN, 유예 기간 안에만 가능
+func (c *adminUsecase) AdminRestoreCompany(requestUserID uint, companyID uint) error {
+	admin, err := c.userRepository.GetUserByID(requestUserID)
+	if err != nil {
+		log.Printf("관리자 계정 조회 중 오류 발생: %v", err)
+		return common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
+	}
+
+	if admin.Role > _userEntity.RoleSubAdmin {
+		log.Printf("권한이 없는 사용자가 회사를 복구하려 했습니다: 요청자 ID %d", requestUserID)
+		return common.NewError(http.StatusForbidden, "관리자 계정이 아닙니다", nil)
 	}
 
-	err = c.companyRepository.DeleteCompany(companyID)
+	company, err := c.companyRepository.GetCompanyByID(companyID)
 	if err != nil {
-		log.Printf("회사 삭제 중 오류 발생: %v", err)
-		return common.NewError(http.StatusInternalServerError, "회사 삭제 중 오류 발생", err)
+		log.Printf("존재하지 않는 회사는 복구할 수 없습니다: %v", err)
+		return common.NewError(http.StatusNotFound, "존재하지 않는 회사는 복구할 수 없습니다", err)
+	}
+
+	if company.PurgeScheduledAt == nil {
+		return common.NewError(http.StatusBadRequest, "삭제 예정인 회사가 아닙니다", nil)
+	}
+
+	if err := c.companyRepository.RestoreCompany(companyID); err != nil {
+		log.Printf("회사 복구 중 오류 발생: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 복구 중 오류 발생", err)
 	}
 
 	return nil
@@ -429,9 +472,29 @@ func (u *adminUsecase) AdminAddUserToCompany(adminUserId uint, targetUserId uint
 		return common.NewError(http.StatusInternalServerError, "존재하지 않는 사용자입니다", err)
 	}
 
+	//TODO 이미 다른 회사에 소속되어 있다면 회사 소속만 추가 (다중 회사)
 	if targetUser.UserProfile.CompanyID != nil {
-		log.Printf("이미 회사에 소속된 사용자입니다: 사용자 ID %d", targetUserId)
-		return common.NewError(http.StatusBadRequest, "이미 회사에 소속된 사용자입니다", err)
+		membership, err := u.userRepository.GetCompanyMembership(targetUserId, companyID)
+		if err != nil {
+			log.Printf("회사 소속 조회 중 오류 발생: %v", err)
+			return common.NewError(http.StatusInternalServerError, "회사 소속 조회 중 오류 발생", err)
+		}
+		if membership != nil {
+			log.Printf("이미 회사에 소속된 사용자입니다: 사용자 ID %d", targetUserId)
+			return common.NewError(http.StatusBadRequest, "이미 회사에 소속된 사용자입니다", nil)
+		}
+
+		entryDate := time.Now()
+		if err := u.userRepository.CreateCompanyMembership(&_userEntity.CompanyMembership{
+			UserID:    targetUserId,
+			CompanyID: companyID,
+			Role:      _userEntity.RoleUser,
+			EntryDate: &entryDate,
+		}); err != nil {
+			log.Printf("회사 소속 추가 중 오류 발생: %v", err)
+			return common.NewError(http.StatusInternalServerError, "회사 소속 추가 중 오류 발생", err)
+		}
+		return nil
 	}
 
 	err = u.userRepository.UpdateUser(targetUserId, map[string]interface{}{}, map[string]interface{}{
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"link/internal/auth/entity"
 	_authRepo "link/internal/auth/repository"
+	_userEntity "link/internal/user/entity"
 	_userRepo "link/internal/user/repository"
 
 	"link/pkg/common"
@@ -23,6 +24,7 @@ type AuthUsecase interface {
 	SignIn(request *req.LoginRequest) (*res.LoginUserResponse, *entity.Token, error) // 로그인 처리
 	SignOut(userId uint, email string) error                                         // 로그아웃 처리
 	GetRefreshToken(userId uint, email string) (string, error)
+	SwitchCompany(userId uint, companyId uint) (*res.LoginUserResponse, *entity.Token, error) // 활성 회사 변경
 }
 
 // authUsecase 구조체 정의
@@ -52,26 +54,23 @@ func (u *authUsecase) SignIn(request *req.LoginRequest) (*res.LoginUserResponse,
 		return nil, nil, common.NewError(http.StatusNotFound, "이메일 또는 비밀번호가 일치하지 않습니다", err)
 	}
 
-	accessToken, err := _utils.GenerateAccessToken(*user.Name, *user.Email, *user.ID)
-	if err != nil {
-
-		log.Printf("액세스 토큰 생성 오류: %v", err)
-		return nil, nil, common.NewError(http.StatusInternalServerError, "액세스 토큰 생성에 실패했습니다", err)
+	//TODO 로그인 시 활성 회사는 대표 회사
+	var activeCompanyId uint
+	if user.UserProfile != nil && user.UserProfile.CompanyID != nil {
+		activeCompanyId = *user.UserProfile.CompanyID
 	}
 
-	refreshToken, err := _utils.GenerateRefreshToken(*user.Name, *user.Email, *user.ID)
+	activeUser, err := u.userRepo.GetUserByIDInCompany(*user.ID, activeCompanyId)
 	if err != nil {
-		log.Printf("리프레시 토큰 생성 오류: %v", err)
-		return nil, nil, common.NewError(http.StatusInternalServerError, "리프레시 토큰 생성에 실패했습니다", err)
+		log.Printf("사용자 조회 오류: %v", err)
+		return nil, nil, common.NewError(http.StatusInternalServerError, "사용자 조회에 실패했습니다", err)
 	}
+	user.Role = activeUser.Role
+	user.UserProfile.Departments = activeUser.UserProfile.Departments
 
-	userIdStr := strconv.FormatUint(uint64(*user.ID), 10)
-	//TODO userId:email 키값으로 레디스 저장
-	mergeKey := fmt.Sprintf("%s:%s", userIdStr, *user.Email)
-	err = u.authRepo.StoreRefreshToken(mergeKey, refreshToken)
+	token, err := u.issueTokens(user, activeCompanyId)
 	if err != nil {
-		log.Printf("리프레시 토큰 저장 오류: %v", err)
-		return nil, nil, common.NewError(http.StatusInternalServerError, "리프레시 토큰 저장에 실패했습니다", err)
+		return nil, nil, err
 	}
 
 	departmentIds := make([]uint, len(user.UserProfile.Departments))
@@ -98,19 +97,7 @@ func (u *authUsecase) SignIn(request *req.LoginRequest) (*res.LoginUserResponse,
 	}
 	u.natsPublisher.PublishEvent("link.event.user.signin", []byte(jsonData))
 
-	return &res.LoginUserResponse{
-			ID:            _utils.GetValueOrDefault(user.ID, 0),
-			Email:         _utils.GetValueOrDefault(user.Email, ""),
-			Name:          _utils.GetValueOrDefault(user.Name, ""),
-			Role:          uint(_utils.GetValueOrDefault(&user.Role, 4)),
-			CompanyID:     _utils.GetValueOrDefault(user.UserProfile.CompanyID, 0),
-			ProfileImage:  _utils.GetValueOrDefault(user.UserProfile.Image, ""),
-			DepartmentIds: departmentIds,
-		}, &entity.Token{
-			AccessToken:  accessToken,
-			RefreshToken: refreshToken,
-			ExpiresAt:    time.Now().Add(24 * time.Hour), // AccessToken의 만료 시간
-		}, nil
+	return toLoginUserResponse(user, departmentIds), token, nil
 }
 
 func (u *authUsecase) SignOut(userId uint, email string) error {
@@ -145,3 +132,74 @@ func (u *authUsecase) GetRefreshToken(userId uint, email string) (string, error)
 	}
 	return refreshToken, nil
 }
+
+// TODO 활성 회사 변경 - 소속된 회사만 가능, 해당 회사 기준으로 토큰 재발급
+func (u *authUsecase) SwitchCompany(userId uint, companyId uint) (*res.LoginUserResponse, *entity.Token, error) {
+	membership, err := u.userRepo.GetCompanyMembership(userId, companyId)
+	if err != nil {
+		log.Printf("회사 소속 조회 오류: %v", err)
+		return nil, nil, common.NewError(http.StatusInternalServerError, "회사 소속 조회에 실패했습니다", err)
+	}
+	if membership == nil {
+		return nil, nil, common.NewError(http.StatusForbidden, "소속되지 않은 회사입니다", nil)
+	}
+
+	user, err := u.userRepo.GetUserByIDInCompany(userId, companyId)
+	if err != nil {
+		log.Printf("사용자 조회 오류: %v", err)
+		return nil, nil, common.NewError(http.StatusInternalServerError, "사용자 조회에 실패했습니다", err)
+	}
+
+	token, err := u.issueTokens(user, companyId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	departmentIds := make([]uint, len(user.UserProfile.Departments))
+	for i, dept := range user.UserProfile.Departments {
+		departmentIds[i] = (*dept)["id"].(uint)
+	}
+
+	return toLoginUserResponse(user, departmentIds), token, nil
+}
+
+// issueTokens 활성 회사를 담은 토큰 발급 후 리프레시 토큰 저장
+func (u *authUsecase) issueTokens(user *_userEntity.User, companyId uint) (*entity.Token, error) {
+	accessToken, err := _utils.GenerateAccessToken(*user.Name, *user.Email, *user.ID, companyId)
+	if err != nil {
+		log.Printf("액세스 토큰 생성 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "액세스 토큰 생성에 실패했습니다", err)
+	}
+
+	refreshToken, err := _utils.GenerateRefreshToken(*user.Name, *user.Email, *user.ID, companyId)
+	if err != nil {
+		log.Printf("리프레시 토큰 생성 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "리프레시 토큰 생성에 실패했습니다", err)
+	}
+
+	userIdStr := strconv.FormatUint(uint64(*user.ID), 10)
+	//TODO userId:email 키값으로 레디스 저장
+	mergeKey := fmt.Sprintf("%s:%s", userIdStr, *user.Email)
+	if err := u.authRepo.StoreRefreshToken(mergeKey, refreshToken); err != nil {
+		log.Printf("리프레시 토큰 저장 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "리프레시 토큰 저장에 실패했습니다", err)
+	}
+
+	return &entity.Token{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresAt:    time.Now().Add(24 * time.Hour), // AccessToken의 만료 시간
+	}, nil
+}
+
+func toLoginUserResponse(user *_userEntity.User, departmentIds []uint) *res.LoginUserResponse {
+	return &res.LoginUserResponse{
+		ID:            _utils.GetValueOrDefault(user.ID, 0),
+		Email:         _utils.GetValueOrDefault(user.Email, ""),
+		Name:          _utils.GetValueOrDefault(user.Name, ""),
+		Role:          uint(_utils.GetValueOrDefault(&user.Role, 4)),
+		CompanyID:     _utils.GetValueOrDefault(user.UserProfile.CompanyID, 0),
+		ProfileImage:  _utils.SignProfileImageURL(_utils.GetValueOrDefault(user.UserProfile.Image, "")),
+		DepartmentIds: departmentIds,
+	}
+}
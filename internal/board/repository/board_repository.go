@@ -11,6 +11,7 @@ type BoardRepository interface {
 	CreateBoard(board *entity.Board, boardUsers []entity.BoardUser) error
 	GetBoardByID(boardID uint) (*entity.Board, error)
 	GetBoardsByProjectID(projectID uint) ([]entity.Board, error)
+	GetBoardCountByProjectID(projectID uint) (int64, error)
 
 	UpdateBoard(board *entity.Board) error
 	DeleteBoard(boardID uint) error
@@ -2,8 +2,11 @@ package usecase
 
 import (
 	"encoding/json"
+	"fmt"
 	"link/internal/board/entity"
 	_boardRepo "link/internal/board/repository"
+	_companyEntity "link/internal/company/entity"
+	_companyRepo "link/internal/company/repository"
 	_projectRepo "link/internal/project/repository"
 	_userEntity "link/internal/user/entity"
 	_userRepo "link/internal/user/repository"
@@ -16,6 +19,7 @@ import (
 	"time"
 
 	_nats "link/pkg/nats"
+	_util "link/pkg/util"
 
 	"github.com/google/uuid"
 )
@@ -35,6 +39,7 @@ type boardUsecase struct {
 	boardRepo     _boardRepo.BoardRepository
 	userRepo      _userRepo.UserRepository
 	projectRepo   _projectRepo.ProjectRepository
+	companyRepo   _companyRepo.CompanyRepository
 	natsPublisher *_nats.NatsPublisher
 }
 
@@ -42,11 +47,13 @@ func NewBoardUsecase(
 	boardRepo _boardRepo.BoardRepository,
 	userRepo _userRepo.UserRepository,
 	projectRepo _projectRepo.ProjectRepository,
+	companyRepo _companyRepo.CompanyRepository,
 	natsPublisher *_nats.NatsPublisher) BoardUsecase {
 	return &boardUsecase{
 		boardRepo:     boardRepo,
 		userRepo:      userRepo,
 		projectRepo:   projectRepo,
+		companyRepo:   companyRepo,
 		natsPublisher: natsPublisher,
 	}
 }
@@ -66,6 +73,13 @@ func (u *boardUsecase) CreateBoard(userId uint, request *req.CreateBoardRequest)
 		return common.NewError(http.StatusForbidden, "프로젝트 접근 권한 없음", nil)
 	}
 
+	//TODO 회사 프로젝트는 요금제 최대 보드 수 확인
+	if hasAcess.CompanyID != 0 {
+		if err := u.checkBoardQuota(hasAcess.CompanyID, request.ProjectID); err != nil {
+			return err
+		}
+	}
+
 	board := entity.Board{
 		Title:     request.Title,
 		ProjectID: request.ProjectID,
@@ -161,6 +175,25 @@ func (u *boardUsecase) CreateBoard(userId uint, request *req.CreateBoardRequest)
 	return nil
 }
 
+func (u *boardUsecase) checkBoardQuota(companyId uint, projectId uint) error {
+	company, err := u.companyRepo.GetCompanyByID(companyId)
+	if err != nil {
+		return common.NewError(http.StatusInternalServerError, "회사 조회 실패", err)
+	}
+
+	boardCount, err := u.boardRepo.GetBoardCountByProjectID(projectId)
+	if err != nil {
+		return common.NewError(http.StatusInternalServerError, "보드 수 조회 실패", err)
+	}
+
+	plan := _companyEntity.GetCompanyPlan(company.Grade)
+	if _companyEntity.IsPlanLimitExceeded(plan.MaxBoardsPerProject, boardCount, 1) {
+		return common.NewError(http.StatusPaymentRequired, fmt.Sprintf("%s 요금제의 프로젝트당 최대 보드 수(%d개)를 초과했습니다. 상위 요금제로 변경해주세요", plan.Name, plan.MaxBoardsPerProject), nil)
+	}
+
+	return nil
+}
+
 func (u *boardUsecase) GetBoards(userId uint, projectID uint) (*res.GetBoardsResponse, error) {
 	_, err := u.userRepo.GetUserByID(userId)
 	if err != nil {
@@ -772,7 +805,7 @@ func (u *boardUsecase) GetKanbanBoard(userId uint, boardID uint) (*res.GetKanban
 
 		var profileImage string
 		if user.UserProfile != nil && user.UserProfile.Image != nil {
-			profileImage = *user.UserProfile.Image
+			profileImage = _util.SignProfileImageURL(*user.UserProfile.Image)
 		} else {
 			profileImage = "" // 기본값 설정
 		}
@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+const (
+	CelebrationTypeBirthday    = "BIRTHDAY"
+	CelebrationTypeAnniversary = "ANNIVERSARY"
+)
+
+// TODO 기념일 대상 사용자 (생일, 입사일)
+type CelebrationUser struct {
+	UserID        uint      `json:"user_id"`
+	Name          string    `json:"name"`
+	Image         *string   `json:"image,omitempty"`
+	Birthday      string    `json:"birthday,omitempty"`
+	EntryDate     time.Time `json:"entry_date,omitempty"`
+	DepartmentIds []uint    `json:"department_ids,omitempty"`
+}
+
+type Celebration struct {
+	UserID        uint      `json:"user_id"`
+	Name          string    `json:"name"`
+	Image         *string   `json:"image,omitempty"`
+	Type          string    `json:"type"`
+	Date          time.Time `json:"date"`
+	Years         int       `json:"years,omitempty"`
+	DepartmentIds []uint    `json:"department_ids,omitempty"`
+}
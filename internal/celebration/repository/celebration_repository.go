@@ -0,0 +1,15 @@
+package repository
+
+import "link/internal/celebration/entity"
+
+type CelebrationRepository interface {
+	GetCelebrationCompanyIds() ([]uint, error)
+	GetCelebrationUsers(companyId uint) ([]entity.CelebrationUser, error)
+	GetDepartmentMemberIds(departmentIds []uint) ([]uint, error)
+
+	IsCelebrationPostEnabled(companyId uint) (bool, error)
+	UpdateCelebrationPostEnabled(companyId uint, enabled bool) error
+
+	//TODO 다중 서버 환경에서 중복 발송 방지
+	MarkCelebrationSent(companyId uint, date string) (bool, error)
+}
@@ -0,0 +1,334 @@
+package usecase
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"link/internal/celebration/entity"
+	_celebrationRepo "link/internal/celebration/repository"
+	_postEntity "link/internal/post/entity"
+	_postRepo "link/internal/post/repository"
+	_userEntity "link/internal/user/entity"
+	_userRepo "link/internal/user/repository"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+	_nats "link/pkg/nats"
+	_util "link/pkg/util"
+)
+
+var celebrationRangeDays = map[string]int{
+	"today": 1,
+	"week":  7,
+	"month": 30,
+}
+
+type CelebrationUsecase interface {
+	GetCelebrations(requestUserId uint, activeCompanyId uint, rangeType string) (*res.GetCelebrationsResponse, error)
+	UpdateCelebrationSetting(requestUserId uint, activeCompanyId uint, request req.UpdateCelebrationSettingRequest) error
+
+	//TODO 스케줄러에서 매일 호출
+	SendDailyCelebrations() error
+}
+
+type celebrationUsecase struct {
+	celebrationRepo _celebrationRepo.CelebrationRepository
+	userRepo        _userRepo.UserRepository
+	postRepo        _postRepo.PostRepository
+	natsPublisher   *_nats.NatsPublisher
+}
+
+func NewCelebrationUsecase(
+	celebrationRepo _celebrationRepo.CelebrationRepository,
+	userRepo _userRepo.UserRepository,
+	postRepo _postRepo.PostRepository,
+	natsPublisher *_nats.NatsPublisher) CelebrationUsecase {
+	return &celebrationUsecase{
+		celebrationRepo: celebrationRepo,
+		userRepo:        userRepo,
+		postRepo:        postRepo,
+		natsPublisher:   natsPublisher,
+	}
+}
+
+// TODO 회사 기념일 목록 조회 (대시보드 위젯)
+func (u *celebrationUsecase) GetCelebrations(requestUserId uint, activeCompanyId uint, rangeType string) (*res.GetCelebrationsResponse, error) {
+	days, ok := celebrationRangeDays[strings.ToLower(rangeType)]
+	if !ok {
+		return nil, common.NewError(http.StatusBadRequest, "유효하지 않은 range 값입니다. 'today', 'week', 'month' 중 하나를 선택하세요", nil)
+	}
+
+	requestUser, err := u.userRepo.GetUserByIDInCompany(requestUserId, activeCompanyId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+	}
+
+	if requestUser.UserProfile == nil || requestUser.UserProfile.CompanyID == nil || *requestUser.UserProfile.CompanyID == 0 {
+		return nil, common.NewError(http.StatusBadRequest, "사용자가 소속된 회사가 없습니다", nil)
+	}
+
+	users, err := u.celebrationRepo.GetCelebrationUsers(*requestUser.UserProfile.CompanyID)
+	if err != nil {
+		log.Printf("기념일 사용자 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "기념일 조회에 실패했습니다", err)
+	}
+
+	today := startOfDayKst(time.Now())
+	celebrations := collectCelebrations(users, today, days)
+
+	response := make([]res.CelebrationResponse, len(celebrations))
+	for i, celebration := range celebrations {
+		response[i] = res.CelebrationResponse{
+			UserID:   celebration.UserID,
+			Name:     celebration.Name,
+			Image:    _util.SignProfileImageURL(_util.GetValueOrDefault(celebration.Image, "")),
+			Type:     celebration.Type,
+			Date:     celebration.Date.Format(time.DateOnly),
+			Years:    celebration.Years,
+			DaysLeft: int(celebration.Date.Sub(today).Hours() / 24),
+		}
+	}
+
+	return &res.GetCelebrationsResponse{
+		Range:        strings.ToLower(rangeType),
+		From:         today.Format(time.DateOnly),
+		To:           today.AddDate(0, 0, days-1).Format(time.DateOnly),
+		Celebrations: response,
+	}, nil
+}
+
+// TODO 회사 기념일 축하 게시물 설정 (회사 관리자만)
+func (u *celebrationUsecase) UpdateCelebrationSetting(requestUserId uint, activeCompanyId uint, request req.UpdateCelebrationSettingRequest) error {
+	requestUser, err := u.userRepo.GetUserByIDInCompany(requestUserId, activeCompanyId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+	}
+
+	if requestUser.Role > _userEntity.RoleCompanySubManager {
+		log.Printf("권한이 없는 사용자가 기념일 설정을 변경하려 했습니다: 사용자 ID %d", requestUserId)
+		return common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+	}
+
+	if requestUser.UserProfile == nil || requestUser.UserProfile.CompanyID == nil || *requestUser.UserProfile.CompanyID == 0 {
+		return common.NewError(http.StatusBadRequest, "사용자가 소속된 회사가 없습니다", nil)
+	}
+
+	if err := u.celebrationRepo.UpdateCelebrationPostEnabled(*requestUser.UserProfile.CompanyID, *request.CelebrationPostEnabled); err != nil {
+		log.Printf("기념일 설정 변경에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "기념일 설정 변경에 실패했습니다", err)
+	}
+
+	return nil
+}
+
+// TODO 오늘의 생일, 입사기념일 축하 게시물 작성 및 같은 부서 동료에게 알림 전송
+func (u *celebrationUsecase) SendDailyCelebrations() error {
+	today := startOfDayKst(time.Now())
+	dateKey := today.Format(time.DateOnly)
+
+	companyIds, err := u.celebrationRepo.GetCelebrationCompanyIds()
+	if err != nil {
+		return fmt.Errorf("기념일 회사 목록 조회 실패: %w", err)
+	}
+
+	for _, companyId := range companyIds {
+		users, err := u.celebrationRepo.GetCelebrationUsers(companyId)
+		if err != nil {
+			log.Printf("기념일 사용자 조회 실패 - 회사 ID %d: %v", companyId, err)
+			continue
+		}
+
+		celebrations := collectCelebrations(users, today, 1)
+		if len(celebrations) == 0 {
+			continue
+		}
+
+		// 다른 서버 인스턴스에서 이미 발송했다면 건너뜀
+		marked, err := u.celebrationRepo.MarkCelebrationSent(companyId, dateKey)
+		if err != nil {
+			log.Printf("기념일 발송 기록 실패 - 회사 ID %d: %v", companyId, err)
+			continue
+		}
+		if !marked {
+			continue
+		}
+
+		if err := u.createCelebrationPost(companyId, celebrations); err != nil {
+			log.Printf("기념일 게시물 작성 실패 - 회사 ID %d: %v", companyId, err)
+		}
+
+		for _, celebration := range celebrations {
+			if err := u.notifyColleagues(companyId, celebration); err != nil {
+				log.Printf("기념일 알림 전송 실패 - 사용자 ID %d: %v", celebration.UserID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (u *celebrationUsecase) createCelebrationPost(companyId uint, celebrations []entity.Celebration) error {
+	enabled, err := u.celebrationRepo.IsCelebrationPostEnabled(companyId)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return nil
+	}
+
+	// 축하 게시물은 시스템 관리자 계정으로 작성
+	author, err := u.userRepo.GetUserByEmail(os.Getenv("SYSTEM_ADMIN_EMAIL"))
+	if err != nil {
+		return fmt.Errorf("시스템 관리자 조회 실패: %w", err)
+	}
+
+	lines := make([]string, len(celebrations))
+	for i, celebration := range celebrations {
+		lines[i] = celebrationMessage(celebration)
+	}
+
+	post := &_postEntity.Post{
+		UserID:     *author.ID,
+		Title:      fmt.Sprintf("🎉 %s 오늘의 축하 소식", celebrationDateLabel(celebrations[0].Date)),
+		Content:    strings.Join(lines, "\n"),
+		Visibility: "company",
+		CompanyID:  &companyId,
+		CreatedAt:  time.Now(),
+	}
+
+	return u.postRepo.CreatePost(*author.ID, post)
+}
+
+func (u *celebrationUsecase) notifyColleagues(companyId uint, celebration entity.Celebration) error {
+	receiverIds, err := u.celebrationRepo.GetDepartmentMemberIds(celebration.DepartmentIds)
+	if err != nil {
+		return err
+	}
+
+	content := fmt.Sprintf("[CELEBRATION] %s", celebrationMessage(celebration))
+
+	for _, receiverId := range receiverIds {
+		if receiverId == celebration.UserID {
+			continue
+		}
+
+		payload := map[string]interface{}{
+			"doc_id":      uuid.New().String(),
+			"sender_id":   celebration.UserID,
+			"receiver_id": receiverId,
+			"title":       "CELEBRATION",
+			"content":     content,
+			"alarm_type":  "CELEBRATION",
+			"is_read":     false,
+			"company_id":  companyId,
+			"target_type": "USER",
+			"target_id":   celebration.UserID,
+			"timestamp":   time.Now(),
+		}
+
+		natsData := map[string]interface{}{
+			"topic":   "link.event.notification.celebration",
+			"payload": payload,
+		}
+
+		jsonData, err := json.Marshal(natsData)
+		if err != nil {
+			log.Printf("NATS 데이터 직렬화 오류: %v", err)
+			continue
+		}
+		if err := u.natsPublisher.PublishEvent("link.event.notification.celebration", jsonData); err != nil {
+			log.Printf("NATS 메시지 전송 실패: %v", err)
+			continue
+		}
+
+		// 실시간 알림 (WsHandler.subscribeToNotifications)
+		if wsData, err := json.Marshal(payload); err == nil {
+			u.natsPublisher.PublishEvent("notification.created", wsData)
+		}
+	}
+
+	return nil
+}
+
+// collectCelebrations from(KST 자정)부터 days일 안에 돌아오는 기념일 목록 (날짜, 이름 순)
+func collectCelebrations(users []entity.CelebrationUser, from time.Time, days int) []entity.Celebration {
+	to := from.AddDate(0, 0, days)
+	celebrations := make([]entity.Celebration, 0)
+
+	for _, user := range users {
+		if birthday, err := time.Parse(time.DateOnly, user.Birthday); err == nil {
+			date := nextOccurrence(birthday.Month(), birthday.Day(), from)
+			if date.Before(to) {
+				celebrations = append(celebrations, entity.Celebration{
+					UserID:        user.UserID,
+					Name:          user.Name,
+					Image:         user.Image,
+					Type:          entity.CelebrationTypeBirthday,
+					Date:          date,
+					DepartmentIds: user.DepartmentIds,
+				})
+			}
+		}
+
+		if !user.EntryDate.IsZero() {
+			entryDate := _util.ParseKst(user.EntryDate)
+			date := nextOccurrence(entryDate.Month(), entryDate.Day(), from)
+			years := date.Year() - entryDate.Year()
+			if date.Before(to) && years > 0 {
+				celebrations = append(celebrations, entity.Celebration{
+					UserID:        user.UserID,
+					Name:          user.Name,
+					Image:         user.Image,
+					Type:          entity.CelebrationTypeAnniversary,
+					Date:          date,
+					Years:         years,
+					DepartmentIds: user.DepartmentIds,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(celebrations, func(i, j int) bool {
+		if !celebrations[i].Date.Equal(celebrations[j].Date) {
+			return celebrations[i].Date.Before(celebrations[j].Date)
+		}
+		return celebrations[i].Name < celebrations[j].Name
+	})
+
+	return celebrations
+}
+
+// nextOccurrence from 이후(당일 포함) 처음 돌아오는 month/day
+func nextOccurrence(month time.Month, day int, from time.Time) time.Time {
+	date := time.Date(from.Year(), month, day, 0, 0, 0, 0, from.Location())
+	if date.Before(from) {
+		date = time.Date(from.Year()+1, month, day, 0, 0, 0, 0, from.Location())
+	}
+	return date
+}
+
+func startOfDayKst(t time.Time) time.Time {
+	kst := _util.ParseKst(t)
+	return time.Date(kst.Year(), kst.Month(), kst.Day(), 0, 0, 0, 0, kst.Location())
+}
+
+func celebrationMessage(celebration entity.Celebration) string {
+	if celebration.Type == entity.CelebrationTypeAnniversary {
+		return fmt.Sprintf("%s님의 입사 %d주년입니다", celebration.Name, celebration.Years)
+	}
+	return fmt.Sprintf("%s님의 생일입니다", celebration.Name)
+}
+
+func celebrationDateLabel(date time.Time) string {
+	return fmt.Sprintf("%d월 %d일", int(date.Month()), date.Day())
+}
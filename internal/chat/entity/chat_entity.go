@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"errors"
 	_userEntity "link/internal/user/entity"
 	"time"
 )
@@ -9,7 +10,15 @@ type ChatRoom struct {
 	ID        uint                `json:"id"`
 	Name      string              `json:"name"`
 	IsPrivate bool                `json:"is_private"`      // 그룹 채팅인지 1:1 채팅인지 구분
+	Image     *string             `json:"image,omitempty"` // 그룹 채팅방 대표 이미지
+	OwnerID   uint                `json:"owner_id,omitempty"`
 	Users     []*_userEntity.User `json:"users,omitempty"` // 사용자 정보 배열로 변경
+	CreatedAt time.Time           `json:"created_at,omitempty"`
+
+	//TODO 조회한 사용자의 채팅방 설정 (채팅방 리스트)
+	IsMuted    bool       `json:"is_muted,omitempty"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	PinnedAt   *time.Time `json:"pinned_at,omitempty"`
 }
 
 type Chat struct {
@@ -21,10 +30,132 @@ type Chat struct {
 	SenderName  string    `json:"sender_name,omitempty"`
 	SenderEmail string    `json:"sender_email,omitempty"`
 	SenderImage string    `json:"sender_image,omitempty"`
-	UnreadBy    []uint    `json:"unread_by,omitempty"`
-	UnreadCount uint      `json:"unread_count,omitempty"`
 	CreatedAt   time.Time `json:"created_at,omitempty"`
 	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+	MessageType string    `json:"message_type,omitempty"` // system이면 시스템 메시지
+
+	//TODO 안정적인 전송 - 클라이언트 메시지 ID, 채팅방별 순번
+	ClientMsgID string `json:"client_msg_id,omitempty"`
+	Seq         int64  `json:"seq,omitempty"`
+
+	//TODO 메시지 수정 이력
+	EditedAt    *time.Time         `json:"edited_at,omitempty"`
+	EditHistory []*ChatEditHistory `json:"edit_history,omitempty"`
+
+	//TODO 스레드 답글
+	ParentMessageID string         `json:"parent_message_id,omitempty"`
+	ShowInRoom      bool           `json:"show_in_room,omitempty"`
+	ReplyCount      int            `json:"reply_count,omitempty"`
+	LastReply       *ChatLastReply `json:"last_reply,omitempty"`
+
+	//TODO 이모지 반응
+	Reactions []*ChatReaction `json:"reactions,omitempty"`
+
+	//TODO 첨부파일
+	Attachments []*ChatAttachment `json:"attachments,omitempty"`
+
+	//TODO 채팅방 고정 메시지
+	PinnedAt *time.Time `json:"pinned_at,omitempty"`
+	PinnedBy uint       `json:"pinned_by,omitempty"`
+}
+
+type ChatAttachment struct {
+	Name         string `json:"name"`
+	Size         int64  `json:"size"`
+	MimeType     string `json:"mime_type"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+}
+
+type ChatReaction struct {
+	EmojiID   uint      `json:"emoji_id"`
+	Unified   string    `json:"unified"`
+	Content   string    `json:"content"`
+	UserID    uint      `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ChatLastReply struct {
+	SenderID   uint      `json:"sender_id"`
+	SenderName string    `json:"sender_name"`
+	Content    string    `json:"content"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ChatEditHistory struct {
+	Content  string    `json:"content"`
+	EditedAt time.Time `json:"edited_at"`
+}
+
+const (
+	ChatRoomRoleOwner  = "owner"
+	ChatRoomRoleAdmin  = "admin"
+	ChatRoomRoleMember = "member"
+
+	ChatMessageTypeSystem = "system"
+
+	ScheduledChatStatusPending  = "pending"
+	ScheduledChatStatusSending  = "sending"
+	ScheduledChatStatusSent     = "sent"
+	ScheduledChatStatusCanceled = "canceled"
+	ScheduledChatStatusFailed   = "failed"
+)
+
+// TODO 저장소의 조건부 쓰기 결과 - usecase에서 상태 코드로 변환
+var (
+	ErrChatPinLimitExceeded     = errors.New("채팅방 고정 메시지 수 초과")
+	ErrChatMessageAlreadyPinned = errors.New("이미 고정된 메시지")
+	ErrDuplicateChatMessage     = errors.New("이미 저장된 메시지")
+)
+
+// 예약 메시지 - 작성자에게만 보임
+type ScheduledChatMessage struct {
+	ID              uint       `json:"id"`
+	SenderID        uint       `json:"sender_id"`
+	ChatRoomID      uint       `json:"chat_room_id"`
+	Content         string     `json:"content"`
+	ParentMessageID string     `json:"parent_message_id,omitempty"`
+	ShowInRoom      bool       `json:"show_in_room,omitempty"`
+	SendAt          time.Time  `json:"send_at"`
+	Status          string     `json:"status"`
+	ChatMessageID   string     `json:"chat_message_id,omitempty"`
+	FailReason      string     `json:"fail_reason,omitempty"`
+	SentAt          *time.Time `json:"sent_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// 채팅방 참여 중인 사용자와 권한
+type ChatRoomMember struct {
+	ChatRoomID uint      `json:"chat_room_id"`
+	UserID     uint      `json:"user_id"`
+	Name       string    `json:"name"`
+	Email      string    `json:"email"`
+	Image      *string   `json:"image,omitempty"`
+	Role       string    `json:"role"`
+	JoinedAt   time.Time `json:"joined_at"`
+}
+
+// 사용자별 메시지 북마크 - 채팅방 정보와 메시지 포함 (삭제된 메시지면 Message가 nil)
+type ChatBookmark struct {
+	ID            uint      `json:"id"`
+	UserID        uint      `json:"user_id"`
+	ChatRoomID    uint      `json:"chat_room_id"`
+	ChatRoomName  string    `json:"chat_room_name"`
+	ChatRoomImage *string   `json:"chat_room_image,omitempty"`
+	IsPrivate     bool      `json:"is_private"`
+	ChatMessageID string    `json:"chat_message_id"`
+	CreatedAt     time.Time `json:"created_at"`
+	Message       *Chat     `json:"message,omitempty"`
+}
+
+// 채팅방 참여자별 읽음 커서
+type ChatReadCursor struct {
+	ChatRoomID        uint       `json:"chat_room_id"`
+	UserID            uint       `json:"user_id"`
+	LastReadMessageID string     `json:"last_read_message_id,omitempty"`
+	LastReadAt        *time.Time `json:"last_read_at,omitempty"`
+	JoinedAt          time.Time  `json:"joined_at"`
 }
 
 type ChatMeta struct {
@@ -1,6 +1,9 @@
 package repository
 
-import "link/internal/chat/entity"
+import (
+	"link/internal/chat/entity"
+	"time"
+)
 
 type ChatRepository interface {
 	CreateChatRoom(chatRoom *entity.ChatRoom) error
@@ -14,10 +17,64 @@ type ChatRepository interface {
 	IsUserInChatRoom(userId uint, chatRoomId uint) bool
 	IsPrivateChatRoom(chatRoomId uint) bool
 
+	//TODO 그룹 채팅방 관리
+	GetChatRoomMembers(chatRoomId uint) ([]*entity.ChatRoomMember, error)
+	GetChatRoomMember(userId uint, chatRoomId uint) (*entity.ChatRoomMember, error)
+	UpdateChatRoom(chatRoomId uint, name *string, image *string) error
+	AddChatRoomMembers(chatRoomId uint, userIds []uint) error
+	UpdateChatRoomMemberRole(chatRoomId uint, userId uint, role string) error
+	TransferChatRoomOwnership(chatRoomId uint, ownerId uint, newOwnerId uint) error
+
 	//TODO 메시지 관련
 	SaveMessage(chat *entity.Chat) error
 	GetChatMessages(chatRoomID uint, queryOptions map[string]interface{}) (*entity.ChatMeta, []*entity.Chat, error)
 	DeleteChatMessage(senderID uint, chatRoomID uint, chatMessageID string) error
+	GetChatMessageById(chatMessageID string) (*entity.Chat, error)
+	UpdateChatMessage(chatMessageID string, prevContent string, content string, editedAt time.Time) error
+	GetThreadReplies(chatRoomID uint, parentMessageID string, queryOptions map[string]interface{}) (*entity.ChatMeta, []*entity.Chat, error)
+	AddThreadReply(parentMessageID string, lastReply *entity.ChatLastReply) (int, error)
+	AddChatMessageReaction(chatMessageID string, reaction *entity.ChatReaction) ([]*entity.ChatReaction, error)
+	RemoveChatMessageReaction(chatMessageID string, userId uint, emojiId uint) ([]*entity.ChatReaction, error)
+	GetLatestChatMessage(chatRoomID uint) (*entity.Chat, error)
+	GetChatAttachmentMessages(chatRoomID uint, queryOptions map[string]interface{}) (*entity.ChatMeta, []*entity.Chat, error)
+	SearchChatMessages(chatRoomIDs []uint, queryOptions map[string]interface{}) (*entity.ChatMeta, []*entity.Chat, error)
+	GetChatMessagesAround(anchor *entity.Chat, before int, after int) ([]*entity.Chat, []*entity.Chat, error)
+
+	//TODO 고정 메시지, 북마크 관련
+	PinChatMessage(chatRoomID uint, chatMessageID string, userId uint, pinnedAt time.Time, maxPinned int) error
+	UnpinChatMessage(chatMessageID string) error
+	GetPinnedChatMessages(chatRoomID uint) ([]*entity.Chat, error)
+	CreateChatBookmark(userId uint, chatRoomID uint, chatMessageID string) error
+	DeleteChatBookmark(userId uint, chatMessageID string) error
+	GetChatBookmarks(userId uint, queryOptions map[string]interface{}) (*entity.ChatMeta, []*entity.ChatBookmark, error)
+	GetBookmarkedChatMessageIDs(userId uint, chatMessageIDs []string) (map[string]bool, error)
+
+	//TODO 안정적인 전송 - 중복 저장 방지, 재연결 시 누락 메시지 재전송
+	GetChatMessageByClientMsgID(chatRoomID uint, senderID uint, clientMsgID string) (*entity.Chat, error)
+	GetChatMessagesAfterSeq(chatRoomID uint, afterSeq int64, limit int) (*entity.ChatMeta, []*entity.Chat, error)
+
+	//TODO 사용자별 채팅방 설정 (알림 끄기, 보관, 상단 고정)과 채팅방 리스트 마지막 메시지
+	UpdateChatRoomSettings(userId uint, chatRoomId uint, settings map[string]interface{}) error
+	GetMutedChatRoomUserIDs(chatRoomId uint) ([]uint, error)
+	GetLastChatMessages(chatRoomIDs []uint) (map[uint]*entity.Chat, error)
+
+	//TODO 예약 메시지
+	CreateScheduledChatMessage(scheduledMessage *entity.ScheduledChatMessage) error
+	GetScheduledChatMessages(senderID uint, chatRoomID uint) ([]*entity.ScheduledChatMessage, error)
+	GetScheduledChatMessageByID(scheduledMessageID uint) (*entity.ScheduledChatMessage, error)
+	UpdatePendingScheduledChatMessage(scheduledMessageID uint, updates map[string]interface{}) (bool, error)
+	ClaimDueScheduledChatMessages(now time.Time, limit int) ([]*entity.ScheduledChatMessage, error)
+	CompleteScheduledChatMessage(scheduledMessageID uint, status string, chatMessageID string, failReason string) error
+
+	//TODO 요금제 채팅 보관 기간 - 회사 팀 채팅방 기준
+	GetCompanyChatRoomIDs(companyID uint) ([]uint, error)
+	DeleteChatMessagesBefore(chatRoomIDs []uint, before time.Time) ([]*entity.ChatAttachment, error)
+
+	//TODO 읽음 커서 관련
+	GetChatRoomReadCursors(chatRoomID uint) ([]*entity.ChatReadCursor, error)
+	GetChatRoomReadCursor(userId uint, chatRoomID uint) (*entity.ChatReadCursor, error)
+	UpdateReadCursor(userId uint, chatRoomID uint, chatMessageID string, readAt time.Time) (bool, error)
+	GetUnreadCounts(userId uint, chatRoomIDs []uint) (map[uint]int, error)
 
 	//TODO 레디스 관련
 	SetChatRoomToRedis(roomId uint, chatRoomInfo map[string]interface{}) error
@@ -2,15 +2,27 @@ package usecase
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"html"
 	"log"
 	"net/http"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/nats-io/nats.go"
 
 	"link/internal/chat/entity"
 	_chatRepo "link/internal/chat/repository"
+	_companyEntity "link/internal/company/entity"
+	_companyRepo "link/internal/company/repository"
+	_likeRepo "link/internal/like/repository"
 	_userEntity "link/internal/user/entity"
 	_userRepo "link/internal/user/repository"
 	"link/pkg/common"
@@ -22,37 +34,94 @@ import (
 
 type ChatUsecase interface {
 	CreateChatRoom(userId uint, request *req.CreateChatRoomRequest) (*res.CreateChatRoomResponse, error)
-	GetChatRoomList(userId uint) ([]*res.ChatRoomInfoResponse, error)
+	GetChatRoomList(userId uint, queryParams *req.GetChatRoomListQueryParams) ([]*res.ChatRoomInfoResponse, error)
+	UpdateChatRoomSettings(userId uint, chatRoomId uint, request *req.UpdateChatRoomSettingsRequest) (*res.ChatRoomSettingsResponse, error)
 	GetChatRoomById(roomId uint) (*res.ChatRoomInfoResponse, error)
 	LeaveChatRoom(userId uint, chatRoomId uint) error
 
-	SaveMessage(senderID uint, chatRoomID uint, content string) (*entity.Chat, error)
+	IsChatRoomMember(userId uint, chatRoomId uint) bool
+
+	//TODO 그룹 채팅방 관리 - 방장, 관리자
+	GetChatRoomMembers(userId uint, chatRoomId uint) ([]*res.ChatRoomMemberResponse, error)
+	UpdateChatRoom(userId uint, chatRoomId uint, request *req.UpdateChatRoomRequest, imageUrl *string) (*res.ChatRoomUpdatedPayload, error)
+	AddChatRoomMembers(userId uint, chatRoomId uint, request *req.AddChatRoomMembersRequest) (*res.ChatRoomUpdatedPayload, error)
+	RemoveChatRoomMember(userId uint, chatRoomId uint, targetUserId uint) (*res.ChatRoomUpdatedPayload, error)
+	UpdateChatRoomMemberRole(userId uint, chatRoomId uint, targetUserId uint, request *req.UpdateChatRoomMemberRoleRequest) (*res.ChatRoomUpdatedPayload, error)
+	TransferChatRoomOwnership(userId uint, chatRoomId uint, request *req.TransferChatRoomOwnerRequest) (*res.ChatRoomUpdatedPayload, error)
+
+	SaveMessage(senderID uint, chatRoomID uint, content string, parentMessageID string, showInRoom bool, clientMsgID string, attachments []*entity.ChatAttachment) (*entity.Chat, error)
+	GetMissedChatMessages(userId uint, chatRoomID uint, afterSeq int64) (*res.ChatReplayResponse, error)
+
+	//TODO 예약 메시지 - 작성자만 조회/수정/취소, 스케줄러가 전송
+	ScheduleChatMessage(userId uint, chatRoomID uint, request *req.ScheduleChatMessageRequest) (*res.ScheduledChatMessageResponse, error)
+	GetScheduledChatMessages(userId uint, chatRoomID uint) ([]*res.ScheduledChatMessageResponse, error)
+	UpdateScheduledChatMessage(userId uint, chatRoomID uint, scheduledMessageID uint, request *req.UpdateScheduledChatMessageRequest) (*res.ScheduledChatMessageResponse, error)
+	CancelScheduledChatMessage(userId uint, chatRoomID uint, scheduledMessageID uint) error
+	DeliverScheduledChatMessages() error
+	PurgeExpiredChatMessages() error
 	GetChatMessages(userId uint, chatRoomID uint, queryParams *req.GetChatMessagesQueryParams) (*res.GetChatMessagesResponse, error)
 	DeleteChatMessage(senderID uint, request *req.DeleteChatMessageRequest) error
+	UpdateChatMessage(senderID uint, request *req.UpdateChatMessageRequest) (*res.ChatMessagesResponse, error)
+	GetChatMessageHistory(userId uint, chatRoomID uint, chatMessageID string) (*res.ChatMessageHistoryResponse, error)
+	GetChatThread(userId uint, chatRoomID uint, parentMessageID string, queryParams *req.GetChatThreadQueryParams) (*res.GetChatThreadResponse, error)
+	AddChatMessageReaction(userId uint, chatRoomID uint, chatMessageID string, request *req.ChatReactionRequest) ([]*res.ChatReactionResponse, error)
+	RemoveChatMessageReaction(userId uint, chatRoomID uint, chatMessageID string, emojiId uint) ([]*res.ChatReactionResponse, error)
+	ReadChatRoom(userId uint, chatRoomID uint, request *req.ReadChatRoomRequest) (*res.ChatReadResponse, error)
+	SendAttachmentMessage(senderID uint, chatRoomID uint, request *req.SendChatAttachmentRequest, uploads []*req.ChatAttachmentUpload) (*res.ChatPayload, error)
+	GetChatFiles(userId uint, chatRoomID uint, queryParams *req.GetChatFilesQueryParams) (*res.GetChatFilesResponse, error)
+	SearchChatMessages(userId uint, queryParams *req.SearchChatMessagesQueryParams) (*res.SearchChatMessagesResponse, error)
+	GetChatMessageContext(userId uint, chatRoomID uint, chatMessageID string, before int, after int) (*res.ChatMessageContextResponse, error)
+
+	//TODO 메시지 고정 (채팅방 공용), 북마크 (개인)
+	GetPinnedChatMessages(userId uint, chatRoomID uint) ([]*res.ChatMessagesResponse, error)
+	PinChatMessage(userId uint, chatRoomID uint, chatMessageID string) (*res.ChatPinPayload, error)
+	UnpinChatMessage(userId uint, chatRoomID uint, chatMessageID string) (*res.ChatPinPayload, error)
+	BookmarkChatMessage(userId uint, chatRoomID uint, chatMessageID string) error
+	RemoveChatMessageBookmark(userId uint, chatMessageID string) error
+	GetChatBookmarks(userId uint, queryParams *req.GetChatBookmarksQueryParams) (*res.GetChatBookmarksResponse, error)
 
 	SetChatRoomToRedis(roomId uint, chatRoomInfo map[string]interface{}) error
 	GetChatRoomByIdFromRedis(roomId uint) (*res.ChatRoomInfoResponse, error)
 }
 
+// 메시지 수정 가능 시간 (CHAT_MESSAGE_EDIT_WINDOW_MINUTES, 기본 15분)
+const defaultChatMessageEditWindow = 15 * time.Minute
+
+var chatMessageEditWindow = getChatMessageEditWindow()
+
+func getChatMessageEditWindow() time.Duration {
+	minutes, err := strconv.Atoi(os.Getenv("CHAT_MESSAGE_EDIT_WINDOW_MINUTES"))
+	if err != nil || minutes <= 0 {
+		return defaultChatMessageEditWindow
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
 type chatUsecase struct {
-	chatRepository _chatRepo.ChatRepository
-	userRepository _userRepo.UserRepository
-	natsPublisher  *_nats.NatsPublisher
-	natsSubscriber *_nats.NatsSubscriber
+	chatRepository    _chatRepo.ChatRepository
+	userRepository    _userRepo.UserRepository
+	likeRepository    _likeRepo.LikeRepository
+	companyRepository _companyRepo.CompanyRepository
+	natsPublisher     *_nats.NatsPublisher
+	natsSubscriber    *_nats.NatsSubscriber
 }
 
 func NewChatUsecase(
 	chatRepository _chatRepo.ChatRepository,
 	userRepository _userRepo.UserRepository,
+	likeRepository _likeRepo.LikeRepository,
+	companyRepository _companyRepo.CompanyRepository,
 	natsPublisher *_nats.NatsPublisher,
 	natsSubscriber *_nats.NatsSubscriber,
 ) ChatUsecase {
 
 	uc := &chatUsecase{
-		chatRepository: chatRepository,
-		userRepository: userRepository,
-		natsPublisher:  natsPublisher,
-		natsSubscriber: natsSubscriber,
+		chatRepository:    chatRepository,
+		userRepository:    userRepository,
+		likeRepository:    likeRepository,
+		companyRepository: companyRepository,
+		natsPublisher:     natsPublisher,
+		natsSubscriber:    natsSubscriber,
 	}
 
 	uc.setUpNatsSubscriber()
@@ -89,6 +158,11 @@ func (uc *chatUsecase) setUpNatsSubscriber() {
 
 // TODO 채팅방 생성
 func (uc *chatUsecase) CreateChatRoom(userId uint, request *req.CreateChatRoomRequest) (*res.CreateChatRoomResponse, error) {
+	//TODO 만든 사람도 참여자에 포함 - 그룹 채팅방의 방장
+	if !slices.Contains(request.UserIDs, userId) {
+		request.UserIDs = append(request.UserIDs, userId)
+	}
+
 	// 해당 유저들이 실제로 존재하는지 확인
 	users, err := uc.userRepository.GetUserByIds(request.UserIDs)
 	if err != nil {
@@ -140,6 +214,10 @@ func (uc *chatUsecase) CreateChatRoom(userId uint, request *req.CreateChatRoomRe
 		Name:      fmt.Sprintf("%s 외 %d명 채팅방", chatRoomName, len(users)-1),
 		IsPrivate: request.IsPrivate,
 		Users:     userPointers,
+		OwnerID:   userId,
+	}
+	if name := strings.TrimSpace(request.Name); name != "" && !request.IsPrivate {
+		chatRoom.Name = name
 	}
 
 	err = uc.chatRepository.CreateChatRoom(chatRoom)
@@ -187,7 +265,7 @@ func (uc *chatUsecase) GetChatRoomById(roomId uint) (*res.ChatRoomInfoResponse,
 			ID:    user.ID,
 			Name:  user.Name,
 			Email: user.Email,
-			Image: user.UserProfile.Image,
+			Image: _util.SignProfileImageURLPtr(user.UserProfile.Image),
 		}
 
 		for _, chatRoomUser := range user.ChatRoomUsers {
@@ -200,6 +278,9 @@ func (uc *chatUsecase) GetChatRoomById(roomId uint) (*res.ChatRoomInfoResponse,
 			if leftAt, ok := chatRoomUser["left_at"].(time.Time); ok {
 				userResponse[i].LeftAt = &leftAt
 			}
+			if role, ok := chatRoomUser["role"].(string); ok && !chatRoom.IsPrivate {
+				userResponse[i].Role = &role
+			}
 		}
 	}
 
@@ -207,23 +288,49 @@ func (uc *chatUsecase) GetChatRoomById(roomId uint) (*res.ChatRoomInfoResponse,
 		ID:        chatRoom.ID,
 		Name:      chatRoom.Name,
 		IsPrivate: &chatRoom.IsPrivate,
+		Image:     chatRoom.Image,
 		Users:     userResponse,
 	}
 
 	return chatRoomResponse, nil
 }
 
-// TODO 해당 사용자가 참여중인 채팅방 리스트 조회
-func (uc *chatUsecase) GetChatRoomList(userId uint) ([]*res.ChatRoomInfoResponse, error) {
+// TODO 해당 사용자가 참여중인 채팅방 리스트 조회 - 상단 고정 먼저, 최근 활동순
+func (uc *chatUsecase) GetChatRoomList(userId uint, queryParams *req.GetChatRoomListQueryParams) ([]*res.ChatRoomInfoResponse, error) {
 	chatRooms, err := uc.chatRepository.GetChatRoomList(userId)
 	if err != nil {
 		log.Printf("채팅방 리스트 조회 중 DB 오류: %v", err)
 		return nil, common.NewError(http.StatusInternalServerError, "채팅방 리스트 조회에 실패했습니다", err)
 	}
 
-	chatRoomListResponse := make([]*res.ChatRoomInfoResponse, len(chatRooms))
-
+	//TODO 채팅방별 마지막 메시지 - 한 번에 조회
+	chatRoomIDs := make([]uint, len(chatRooms))
 	for i, chatRoom := range chatRooms {
+		chatRoomIDs[i] = chatRoom.ID
+	}
+	lastMessages, err := uc.chatRepository.GetLastChatMessages(chatRoomIDs)
+	if err != nil {
+		log.Printf("채팅방 마지막 메시지 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 리스트 조회에 실패했습니다", err)
+	}
+
+	chatRoomListResponse := make([]*res.ChatRoomInfoResponse, 0, len(chatRooms))
+	lastActivities := make(map[uint]time.Time, len(chatRooms))
+	pinnedAt := make(map[uint]time.Time, len(chatRooms))
+
+	for _, chatRoom := range chatRooms {
+		lastMessage := lastMessages[chatRoom.ID]
+		lastActivity := chatRoom.CreatedAt
+		if lastMessage != nil {
+			lastActivity = lastMessage.CreatedAt
+		}
+
+		//TODO 보관한 채팅방은 보관 이후 새 메시지가 오면 다시 표시
+		isArchived := chatRoom.ArchivedAt != nil && !lastActivity.After(*chatRoom.ArchivedAt)
+		if isArchived != queryParams.Archived {
+			continue
+		}
+
 		userResponse := make([]res.UserInfoResponse, len(chatRoom.Users))
 
 		for j, user := range chatRoom.Users {
@@ -241,18 +348,128 @@ func (uc *chatUsecase) GetChatRoomList(userId uint) ([]*res.ChatRoomInfoResponse
 			}
 		}
 
-		chatRoomListResponse[i] = &res.ChatRoomInfoResponse{
-			ID:        chatRoom.ID,
-			Name:      chatRoom.Name,
-			IsPrivate: &chatRoom.IsPrivate,
-			Users:     userResponse,
+		chatRoomResponse := &res.ChatRoomInfoResponse{
+			ID:             chatRoom.ID,
+			Name:           chatRoom.Name,
+			IsPrivate:      &chatRoom.IsPrivate,
+			Image:          chatRoom.Image,
+			Users:          userResponse,
+			IsMuted:        chatRoom.IsMuted,
+			IsArchived:     isArchived,
+			IsPinned:       chatRoom.PinnedAt != nil,
+			LastMessage:    toChatLastMessageResponse(lastMessage),
+			LastActivityAt: _util.ParseKst(lastActivity).Format(time.DateTime),
 		}
+		chatRoomListResponse = append(chatRoomListResponse, chatRoomResponse)
+		lastActivities[chatRoom.ID] = lastActivity
+		if chatRoom.PinnedAt != nil {
+			pinnedAt[chatRoom.ID] = *chatRoom.PinnedAt
+		}
+	}
 
+	//TODO 채팅방별 안 읽은 메시지 수 - 한 번에 조회
+	listedChatRoomIDs := make([]uint, len(chatRoomListResponse))
+	for i, chatRoomResponse := range chatRoomListResponse {
+		listedChatRoomIDs[i] = chatRoomResponse.ID
+	}
+	unreadCounts, err := uc.chatRepository.GetUnreadCounts(userId, listedChatRoomIDs)
+	if err != nil {
+		log.Printf("안 읽은 메시지 수 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 리스트 조회에 실패했습니다", err)
+	}
+	for _, chatRoomResponse := range chatRoomListResponse {
+		unreadCount := unreadCounts[chatRoomResponse.ID]
+		chatRoomResponse.UnreadCount = &unreadCount
 	}
 
+	sort.SliceStable(chatRoomListResponse, func(i, j int) bool {
+		left, right := chatRoomListResponse[i], chatRoomListResponse[j]
+		if left.IsPinned != right.IsPinned {
+			return left.IsPinned
+		}
+		if left.IsPinned && !pinnedAt[left.ID].Equal(pinnedAt[right.ID]) {
+			return pinnedAt[left.ID].After(pinnedAt[right.ID])
+		}
+		return lastActivities[left.ID].After(lastActivities[right.ID])
+	})
+
 	return chatRoomListResponse, nil
 }
 
+// 채팅방 리스트 미리보기 길이 (글자 수)
+const chatLastMessagePreviewLength = 100
+
+func toChatLastMessageResponse(chatMessage *entity.Chat) *res.ChatLastMessageResponse {
+	if chatMessage == nil {
+		return nil
+	}
+
+	content := []rune(chatMessage.Content)
+	if len(content) > chatLastMessagePreviewLength {
+		content = append(content[:chatLastMessagePreviewLength], []rune("...")...)
+	}
+
+	return &res.ChatLastMessageResponse{
+		ChatMessageID:   chatMessage.ID,
+		SenderID:        chatMessage.SenderID,
+		SenderName:      chatMessage.SenderName,
+		Content:         string(content),
+		MessageType:     chatMessage.MessageType,
+		AttachmentCount: len(chatMessage.Attachments),
+		CreatedAt:       _util.ParseKst(chatMessage.CreatedAt).Format(time.DateTime),
+	}
+}
+
+// TODO 사용자별 채팅방 설정 - 알림 끄기, 보관, 상단 고정 (본인에게만 적용)
+func (uc *chatUsecase) UpdateChatRoomSettings(userId uint, chatRoomId uint, request *req.UpdateChatRoomSettingsRequest) (*res.ChatRoomSettingsResponse, error) {
+	if !uc.chatRepository.IsUserInChatRoom(userId, chatRoomId) {
+		log.Printf("채팅방 설정 변경 중 사용자 조회 오류: 채팅방 %d에 사용자 %d 없음", chatRoomId, userId)
+		return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+	}
+
+	now := time.Now()
+	settings := make(map[string]interface{})
+	if request.IsMuted != nil {
+		settings["is_muted"] = *request.IsMuted
+	}
+	if request.IsArchived != nil {
+		if *request.IsArchived {
+			settings["archived_at"] = now
+		} else {
+			settings["archived_at"] = nil
+		}
+	}
+	if request.IsPinned != nil {
+		if *request.IsPinned {
+			settings["pinned_at"] = now
+		} else {
+			settings["pinned_at"] = nil
+		}
+	}
+
+	if err := uc.chatRepository.UpdateChatRoomSettings(userId, chatRoomId, settings); err != nil {
+		log.Printf("채팅방 설정 변경 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 설정 변경에 실패했습니다", err)
+	}
+
+	chatRooms, err := uc.chatRepository.GetChatRoomList(userId)
+	if err != nil {
+		log.Printf("채팅방 설정 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 설정 변경에 실패했습니다", err)
+	}
+	for _, chatRoom := range chatRooms {
+		if chatRoom.ID == chatRoomId {
+			return &res.ChatRoomSettingsResponse{
+				ChatRoomID: chatRoomId,
+				IsMuted:    chatRoom.IsMuted,
+				IsArchived: chatRoom.ArchivedAt != nil,
+				IsPinned:   chatRoom.PinnedAt != nil,
+			}, nil
+		}
+	}
+	return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+}
+
 // TODO 채팅방 나가기 - nats로 웹소켓에 전송
 func (uc *chatUsecase) LeaveChatRoom(userId uint, chatRoomId uint) error {
 	//TODO 사용자가 있는지 먼저 확인
@@ -263,18 +480,41 @@ func (uc *chatUsecase) LeaveChatRoom(userId uint, chatRoomId uint) error {
 	}
 
 	//TODO 채팅방이 있는지 확인
-	_, err = uc.chatRepository.GetChatRoomById(chatRoomId)
+	chatRoom, err := uc.chatRepository.GetChatRoomById(chatRoomId)
 	if err != nil {
 		fmt.Printf("채팅방 나가기 중 채팅방 조회 오류: %v", err)
 		return common.NewError(http.StatusNotFound, "존재하지 않는 채팅방입니다", err)
 	}
 
+	//TODO 방장이 나가면 관리자, 없으면 가장 먼저 참여한 사용자에게 방장 위임
+	var newOwner *entity.ChatRoomMember
+	if !chatRoom.IsPrivate {
+		newOwner, err = uc.findNextChatRoomOwner(userId, chatRoomId)
+		if err != nil {
+			fmt.Printf("채팅방 나가기 중 방장 위임 오류: %v", err)
+			return common.NewError(http.StatusInternalServerError, "채팅방 나가기에 실패했습니다", err)
+		}
+		if newOwner != nil {
+			if err := uc.chatRepository.TransferChatRoomOwnership(chatRoomId, userId, newOwner.UserID); err != nil {
+				fmt.Printf("채팅방 나가기 중 방장 위임 오류: %v", err)
+				return common.NewError(http.StatusInternalServerError, "채팅방 나가기에 실패했습니다", err)
+			}
+		}
+	}
+
 	err = uc.chatRepository.LeaveChatRoom(userId, chatRoomId)
 	if err != nil {
 		fmt.Printf("채팅방 나가기 중 DB 오류: %v", err)
 		return common.NewError(http.StatusInternalServerError, "채팅방 나가기에 실패했습니다", err)
 	}
 
+	//TODO 나가기 메시지는 chat.room.leave 이벤트로 전달되므로 저장만
+	uc.saveSystemMessage(chatRoomId, userId, fmt.Sprintf("%s님이 채팅방을 나갔습니다.", *leaveUser.Name))
+	if newOwner != nil {
+		uc.sendSystemMessage(chatRoomId, userId, fmt.Sprintf("%s님이 방장이 되었습니다.", newOwner.Name), 0)
+		uc.publishChatRoomUpdated(chatRoomId)
+	}
+
 	//TODO []byte로 변환
 	auditLeaveData, err := json.Marshal(map[string]interface{}{
 		"roomId":        chatRoomId,
@@ -352,10 +592,345 @@ func (uc *chatUsecase) AddUserChatRoom(requestUserId uint, targetUserId uint, ch
 	return nil
 }
 
+// TODO 채팅방 참여 여부 - 웹소켓 접속자 표시, 입력 중 표시는 참여자만
+func (uc *chatUsecase) IsChatRoomMember(userId uint, chatRoomId uint) bool {
+	return uc.chatRepository.IsUserInChatRoom(userId, chatRoomId)
+}
+
+// TODO 채팅방 참여자와 권한 조회
+func (uc *chatUsecase) GetChatRoomMembers(userId uint, chatRoomId uint) ([]*res.ChatRoomMemberResponse, error) {
+	if !uc.chatRepository.IsUserInChatRoom(userId, chatRoomId) {
+		log.Printf("채팅방 참여자 조회 중 사용자 조회 오류: 채팅방 %d에 사용자 %d 없음", chatRoomId, userId)
+		return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+	}
+
+	members, err := uc.chatRepository.GetChatRoomMembers(chatRoomId)
+	if err != nil {
+		log.Printf("채팅방 참여자 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 참여자 조회에 실패했습니다", err)
+	}
+
+	return toChatRoomMemberResponses(members), nil
+}
+
+// TODO 그룹 채팅방 이름, 이미지 변경 - 방장, 관리자만
+func (uc *chatUsecase) UpdateChatRoom(userId uint, chatRoomId uint, request *req.UpdateChatRoomRequest, imageUrl *string) (*res.ChatRoomUpdatedPayload, error) {
+	manager, err := uc.getGroupChatRoomManager(userId, chatRoomId, entity.ChatRoomRoleOwner, entity.ChatRoomRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	var name *string
+	if request.Name != nil {
+		trimmed := strings.TrimSpace(*request.Name)
+		if trimmed == "" {
+			return nil, common.NewError(http.StatusBadRequest, "채팅방 이름을 입력해주세요", nil)
+		}
+		if utf8.RuneCountInString(trimmed) > 50 {
+			return nil, common.NewError(http.StatusBadRequest, "채팅방 이름은 50자까지 입력할 수 있습니다", nil)
+		}
+		name = &trimmed
+	}
+	if name == nil && imageUrl == nil {
+		return nil, common.NewError(http.StatusBadRequest, "변경할 내용이 없습니다", nil)
+	}
+
+	if err := uc.chatRepository.UpdateChatRoom(chatRoomId, name, imageUrl); err != nil {
+		log.Printf("채팅방 수정 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 수정에 실패했습니다", err)
+	}
+
+	if name != nil {
+		uc.sendSystemMessage(chatRoomId, userId, fmt.Sprintf("%s님이 채팅방 이름을 '%s'(으)로 변경했습니다.", manager.Name, *name), 0)
+	}
+	if imageUrl != nil {
+		uc.sendSystemMessage(chatRoomId, userId, fmt.Sprintf("%s님이 채팅방 사진을 변경했습니다.", manager.Name), 0)
+	}
+
+	return uc.publishChatRoomUpdated(chatRoomId)
+}
+
+// TODO 그룹 채팅방 멤버 추가 - 방장, 관리자만 (이미 참여 중인 사용자는 제외)
+func (uc *chatUsecase) AddChatRoomMembers(userId uint, chatRoomId uint, request *req.AddChatRoomMembersRequest) (*res.ChatRoomUpdatedPayload, error) {
+	manager, err := uc.getGroupChatRoomManager(userId, chatRoomId, entity.ChatRoomRoleOwner, entity.ChatRoomRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := uc.userRepository.GetUserByIds(request.UserIDs)
+	if err != nil {
+		log.Printf("채팅방 멤버 추가 중 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 멤버 추가에 실패했습니다", err)
+	}
+
+	newUserIds := make([]uint, 0, len(users))
+	newUserNames := make([]string, 0, len(users))
+	for _, user := range users {
+		if uc.chatRepository.IsUserInChatRoom(*user.ID, chatRoomId) {
+			continue
+		}
+		newUserIds = append(newUserIds, *user.ID)
+		newUserNames = append(newUserNames, *user.Name)
+	}
+	if len(newUserIds) == 0 {
+		return nil, common.NewError(http.StatusBadRequest, "추가할 사용자가 없습니다", nil)
+	}
+
+	if err := uc.chatRepository.AddChatRoomMembers(chatRoomId, newUserIds); err != nil {
+		log.Printf("채팅방 멤버 추가 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 멤버 추가에 실패했습니다", err)
+	}
+
+	uc.sendSystemMessage(chatRoomId, userId, fmt.Sprintf("%s님이 %s님을 초대했습니다.", manager.Name, strings.Join(newUserNames, "님, ")), 0)
+
+	return uc.publishChatRoomUpdated(chatRoomId)
+}
+
+// TODO 그룹 채팅방 멤버 내보내기 - 방장은 모두, 관리자는 일반 멤버만
+func (uc *chatUsecase) RemoveChatRoomMember(userId uint, chatRoomId uint, targetUserId uint) (*res.ChatRoomUpdatedPayload, error) {
+	manager, err := uc.getGroupChatRoomManager(userId, chatRoomId, entity.ChatRoomRoleOwner, entity.ChatRoomRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if userId == targetUserId {
+		return nil, common.NewError(http.StatusBadRequest, "본인은 내보낼 수 없습니다. 채팅방 나가기를 이용해주세요", nil)
+	}
+
+	target, err := uc.getChatRoomMember(targetUserId, chatRoomId)
+	if err != nil {
+		return nil, err
+	}
+	if target.Role == entity.ChatRoomRoleOwner ||
+		(manager.Role == entity.ChatRoomRoleAdmin && target.Role != entity.ChatRoomRoleMember) {
+		return nil, common.NewError(http.StatusForbidden, "해당 멤버를 내보낼 권한이 없습니다", nil)
+	}
+
+	if err := uc.chatRepository.LeaveChatRoom(targetUserId, chatRoomId); err != nil {
+		log.Printf("채팅방 멤버 내보내기 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 멤버 내보내기에 실패했습니다", err)
+	}
+
+	uc.sendSystemMessage(chatRoomId, userId, fmt.Sprintf("%s님이 %s님을 내보냈습니다.", manager.Name, target.Name), targetUserId)
+
+	return uc.publishChatRoomUpdated(chatRoomId)
+}
+
+// TODO 그룹 채팅방 관리자 지정/해제 - 방장만
+func (uc *chatUsecase) UpdateChatRoomMemberRole(userId uint, chatRoomId uint, targetUserId uint, request *req.UpdateChatRoomMemberRoleRequest) (*res.ChatRoomUpdatedPayload, error) {
+	owner, err := uc.getGroupChatRoomManager(userId, chatRoomId, entity.ChatRoomRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if request.Role != entity.ChatRoomRoleAdmin && request.Role != entity.ChatRoomRoleMember {
+		return nil, common.NewError(http.StatusBadRequest, "유효하지 않은 권한입니다", nil)
+	}
+	if userId == targetUserId {
+		return nil, common.NewError(http.StatusBadRequest, "방장 권한은 위임으로만 변경할 수 있습니다", nil)
+	}
+
+	target, err := uc.getChatRoomMember(targetUserId, chatRoomId)
+	if err != nil {
+		return nil, err
+	}
+	if target.Role == request.Role {
+		return nil, common.NewError(http.StatusBadRequest, "이미 해당 권한입니다", nil)
+	}
+
+	if err := uc.chatRepository.UpdateChatRoomMemberRole(chatRoomId, targetUserId, request.Role); err != nil {
+		log.Printf("채팅방 멤버 권한 변경 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 멤버 권한 변경에 실패했습니다", err)
+	}
+
+	if request.Role == entity.ChatRoomRoleAdmin {
+		uc.sendSystemMessage(chatRoomId, userId, fmt.Sprintf("%s님이 %s님을 관리자로 지정했습니다.", owner.Name, target.Name), 0)
+	} else {
+		uc.sendSystemMessage(chatRoomId, userId, fmt.Sprintf("%s님이 %s님의 관리자 권한을 해제했습니다.", owner.Name, target.Name), 0)
+	}
+
+	return uc.publishChatRoomUpdated(chatRoomId)
+}
+
+// TODO 방장 위임 - 방장만, 기존 방장은 관리자로
+func (uc *chatUsecase) TransferChatRoomOwnership(userId uint, chatRoomId uint, request *req.TransferChatRoomOwnerRequest) (*res.ChatRoomUpdatedPayload, error) {
+	owner, err := uc.getGroupChatRoomManager(userId, chatRoomId, entity.ChatRoomRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if userId == request.UserID {
+		return nil, common.NewError(http.StatusBadRequest, "이미 방장입니다", nil)
+	}
+
+	target, err := uc.getChatRoomMember(request.UserID, chatRoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.chatRepository.TransferChatRoomOwnership(chatRoomId, userId, target.UserID); err != nil {
+		log.Printf("방장 위임 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "방장 위임에 실패했습니다", err)
+	}
+
+	uc.sendSystemMessage(chatRoomId, userId, fmt.Sprintf("%s님이 %s님에게 방장을 위임했습니다.", owner.Name, target.Name), 0)
+
+	return uc.publishChatRoomUpdated(chatRoomId)
+}
+
+// 그룹 채팅방에서 요청 사용자가 roles 중 하나의 권한을 가지고 있는지 확인
+func (uc *chatUsecase) getGroupChatRoomManager(userId uint, chatRoomId uint, roles ...string) (*entity.ChatRoomMember, error) {
+	chatRoom, err := uc.chatRepository.GetChatRoomById(chatRoomId)
+	if err != nil {
+		log.Printf("채팅방 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 채팅방입니다", err)
+	}
+	if chatRoom.IsPrivate {
+		return nil, common.NewError(http.StatusBadRequest, "1:1 채팅방은 관리할 수 없습니다", nil)
+	}
+
+	member, err := uc.getChatRoomMember(userId, chatRoomId)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(roles, member.Role) {
+		return nil, common.NewError(http.StatusForbidden, "채팅방 관리 권한이 없습니다", nil)
+	}
+
+	return member, nil
+}
+
+func (uc *chatUsecase) getChatRoomMember(userId uint, chatRoomId uint) (*entity.ChatRoomMember, error) {
+	member, err := uc.chatRepository.GetChatRoomMember(userId, chatRoomId)
+	if err != nil {
+		log.Printf("채팅방 참여자 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 참여자 조회에 실패했습니다", err)
+	}
+	if member == nil {
+		return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+	}
+	return member, nil
+}
+
+// 방장이 나갈 때 위임받을 사용자 - 관리자 중 먼저 참여한 사람, 없으면 가장 먼저 참여한 사람
+func (uc *chatUsecase) findNextChatRoomOwner(ownerId uint, chatRoomId uint) (*entity.ChatRoomMember, error) {
+	members, err := uc.chatRepository.GetChatRoomMembers(chatRoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	var isOwner bool
+	var firstAdmin, firstMember *entity.ChatRoomMember
+	for _, member := range members {
+		if member.UserID == ownerId {
+			isOwner = member.Role == entity.ChatRoomRoleOwner
+			continue
+		}
+		if firstAdmin == nil && member.Role == entity.ChatRoomRoleAdmin {
+			firstAdmin = member
+		}
+		if firstMember == nil {
+			firstMember = member
+		}
+	}
+	if !isOwner {
+		return nil, nil
+	}
+	if firstAdmin != nil {
+		return firstAdmin, nil
+	}
+	return firstMember, nil
+}
+
+// 시스템 메시지 저장 - 메시지 목록에 남도록 mongo에 직접 저장
+func (uc *chatUsecase) saveSystemMessage(chatRoomId uint, actorId uint, content string) *entity.Chat {
+	chat := &entity.Chat{
+		ChatRoomID:  chatRoomId,
+		SenderID:    actorId,
+		Content:     content,
+		CreatedAt:   time.Now(),
+		MessageType: entity.ChatMessageTypeSystem,
+	}
+	if err := uc.chatRepository.SaveMessage(chat); err != nil {
+		log.Printf("시스템 메시지 저장 중 오류: %v", err)
+	}
+	return chat
+}
+
+// TODO 시스템 메시지 저장 후 이벤트 발행 -> 웹소켓 chat (removedUserId가 있으면 웹소켓 채팅방에서도 제외)
+func (uc *chatUsecase) sendSystemMessage(chatRoomId uint, actorId uint, content string, removedUserId uint) {
+	chat := uc.saveSystemMessage(chatRoomId, actorId, content)
+
+	systemData := map[string]interface{}{
+		"roomId":  chatRoomId,
+		"message": toChatPayload(chat),
+	}
+	if removedUserId != 0 {
+		systemData["removedUserId"] = removedUserId
+	}
+
+	jsonData, err := json.Marshal(systemData)
+	if err != nil {
+		log.Printf("시스템 메시지 이벤트 직렬화 오류: %v", err)
+		return
+	}
+	if err := uc.natsPublisher.PublishEvent("chat.room.system", jsonData); err != nil {
+		log.Printf("시스템 메시지 이벤트 발행 오류: %v", err)
+	}
+}
+
+// TODO 채팅방 정보 변경 이벤트 발행 -> 웹소켓 chat.room.updated
+func (uc *chatUsecase) publishChatRoomUpdated(chatRoomId uint) (*res.ChatRoomUpdatedPayload, error) {
+	chatRoom, err := uc.chatRepository.GetChatRoomById(chatRoomId)
+	if err != nil {
+		log.Printf("채팅방 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 조회에 실패했습니다", err)
+	}
+	members, err := uc.chatRepository.GetChatRoomMembers(chatRoomId)
+	if err != nil {
+		log.Printf("채팅방 참여자 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 참여자 조회에 실패했습니다", err)
+	}
+
+	payload := &res.ChatRoomUpdatedPayload{
+		ChatRoomID: chatRoom.ID,
+		Name:       chatRoom.Name,
+		Image:      chatRoom.Image,
+		Members:    toChatRoomMemberResponses(members),
+	}
+
+	roomData, err := json.Marshal(map[string]interface{}{
+		"roomId": chatRoomId,
+		"room":   payload,
+	})
+	if err != nil {
+		log.Printf("채팅방 정보 변경 이벤트 직렬화 오류: %v", err)
+		return payload, nil
+	}
+	if err := uc.natsPublisher.PublishEvent("chat.room.updated", roomData); err != nil {
+		log.Printf("채팅방 정보 변경 이벤트 발행 오류: %v", err)
+	}
+
+	return payload, nil
+}
+
+func toChatRoomMemberResponses(members []*entity.ChatRoomMember) []*res.ChatRoomMemberResponse {
+	result := make([]*res.ChatRoomMemberResponse, len(members))
+	for i, member := range members {
+		result[i] = &res.ChatRoomMemberResponse{
+			UserID:   member.UserID,
+			Name:     member.Name,
+			Email:    member.Email,
+			Image:    _util.SignProfileImageURLPtr(member.Image),
+			Role:     member.Role,
+			JoinedAt: _util.ParseKst(member.JoinedAt).Format(time.DateTime),
+		}
+	}
+	return result
+}
+
 // TODO 단체방 채팅 초대
 
-// TODO 메시지 저장
-func (uc *chatUsecase) SaveMessage(senderID uint, chatRoomID uint, content string) (*entity.Chat, error) {
+// TODO 메시지 저장 - parentMessageID가 있으면 스레드 답글
+// clientMsgID로 이미 저장된 메시지면 저장된 메시지와 409 에러를 함께 반환 (재전송 - 브로드캐스트하지 않고 ack만)
+func (uc *chatUsecase) SaveMessage(senderID uint, chatRoomID uint, content string, parentMessageID string, showInRoom bool, clientMsgID string, attachments []*entity.ChatAttachment) (*entity.Chat, error) {
 	//TODO SenderID 조회
 	sender, err := uc.userRepository.GetUserByID(senderID)
 	if err != nil {
@@ -375,6 +950,8 @@ func (uc *chatUsecase) SaveMessage(senderID uint, chatRoomID uint, content strin
 		SenderImage: senderImage,
 		Content:     content,
 		CreatedAt:   time.Now(),
+		Attachments: attachments,
+		ClientMsgID: clientMsgID,
 	}
 
 	//TODO 채팅방 조회
@@ -384,25 +961,87 @@ func (uc *chatUsecase) SaveMessage(senderID uint, chatRoomID uint, content strin
 		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 채팅방입니다", err)
 	}
 
-	// err = uc.chatRepository.SaveMessage(chat)
-	// if err != nil {
-	// 	log.Printf("메시지 저장 중 DB 오류: %v", err)
-	// 	return nil, common.NewError(http.StatusInternalServerError, "메시지 저장에 실패했습니다", err)
-	// }
+	//TODO 채팅방 참여자만 메시지 저장
+	if !uc.chatRepository.IsUserInChatRoom(senderID, chatRoomID) {
+		log.Printf("메시지 저장 중 사용자 조회 오류: 채팅방 %d에 사용자 %d 없음", chatRoomID, senderID)
+		return nil, common.NewError(http.StatusForbidden, "채팅방 참여자만 메시지를 보낼 수 있습니다", nil)
+	}
+
+	//TODO 스레드 답글은 같은 채팅방의 원본 메시지에만 - 답글에 답글은 불가
+	if parentMessageID != "" {
+		parentMessage, err := uc.chatRepository.GetChatMessageById(parentMessageID)
+		if err != nil {
+			log.Printf("스레드 원본 메시지 조회 중 오류: %v", err)
+			return nil, common.NewError(http.StatusNotFound, "존재하지 않는 채팅 메시지입니다", err)
+		}
+		if parentMessage.ChatRoomID != chatRoomID {
+			return nil, common.NewError(http.StatusNotFound, "존재하지 않는 채팅 메시지입니다", nil)
+		}
+		if parentMessage.ParentMessageID != "" {
+			return nil, common.NewError(http.StatusBadRequest, "스레드 답글에는 답글을 달 수 없습니다", nil)
+		}
+		chat.ParentMessageID = parentMessageID
+		chat.ShowInRoom = showInRoom
+	}
+
+	//TODO 같은 client_msg_id로 재전송된 메시지는 다시 저장하지 않음
+	if clientMsgID != "" {
+		savedChat, err := uc.chatRepository.GetChatMessageByClientMsgID(chatRoomID, senderID, clientMsgID)
+		if err != nil {
+			log.Printf("클라이언트 메시지 ID 조회 중 DB 오류: %v", err)
+			return nil, common.NewError(http.StatusInternalServerError, "메시지 저장에 실패했습니다", err)
+		}
+		if savedChat != nil {
+			return savedChat, common.NewError(http.StatusConflict, "이미 전송된 메시지입니다", nil)
+		}
+	}
+
+	//TODO ack에 메시지 ID와 순번을 담아야 하므로 저장은 바로 처리 - NATS 이벤트는 후속 처리용
+	err = uc.chatRepository.SaveMessage(chat)
+	if errors.Is(err, entity.ErrDuplicateChatMessage) {
+		return chat, common.NewError(http.StatusConflict, "이미 전송된 메시지입니다", nil)
+	}
+	if err != nil {
+		log.Printf("메시지 저장 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "메시지 저장에 실패했습니다", err)
+	}
 
 	publishData := map[string]interface{}{
 		"topic":   "link.event.chat.message",
 		"eventId": "chat_test",
 		"payload": map[string]interface{}{
-			"chat_room_id": chatRoomID,
-			"sender_id":    senderID,
-			"sender_name":  *sender.Name,
-			"sender_email": *sender.Email,
-			"content":      content,
+			"chat_room_id":    chatRoomID,
+			"sender_id":       senderID,
+			"sender_name":     *sender.Name,
+			"sender_email":    *sender.Email,
+			"content":         content,
+			"chat_message_id": chat.ID,
+			"seq":             chat.Seq,
+			"saved":           true,
 		},
 	}
+	if chat.ClientMsgID != "" {
+		publishData["payload"].(map[string]interface{})["client_msg_id"] = chat.ClientMsgID
+	}
+
+	//TODO 채팅방 알림을 끈 참여자 - 채팅 메시지 알림은 link.event.chat.message 구독 측에서 보내므로 같은 이벤트에 담아 알림 대상에서 제외
+	// (채팅방 브로드캐스트는 알림 끔과 무관하게 전달)
+	mutedUserIds, err := uc.chatRepository.GetMutedChatRoomUserIDs(chatRoomID)
+	if err != nil {
+		log.Printf("알림 끈 참여자 조회 중 DB 오류: %v", err)
+	} else if len(mutedUserIds) > 0 {
+		publishData["payload"].(map[string]interface{})["muted_user_ids"] = mutedUserIds
+	}
+	if chat.ParentMessageID != "" {
+		payload := publishData["payload"].(map[string]interface{})
+		payload["parent_message_id"] = chat.ParentMessageID
+		payload["show_in_room"] = chat.ShowInRoom
+	}
+	if len(chat.Attachments) > 0 {
+		publishData["payload"].(map[string]interface{})["attachments"] = chat.Attachments
+	}
 
-	//TODO nats로 발행 로직 처리
+	//TODO nats로 발행 - 이미 저장된 메시지이므로 saved, chat_message_id, seq를 담아 구독 측이 다시 저장하지 않도록
 	jsonData, err := json.Marshal(publishData)
 	if err != nil {
 		log.Printf("NATS 데이터 직렬화 오류: %v", err)
@@ -412,9 +1051,43 @@ func (uc *chatUsecase) SaveMessage(senderID uint, chatRoomID uint, content strin
 		uc.natsPublisher.PublishEvent("link.event.chat.message", jsonData)
 	}()
 
+	if chat.ParentMessageID != "" {
+		uc.publishThreadReply(chat)
+	}
+
 	return chat, nil
 }
 
+// TODO 원본 메시지 답글 수 갱신 후 스레드 답글 이벤트 발행 -> 웹소켓 chat.thread.reply
+func (uc *chatUsecase) publishThreadReply(chat *entity.Chat) {
+	replyCount, err := uc.chatRepository.AddThreadReply(chat.ParentMessageID, &entity.ChatLastReply{
+		SenderID:   chat.SenderID,
+		SenderName: chat.SenderName,
+		Content:    chat.Content,
+		CreatedAt:  chat.CreatedAt,
+	})
+	if err != nil {
+		log.Printf("스레드 답글 수 갱신 중 오류: %v", err)
+		return
+	}
+
+	threadData, err := json.Marshal(map[string]interface{}{
+		"roomId": chat.ChatRoomID,
+		"thread": &res.ChatThreadReplyPayload{
+			ParentMessageID: chat.ParentMessageID,
+			ReplyCount:      replyCount,
+			Reply:           toChatPayload(chat),
+		},
+	})
+	if err != nil {
+		log.Printf("스레드 답글 이벤트 직렬화 오류: %v", err)
+		return
+	}
+	if err := uc.natsPublisher.PublishEvent("chat.thread.reply", threadData); err != nil {
+		log.Printf("스레드 답글 이벤트 발행 오류: %v", err)
+	}
+}
+
 // TODO 채팅방 내용 조회
 func (uc *chatUsecase) GetChatMessages(userId uint, chatRoomID uint, queryParams *req.GetChatMessagesQueryParams) (*res.GetChatMessagesResponse, error) {
 
@@ -448,18 +1121,29 @@ func (uc *chatUsecase) GetChatMessages(userId uint, chatRoomID uint, queryParams
 		return nil, common.NewError(http.StatusInternalServerError, "채팅 내용 조회에 실패했습니다", err)
 	}
 
-	chatMessagesResponse := make([]*res.ChatMessagesResponse, len(chatMessages))
+	//TODO 참여자 읽음 커서로 메시지별 읽은 사람 수 계산
+	readCursors, err := uc.chatRepository.GetChatRoomReadCursors(chatRoomID)
+	if err != nil {
+		log.Printf("채팅 내용 조회 중 읽음 커서 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅 내용 조회에 실패했습니다", err)
+	}
+
+	//TODO 본인이 북마크한 메시지 표시
+	chatMessageIDs := make([]string, len(chatMessages))
 	for i, chatMessage := range chatMessages {
-		chatMessagesResponse[i] = &res.ChatMessagesResponse{
-			ChatMessageID: chatMessage.ID,
-			Content:       chatMessage.Content,
-			SenderID:      chatMessage.SenderID,
-			SenderName:    chatMessage.SenderName,
-			SenderImage:   chatMessage.SenderImage, //! 메시지 작성할때 송신자 이미지 추가
-			ChatRoomID:    chatMessage.ChatRoomID,
-			// UnreadCount: chatMessage.UnreadCount,
-			CreatedAt: _util.ParseKst(chatMessage.CreatedAt).Format(time.DateTime),
-		}
+		chatMessageIDs[i] = chatMessage.ID
+	}
+	bookmarked, err := uc.chatRepository.GetBookmarkedChatMessageIDs(*user.ID, chatMessageIDs)
+	if err != nil {
+		log.Printf("채팅 내용 조회 중 북마크 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅 내용 조회에 실패했습니다", err)
+	}
+
+	chatMessagesResponse := make([]*res.ChatMessagesResponse, len(chatMessages))
+	for i, chatMessage := range chatMessages {
+		chatMessagesResponse[i] = toChatMessageResponse(chatMessage, *user.ID)
+		chatMessagesResponse[i].ReadCount = countReadBy(chatMessage, readCursors)
+		chatMessagesResponse[i].IsBookmarked = bookmarked[chatMessage.ID]
 	}
 
 	return &res.GetChatMessagesResponse{
@@ -476,6 +1160,849 @@ func (uc *chatUsecase) GetChatMessages(userId uint, chatRoomID uint, queryParams
 	}, nil
 }
 
+// TODO 스레드 조회 - 원본 메시지와 답글 (커서 페이지네이션)
+func (uc *chatUsecase) GetChatThread(userId uint, chatRoomID uint, parentMessageID string, queryParams *req.GetChatThreadQueryParams) (*res.GetChatThreadResponse, error) {
+	if !uc.chatRepository.IsUserInChatRoom(userId, chatRoomID) {
+		log.Printf("스레드 조회 중 사용자 조회 오류: 채팅방 %d에 사용자 %d 없음", chatRoomID, userId)
+		return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+	}
+
+	parentMessage, err := uc.chatRepository.GetChatMessageById(parentMessageID)
+	if err != nil {
+		log.Printf("스레드 원본 메시지 조회 중 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 채팅 메시지입니다", err)
+	}
+	if parentMessage.ChatRoomID != chatRoomID || parentMessage.ParentMessageID != "" {
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 스레드입니다", nil)
+	}
+
+	queryOptions := map[string]interface{}{
+		"limit":  queryParams.Limit,
+		"cursor": map[string]interface{}{},
+	}
+	if queryParams.Cursor != nil && queryParams.Cursor.CreatedAt != "" {
+		queryOptions["cursor"].(map[string]interface{})["created_at"] = queryParams.Cursor.CreatedAt
+	}
+
+	threadMeta, replies, err := uc.chatRepository.GetThreadReplies(chatRoomID, parentMessageID, queryOptions)
+	if err != nil {
+		log.Printf("스레드 답글 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "스레드 조회에 실패했습니다", err)
+	}
+
+	repliesResponse := make([]*res.ChatMessagesResponse, len(replies))
+	for i, reply := range replies {
+		repliesResponse[i] = toChatMessageResponse(reply, userId)
+	}
+
+	return &res.GetChatThreadResponse{
+		ParentMessage: toChatMessageResponse(parentMessage, userId),
+		Replies:       repliesResponse,
+		Meta: &res.ChatMeta{
+			NextCursor: threadMeta.NextCursor,
+			HasMore:    threadMeta.HasMore,
+			TotalCount: threadMeta.TotalCount,
+			TotalPages: threadMeta.TotalPages,
+			PageSize:   threadMeta.PageSize,
+		},
+	}, nil
+}
+
+func toChatMessageResponse(chatMessage *entity.Chat, userId uint) *res.ChatMessagesResponse {
+	response := &res.ChatMessagesResponse{
+		ChatMessageID: chatMessage.ID,
+		Content:       chatMessage.Content,
+		SenderID:      chatMessage.SenderID,
+		SenderName:    chatMessage.SenderName,
+		SenderImage:   _util.SignProfileImageURL(chatMessage.SenderImage), //! 메시지 작성할때 송신자 이미지 추가
+		ChatRoomID:    chatMessage.ChatRoomID,
+		CreatedAt:     _util.ParseKst(chatMessage.CreatedAt).Format(time.DateTime),
+		MessageType:   chatMessage.MessageType,
+		IsPinned:      chatMessage.PinnedAt != nil,
+		Seq:           chatMessage.Seq,
+
+		ParentMessageID: chatMessage.ParentMessageID,
+		ReplyCount:      chatMessage.ReplyCount,
+		Reactions:       toChatReactionResponses(chatMessage.Reactions, userId),
+		Attachments:     toChatAttachmentResponses(chatMessage.Attachments),
+	}
+	if chatMessage.EditedAt != nil {
+		response.IsEdited = true
+		response.EditedAt = _util.ParseKst(*chatMessage.EditedAt).Format(time.DateTime)
+	}
+	if chatMessage.LastReply != nil {
+		response.LastReply = &res.ChatLastReplyResponse{
+			SenderID:   chatMessage.LastReply.SenderID,
+			SenderName: chatMessage.LastReply.SenderName,
+			Content:    chatMessage.LastReply.Content,
+			CreatedAt:  _util.ParseKst(chatMessage.LastReply.CreatedAt).Format(time.DateTime),
+		}
+	}
+	return response
+}
+
+func toChatAttachmentResponses(attachments []*entity.ChatAttachment) []*res.ChatAttachmentResponse {
+	if len(attachments) == 0 {
+		return nil
+	}
+	result := make([]*res.ChatAttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		result[i] = &res.ChatAttachmentResponse{
+			Name:         attachment.Name,
+			Size:         attachment.Size,
+			MimeType:     attachment.MimeType,
+			URL:          attachment.URL,
+			ThumbnailURL: attachment.ThumbnailURL,
+		}
+	}
+	return result
+}
+
+// TODO 첨부파일 메시지 전송 - 파일은 미들웨어에서 저장, 내용은 선택
+func (uc *chatUsecase) SendAttachmentMessage(senderID uint, chatRoomID uint, request *req.SendChatAttachmentRequest, uploads []*req.ChatAttachmentUpload) (*res.ChatPayload, error) {
+	if !uc.chatRepository.IsUserInChatRoom(senderID, chatRoomID) {
+		log.Printf("첨부파일 전송 중 사용자 조회 오류: 채팅방 %d에 사용자 %d 없음", chatRoomID, senderID)
+		return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+	}
+	if len(uploads) == 0 {
+		return nil, common.NewError(http.StatusBadRequest, "첨부파일이 없습니다", nil)
+	}
+
+	attachments := make([]*entity.ChatAttachment, len(uploads))
+	for i, upload := range uploads {
+		attachments[i] = &entity.ChatAttachment{
+			Name:         upload.Name,
+			Size:         upload.Size,
+			MimeType:     upload.MimeType,
+			URL:          upload.URL,
+			ThumbnailURL: upload.ThumbnailURL,
+		}
+	}
+
+	chat, err := uc.SaveMessage(senderID, chatRoomID, strings.TrimSpace(request.Content), request.ParentMessageID, request.ShowInRoom, request.ClientMsgID, attachments)
+	if err != nil {
+		// 재전송이면 저장된 메시지와 409 에러를 그대로 전달
+		if chat != nil {
+			return toChatPayload(chat), err
+		}
+		return nil, err
+	}
+
+	return toChatPayload(chat), nil
+}
+
+// 웹소켓 chat 이벤트와 같은 형태의 메시지
+func toChatPayload(chat *entity.Chat) *res.ChatPayload {
+	return &res.ChatPayload{
+		ChatRoomID:      chat.ChatRoomID,
+		SenderID:        chat.SenderID,
+		SenderName:      chat.SenderName,
+		SenderEmail:     chat.SenderEmail,
+		SenderImage:     _util.SignProfileImageURL(chat.SenderImage),
+		Content:         chat.Content,
+		CreatedAt:       chat.CreatedAt.Format(time.RFC3339),
+		MessageType:     chat.MessageType,
+		ParentMessageID: chat.ParentMessageID,
+		ShowInRoom:      chat.ShowInRoom,
+		Attachments:     toChatAttachmentResponses(chat.Attachments),
+		ChatMessageID:   chat.ID,
+		ClientMsgID:     chat.ClientMsgID,
+		Seq:             chat.Seq,
+	}
+}
+
+// 재연결 시 한 번에 재전송할 메시지 수
+const chatReplayLimit = 100
+
+// TODO 재연결 시 누락 메시지 - afterSeq 이후 채팅방에 표시되는 메시지 (순번 오름차순)
+func (uc *chatUsecase) GetMissedChatMessages(userId uint, chatRoomID uint, afterSeq int64) (*res.ChatReplayResponse, error) {
+	if !uc.chatRepository.IsUserInChatRoom(userId, chatRoomID) {
+		log.Printf("누락 메시지 조회 중 사용자 조회 오류: 채팅방 %d에 사용자 %d 없음", chatRoomID, userId)
+		return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+	}
+	if afterSeq < 0 {
+		afterSeq = 0
+	}
+
+	replayMeta, chatMessages, err := uc.chatRepository.GetChatMessagesAfterSeq(chatRoomID, afterSeq, chatReplayLimit)
+	if err != nil {
+		log.Printf("누락 메시지 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "누락 메시지 조회에 실패했습니다", err)
+	}
+
+	response := &res.ChatReplayResponse{
+		ChatRoomID: chatRoomID,
+		Messages:   make([]*res.ChatPayload, len(chatMessages)),
+		LastSeq:    afterSeq,
+		HasMore:    replayMeta.HasMore != nil && *replayMeta.HasMore,
+	}
+	for i, chatMessage := range chatMessages {
+		response.Messages[i] = toChatPayload(chatMessage)
+		response.LastSeq = chatMessage.Seq
+	}
+	return response, nil
+}
+
+// TODO 채팅방 파일 모아보기 - 최신순 (커서 페이지네이션)
+func (uc *chatUsecase) GetChatFiles(userId uint, chatRoomID uint, queryParams *req.GetChatFilesQueryParams) (*res.GetChatFilesResponse, error) {
+	if !uc.chatRepository.IsUserInChatRoom(userId, chatRoomID) {
+		log.Printf("채팅방 파일 조회 중 사용자 조회 오류: 채팅방 %d에 사용자 %d 없음", chatRoomID, userId)
+		return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+	}
+
+	queryOptions := map[string]interface{}{
+		"limit":  queryParams.Limit,
+		"cursor": map[string]interface{}{},
+	}
+	if queryParams.Cursor != nil && queryParams.Cursor.CreatedAt != "" {
+		queryOptions["cursor"].(map[string]interface{})["created_at"] = queryParams.Cursor.CreatedAt
+	}
+
+	filesMeta, chatMessages, err := uc.chatRepository.GetChatAttachmentMessages(chatRoomID, queryOptions)
+	if err != nil {
+		log.Printf("채팅방 파일 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 파일 조회에 실패했습니다", err)
+	}
+
+	files := make([]*res.ChatFileResponse, 0)
+	for _, chatMessage := range chatMessages {
+		for _, attachment := range toChatAttachmentResponses(chatMessage.Attachments) {
+			files = append(files, &res.ChatFileResponse{
+				ChatMessageID:          chatMessage.ID,
+				SenderID:               chatMessage.SenderID,
+				SenderName:             chatMessage.SenderName,
+				CreatedAt:              _util.ParseKst(chatMessage.CreatedAt).Format(time.DateTime),
+				ChatAttachmentResponse: attachment,
+			})
+		}
+	}
+
+	return &res.GetChatFilesResponse{
+		Files: files,
+		Meta: &res.ChatMeta{
+			NextCursor: filesMeta.NextCursor,
+			HasMore:    filesMeta.HasMore,
+			TotalCount: filesMeta.TotalCount,
+			TotalPages: filesMeta.TotalPages,
+			PageSize:   filesMeta.PageSize,
+		},
+	}, nil
+}
+
+// 검색 결과 미리보기 - 첫 번째 일치 위치 주변만 잘라서 표시
+const (
+	chatSnippetBefore = 30
+	chatSnippetLength = 100
+)
+
+// TODO 채팅 검색 - 참여 중인 채팅방 전체, 채팅방/보낸 사람/기간 필터
+func (uc *chatUsecase) SearchChatMessages(userId uint, queryParams *req.SearchChatMessagesQueryParams) (*res.SearchChatMessagesResponse, error) {
+	query := strings.TrimSpace(queryParams.Query)
+	if query == "" {
+		return nil, common.NewError(http.StatusBadRequest, "검색어를 입력해주세요", nil)
+	}
+
+	chatRooms, err := uc.chatRepository.GetChatRoomList(userId)
+	if err != nil {
+		log.Printf("채팅 검색 중 채팅방 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅 검색에 실패했습니다", err)
+	}
+
+	chatRoomNames := make(map[uint]string)
+	chatRoomIDs := make([]uint, 0, len(chatRooms))
+	for _, chatRoom := range chatRooms {
+		if queryParams.ChatRoomID != 0 && chatRoom.ID != queryParams.ChatRoomID {
+			continue
+		}
+		chatRoomNames[chatRoom.ID] = chatRoom.Name
+		chatRoomIDs = append(chatRoomIDs, chatRoom.ID)
+	}
+	if queryParams.ChatRoomID != 0 && len(chatRoomIDs) == 0 {
+		return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+	}
+
+	queryOptions := map[string]interface{}{
+		"query":     query,
+		"sender_id": queryParams.SenderID,
+		"page":      queryParams.Page,
+		"limit":     queryParams.Limit,
+	}
+
+	loc, err := time.LoadLocation("Asia/Seoul")
+	if err != nil {
+		log.Printf("시간대 로드 실패: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "시간대 로드 실패", err)
+	}
+	if queryParams.From != "" {
+		from, err := time.ParseInLocation(time.DateOnly, queryParams.From, loc)
+		if err != nil {
+			return nil, common.NewError(http.StatusBadRequest, "시작일 형식이 올바르지 않습니다", err)
+		}
+		queryOptions["from"] = from
+	}
+	if queryParams.To != "" {
+		to, err := time.ParseInLocation(time.DateOnly, queryParams.To, loc)
+		if err != nil {
+			return nil, common.NewError(http.StatusBadRequest, "종료일 형식이 올바르지 않습니다", err)
+		}
+		// 종료일 당일까지 포함
+		queryOptions["to"] = to.AddDate(0, 0, 1)
+	}
+
+	searchResponse := &res.SearchChatMessagesResponse{Results: make([]*res.ChatSearchResultResponse, 0)}
+	if len(chatRoomIDs) == 0 {
+		hasMore := false
+		searchResponse.Meta = &res.ChatMeta{HasMore: &hasMore, PageSize: queryParams.Limit}
+		return searchResponse, nil
+	}
+
+	searchMeta, chatMessages, err := uc.chatRepository.SearchChatMessages(chatRoomIDs, queryOptions)
+	if err != nil {
+		log.Printf("채팅 검색 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅 검색에 실패했습니다", err)
+	}
+
+	terms := strings.Fields(query)
+	for _, chatMessage := range chatMessages {
+		searchResponse.Results = append(searchResponse.Results, &res.ChatSearchResultResponse{
+			ChatMessageID:   chatMessage.ID,
+			ChatRoomID:      chatMessage.ChatRoomID,
+			ChatRoomName:    chatRoomNames[chatMessage.ChatRoomID],
+			SenderID:        chatMessage.SenderID,
+			SenderName:      chatMessage.SenderName,
+			SenderImage:     _util.SignProfileImageURL(chatMessage.SenderImage),
+			Content:         chatMessage.Content,
+			Snippet:         highlightChatSnippet(chatMessage.Content, terms),
+			CreatedAt:       _util.ParseKst(chatMessage.CreatedAt).Format(time.DateTime),
+			ParentMessageID: chatMessage.ParentMessageID,
+		})
+	}
+	searchResponse.Meta = &res.ChatMeta{
+		HasMore:    searchMeta.HasMore,
+		TotalCount: searchMeta.TotalCount,
+		TotalPages: searchMeta.TotalPages,
+		PageSize:   searchMeta.PageSize,
+		PrevPage:   searchMeta.PrevPage,
+		NextPage:   searchMeta.NextPage,
+	}
+
+	return searchResponse, nil
+}
+
+// TODO 검색 결과로 이동 - 메시지 앞뒤 채팅 조회
+// 스레드에만 보낸 답글은 채팅방에 보이지 않으므로 원본 메시지 주변을 조회
+func (uc *chatUsecase) GetChatMessageContext(userId uint, chatRoomID uint, chatMessageID string, before int, after int) (*res.ChatMessageContextResponse, error) {
+	chatMessage, err := uc.getChatRoomMessage(userId, chatRoomID, chatMessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	anchor := chatMessage
+	if chatMessage.ParentMessageID != "" && !chatMessage.ShowInRoom {
+		anchor, err = uc.chatRepository.GetChatMessageById(chatMessage.ParentMessageID)
+		if err != nil {
+			log.Printf("스레드 원본 메시지 조회 중 오류: %v", err)
+			return nil, common.NewError(http.StatusNotFound, "존재하지 않는 채팅 메시지입니다", err)
+		}
+	}
+
+	beforeMessages, afterMessages, err := uc.chatRepository.GetChatMessagesAround(anchor, before, after)
+	if err != nil {
+		log.Printf("주변 메시지 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "메시지 조회에 실패했습니다", err)
+	}
+
+	hasBefore := len(beforeMessages) > before
+	if hasBefore {
+		beforeMessages = beforeMessages[1:]
+	}
+	hasAfter := len(afterMessages) > after
+	if hasAfter {
+		afterMessages = afterMessages[:after]
+	}
+
+	readCursors, err := uc.chatRepository.GetChatRoomReadCursors(chatRoomID)
+	if err != nil {
+		log.Printf("주변 메시지 조회 중 읽음 커서 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "메시지 조회에 실패했습니다", err)
+	}
+
+	chatMessages := append(append(beforeMessages, anchor), afterMessages...)
+	messagesResponse := make([]*res.ChatMessagesResponse, len(chatMessages))
+	for i, message := range chatMessages {
+		messagesResponse[i] = toChatMessageResponse(message, userId)
+		messagesResponse[i].ReadCount = countReadBy(message, readCursors)
+	}
+
+	return &res.ChatMessageContextResponse{
+		TargetMessageID: chatMessage.ID,
+		AnchorMessageID: anchor.ID,
+		Messages:        messagesResponse,
+		HasBefore:       hasBefore,
+		HasAfter:        hasAfter,
+	}, nil
+}
+
+// 검색어 일치 부분을 <mark>로 감싼 미리보기 - 내용은 HTML 이스케이프
+func highlightChatSnippet(content string, terms []string) string {
+	contentRunes := []rune(content)
+	lowerRunes := make([]rune, len(contentRunes))
+	for i, r := range contentRunes {
+		lowerRunes[i] = unicode.ToLower(r)
+	}
+
+	marked := make([]bool, len(contentRunes))
+	firstMatch := -1
+	for _, term := range terms {
+		termRunes := []rune(strings.ToLower(term))
+		if len(termRunes) == 0 {
+			continue
+		}
+		for i := 0; i+len(termRunes) <= len(lowerRunes); i++ {
+			if string(lowerRunes[i:i+len(termRunes)]) != string(termRunes) {
+				continue
+			}
+			for j := i; j < i+len(termRunes); j++ {
+				marked[j] = true
+			}
+			if firstMatch == -1 || i < firstMatch {
+				firstMatch = i
+			}
+		}
+	}
+
+	start := 0
+	if firstMatch > chatSnippetBefore {
+		start = firstMatch - chatSnippetBefore
+	}
+	end := min(start+chatSnippetLength, len(contentRunes))
+
+	var snippet strings.Builder
+	if start > 0 {
+		snippet.WriteString("…")
+	}
+	for i := start; i < end; {
+		j := i
+		for j < end && marked[j] == marked[i] {
+			j++
+		}
+		text := html.EscapeString(string(contentRunes[i:j]))
+		if marked[i] {
+			snippet.WriteString("<mark>" + text + "</mark>")
+		} else {
+			snippet.WriteString(text)
+		}
+		i = j
+	}
+	if end < len(contentRunes) {
+		snippet.WriteString("…")
+	}
+
+	return snippet.String()
+}
+
+// TODO 메시지를 읽은 참여자 수 (보낸 사람 제외)
+func countReadBy(chatMessage *entity.Chat, readCursors []*entity.ChatReadCursor) int {
+	readCount := 0
+	for _, cursor := range readCursors {
+		if cursor.UserID == chatMessage.SenderID || cursor.LastReadAt == nil {
+			continue
+		}
+		if !cursor.LastReadAt.Before(chatMessage.CreatedAt) {
+			readCount++
+		}
+	}
+	return readCount
+}
+
+// TODO 채팅방 읽음 처리 - 읽음 커서 이동 후 chat.read 이벤트
+func (uc *chatUsecase) ReadChatRoom(userId uint, chatRoomID uint, request *req.ReadChatRoomRequest) (*res.ChatReadResponse, error) {
+	var chatMessage *entity.Chat
+	var err error
+	if request.ChatMessageID != "" {
+		chatMessage, err = uc.getChatRoomMessage(userId, chatRoomID, request.ChatMessageID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if !uc.chatRepository.IsUserInChatRoom(userId, chatRoomID) {
+			return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+		}
+		chatMessage, err = uc.chatRepository.GetLatestChatMessage(chatRoomID)
+		if err != nil {
+			log.Printf("채팅방 읽음 처리 중 최신 메시지 조회 오류: %v", err)
+			return nil, common.NewError(http.StatusInternalServerError, "채팅방 읽음 처리에 실패했습니다", err)
+		}
+		if chatMessage == nil {
+			return nil, common.NewError(http.StatusNotFound, "읽을 메시지가 없습니다", nil)
+		}
+	}
+
+	updated, err := uc.chatRepository.UpdateReadCursor(userId, chatRoomID, chatMessage.ID, chatMessage.CreatedAt)
+	if err != nil {
+		log.Printf("채팅방 읽음 처리 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 읽음 처리에 실패했습니다", err)
+	}
+
+	cursor, err := uc.chatRepository.GetChatRoomReadCursor(userId, chatRoomID)
+	if err != nil {
+		log.Printf("채팅방 읽음 처리 중 읽음 커서 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 읽음 처리에 실패했습니다", err)
+	}
+
+	response := &res.ChatReadResponse{
+		ChatRoomID:        chatRoomID,
+		UserID:            userId,
+		LastReadMessageID: cursor.LastReadMessageID,
+	}
+	if cursor.LastReadAt != nil {
+		response.LastReadAt = cursor.LastReadAt.Format(time.RFC3339Nano)
+	}
+
+	//TODO 커서가 앞으로 이동한 경우만 채팅방에 읽음 이벤트 발행
+	if updated {
+		readData, err := json.Marshal(map[string]interface{}{
+			"roomId": chatRoomID,
+			"read":   response,
+		})
+		if err != nil {
+			log.Printf("읽음 이벤트 직렬화 오류: %v", err)
+			return response, nil
+		}
+		if err := uc.natsPublisher.PublishEvent("chat.room.read", readData); err != nil {
+			log.Printf("읽음 이벤트 발행 오류: %v", err)
+		}
+	}
+
+	return response, nil
+}
+
+// TODO 이모지별 반응 집계 - userId가 누른 이모지는 is_clicked
+func toChatReactionResponses(reactions []*entity.ChatReaction, userId uint) []*res.ChatReactionResponse {
+	result := make([]*res.ChatReactionResponse, 0)
+	byEmoji := make(map[uint]*res.ChatReactionResponse)
+	for _, reaction := range reactions {
+		aggregated, ok := byEmoji[reaction.EmojiID]
+		if !ok {
+			aggregated = &res.ChatReactionResponse{
+				EmojiID: reaction.EmojiID,
+				Unified: reaction.Unified,
+				Content: reaction.Content,
+			}
+			byEmoji[reaction.EmojiID] = aggregated
+			result = append(result, aggregated)
+		}
+		aggregated.Count++
+		if reaction.UserID == userId {
+			aggregated.IsClicked = true
+		}
+	}
+	return result
+}
+
+// TODO 채팅 메시지 이모지 반응 - 채팅방 참여자만
+func (uc *chatUsecase) AddChatMessageReaction(userId uint, chatRoomID uint, chatMessageID string, request *req.ChatReactionRequest) ([]*res.ChatReactionResponse, error) {
+	chatMessage, err := uc.getChatRoomMessage(userId, chatRoomID, chatMessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	emoji, err := uc.likeRepository.GetOrCreateEmoji(request.Unified, request.Content)
+	if err != nil {
+		log.Printf("이모지 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "이모지 반응에 실패했습니다", err)
+	}
+
+	for _, reaction := range chatMessage.Reactions {
+		if reaction.UserID == userId && reaction.EmojiID == emoji.EmojiID {
+			return nil, common.NewError(http.StatusConflict, "이미 동일한 이모지 반응이 존재합니다", nil)
+		}
+	}
+
+	reactions, err := uc.chatRepository.AddChatMessageReaction(chatMessageID, &entity.ChatReaction{
+		EmojiID:   emoji.EmojiID,
+		Unified:   emoji.Unified,
+		Content:   emoji.Content,
+		UserID:    userId,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		log.Printf("채팅 메시지 반응 추가 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "이모지 반응에 실패했습니다", err)
+	}
+
+	uc.publishReactionEvent(&res.ChatReactionEventPayload{
+		ChatRoomID:    chatRoomID,
+		ChatMessageID: chatMessageID,
+		Action:        "add",
+		UserID:        userId,
+		EmojiID:       emoji.EmojiID,
+		Unified:       emoji.Unified,
+		Content:       emoji.Content,
+		Reactions:     toChatReactionResponses(reactions, 0),
+	})
+
+	return toChatReactionResponses(reactions, userId), nil
+}
+
+// TODO 채팅 메시지 이모지 반응 취소
+func (uc *chatUsecase) RemoveChatMessageReaction(userId uint, chatRoomID uint, chatMessageID string, emojiId uint) ([]*res.ChatReactionResponse, error) {
+	chatMessage, err := uc.getChatRoomMessage(userId, chatRoomID, chatMessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	var removed *entity.ChatReaction
+	for _, reaction := range chatMessage.Reactions {
+		if reaction.UserID == userId && reaction.EmojiID == emojiId {
+			removed = reaction
+			break
+		}
+	}
+	if removed == nil {
+		return nil, common.NewError(http.StatusNotFound, "해당 이모지 반응을 누른 적이 없습니다", nil)
+	}
+
+	reactions, err := uc.chatRepository.RemoveChatMessageReaction(chatMessageID, userId, emojiId)
+	if err != nil {
+		log.Printf("채팅 메시지 반응 취소 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "이모지 반응 취소에 실패했습니다", err)
+	}
+
+	uc.publishReactionEvent(&res.ChatReactionEventPayload{
+		ChatRoomID:    chatRoomID,
+		ChatMessageID: chatMessageID,
+		Action:        "remove",
+		UserID:        userId,
+		EmojiID:       removed.EmojiID,
+		Unified:       removed.Unified,
+		Content:       removed.Content,
+		Reactions:     toChatReactionResponses(reactions, 0),
+	})
+
+	return toChatReactionResponses(reactions, userId), nil
+}
+
+// TODO 채팅방 참여자 확인 후 해당 채팅방의 메시지 조회
+func (uc *chatUsecase) getChatRoomMessage(userId uint, chatRoomID uint, chatMessageID string) (*entity.Chat, error) {
+	if !uc.chatRepository.IsUserInChatRoom(userId, chatRoomID) {
+		log.Printf("채팅 메시지 조회 중 사용자 조회 오류: 채팅방 %d에 사용자 %d 없음", chatRoomID, userId)
+		return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+	}
+
+	chatMessage, err := uc.chatRepository.GetChatMessageById(chatMessageID)
+	if err != nil {
+		log.Printf("채팅 메시지 조회 중 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 채팅 메시지입니다", err)
+	}
+	if chatMessage.ChatRoomID != chatRoomID {
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 채팅 메시지입니다", nil)
+	}
+
+	return chatMessage, nil
+}
+
+// TODO 이모지 반응 이벤트 발행 -> 웹소켓 chat.message.reaction
+func (uc *chatUsecase) publishReactionEvent(payload *res.ChatReactionEventPayload) {
+	reactionData, err := json.Marshal(map[string]interface{}{
+		"roomId":   payload.ChatRoomID,
+		"reaction": payload,
+	})
+	if err != nil {
+		log.Printf("이모지 반응 이벤트 직렬화 오류: %v", err)
+		return
+	}
+	if err := uc.natsPublisher.PublishEvent("chat.message.reaction", reactionData); err != nil {
+		log.Printf("이모지 반응 이벤트 발행 오류: %v", err)
+	}
+}
+
+// 채팅방당 고정할 수 있는 메시지 수
+const maxPinnedChatMessages = 10
+
+// TODO 채팅방 고정 메시지 목록 - 채팅방 참여자만
+func (uc *chatUsecase) GetPinnedChatMessages(userId uint, chatRoomID uint) ([]*res.ChatMessagesResponse, error) {
+	if !uc.chatRepository.IsUserInChatRoom(userId, chatRoomID) {
+		log.Printf("고정 메시지 조회 중 사용자 조회 오류: 채팅방 %d에 사용자 %d 없음", chatRoomID, userId)
+		return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+	}
+
+	pinnedMessages, err := uc.chatRepository.GetPinnedChatMessages(chatRoomID)
+	if err != nil {
+		log.Printf("고정 메시지 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "고정 메시지 조회에 실패했습니다", err)
+	}
+
+	response := make([]*res.ChatMessagesResponse, len(pinnedMessages))
+	for i, pinnedMessage := range pinnedMessages {
+		response[i] = toChatMessageResponse(pinnedMessage, userId)
+	}
+	return response, nil
+}
+
+// TODO 채팅 메시지 고정 - 채팅방 참여자 누구나, 최대 maxPinnedChatMessages개
+func (uc *chatUsecase) PinChatMessage(userId uint, chatRoomID uint, chatMessageID string) (*res.ChatPinPayload, error) {
+	chatMessage, err := uc.getChatRoomMessage(userId, chatRoomID, chatMessageID)
+	if err != nil {
+		return nil, err
+	}
+	if chatMessage.MessageType == entity.ChatMessageTypeSystem {
+		return nil, common.NewError(http.StatusBadRequest, "시스템 메시지는 고정할 수 없습니다", nil)
+	}
+	if chatMessage.PinnedAt != nil {
+		return nil, common.NewError(http.StatusConflict, "이미 고정된 메시지입니다", nil)
+	}
+
+	user, err := uc.userRepository.GetUserByID(userId)
+	if err != nil {
+		log.Printf("메시지 고정 중 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 사용자입니다", err)
+	}
+
+	//TODO 고정 개수 확인과 고정은 저장소에서 채팅방 단위로 함께 처리
+	pinnedAt := time.Now()
+	if err := uc.chatRepository.PinChatMessage(chatRoomID, chatMessageID, userId, pinnedAt, maxPinnedChatMessages); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrChatPinLimitExceeded):
+			return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("메시지는 최대 %d개까지 고정할 수 있습니다", maxPinnedChatMessages), nil)
+		case errors.Is(err, entity.ErrChatMessageAlreadyPinned):
+			return nil, common.NewError(http.StatusConflict, "이미 고정된 메시지입니다", nil)
+		}
+		log.Printf("메시지 고정 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "메시지 고정에 실패했습니다", err)
+	}
+	chatMessage.PinnedAt = &pinnedAt
+	chatMessage.PinnedBy = userId
+
+	payload := &res.ChatPinPayload{
+		ChatRoomID:    chatRoomID,
+		ChatMessageID: chatMessageID,
+		UserID:        userId,
+		UserName:      *user.Name,
+		PinnedAt:      _util.ParseKst(pinnedAt).Format(time.DateTime),
+		Message:       toChatMessageResponse(chatMessage, 0),
+	}
+	uc.publishPinEvent("chat.message.pinned", payload)
+
+	return payload, nil
+}
+
+// TODO 채팅 메시지 고정 해제 - 채팅방 참여자 누구나
+func (uc *chatUsecase) UnpinChatMessage(userId uint, chatRoomID uint, chatMessageID string) (*res.ChatPinPayload, error) {
+	chatMessage, err := uc.getChatRoomMessage(userId, chatRoomID, chatMessageID)
+	if err != nil {
+		return nil, err
+	}
+	if chatMessage.PinnedAt == nil {
+		return nil, common.NewError(http.StatusNotFound, "고정되지 않은 메시지입니다", nil)
+	}
+
+	user, err := uc.userRepository.GetUserByID(userId)
+	if err != nil {
+		log.Printf("메시지 고정 해제 중 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 사용자입니다", err)
+	}
+
+	if err := uc.chatRepository.UnpinChatMessage(chatMessageID); err != nil {
+		log.Printf("메시지 고정 해제 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "메시지 고정 해제에 실패했습니다", err)
+	}
+
+	payload := &res.ChatPinPayload{
+		ChatRoomID:    chatRoomID,
+		ChatMessageID: chatMessageID,
+		UserID:        userId,
+		UserName:      *user.Name,
+	}
+	uc.publishPinEvent("chat.message.unpinned", payload)
+
+	return payload, nil
+}
+
+// TODO 고정/해제 이벤트 발행 -> 웹소켓 chat.message.pinned, chat.message.unpinned
+func (uc *chatUsecase) publishPinEvent(subject string, payload *res.ChatPinPayload) {
+	pinData, err := json.Marshal(map[string]interface{}{
+		"roomId": payload.ChatRoomID,
+		"pin":    payload,
+	})
+	if err != nil {
+		log.Printf("메시지 고정 이벤트 직렬화 오류: %v", err)
+		return
+	}
+	if err := uc.natsPublisher.PublishEvent(subject, pinData); err != nil {
+		log.Printf("메시지 고정 이벤트 발행 오류: %v", err)
+	}
+}
+
+// TODO 채팅 메시지 북마크 - 본인만 보는 저장 항목
+func (uc *chatUsecase) BookmarkChatMessage(userId uint, chatRoomID uint, chatMessageID string) error {
+	chatMessage, err := uc.getChatRoomMessage(userId, chatRoomID, chatMessageID)
+	if err != nil {
+		return err
+	}
+	if chatMessage.MessageType == entity.ChatMessageTypeSystem {
+		return common.NewError(http.StatusBadRequest, "시스템 메시지는 북마크할 수 없습니다", nil)
+	}
+
+	if err := uc.chatRepository.CreateChatBookmark(userId, chatRoomID, chatMessageID); err != nil {
+		log.Printf("북마크 저장 중 DB 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "북마크 저장에 실패했습니다", err)
+	}
+	return nil
+}
+
+// TODO 북마크 해제 - 메시지가 삭제됐거나 채팅방을 나갔어도 해제 가능
+func (uc *chatUsecase) RemoveChatMessageBookmark(userId uint, chatMessageID string) error {
+	if err := uc.chatRepository.DeleteChatBookmark(userId, chatMessageID); err != nil {
+		log.Printf("북마크 해제 중 DB 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "북마크 해제에 실패했습니다", err)
+	}
+	return nil
+}
+
+// TODO 저장 항목 - 북마크한 메시지와 채팅방 정보 (최근에 저장한 순)
+func (uc *chatUsecase) GetChatBookmarks(userId uint, queryParams *req.GetChatBookmarksQueryParams) (*res.GetChatBookmarksResponse, error) {
+	queryOptions := map[string]interface{}{
+		"page":  queryParams.Page,
+		"limit": queryParams.Limit,
+	}
+
+	bookmarkMeta, bookmarks, err := uc.chatRepository.GetChatBookmarks(userId, queryOptions)
+	if err != nil {
+		log.Printf("북마크 목록 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "북마크 목록 조회에 실패했습니다", err)
+	}
+
+	bookmarksResponse := make([]*res.ChatBookmarkResponse, len(bookmarks))
+	for i, bookmark := range bookmarks {
+		bookmarksResponse[i] = &res.ChatBookmarkResponse{
+			ID:            bookmark.ID,
+			ChatRoomID:    bookmark.ChatRoomID,
+			ChatRoomName:  bookmark.ChatRoomName,
+			ChatRoomImage: bookmark.ChatRoomImage,
+			IsPrivate:     bookmark.IsPrivate,
+			ChatMessageID: bookmark.ChatMessageID,
+			BookmarkedAt:  _util.ParseKst(bookmark.CreatedAt).Format(time.DateTime),
+		}
+		if bookmark.Message != nil {
+			bookmarksResponse[i].Message = toChatMessageResponse(bookmark.Message, userId)
+			bookmarksResponse[i].Message.IsBookmarked = true
+		}
+	}
+
+	return &res.GetChatBookmarksResponse{
+		Bookmarks: bookmarksResponse,
+		Meta: &res.ChatMeta{
+			TotalCount: bookmarkMeta.TotalCount,
+			TotalPages: bookmarkMeta.TotalPages,
+			PageSize:   bookmarkMeta.PageSize,
+			HasMore:    bookmarkMeta.HasMore,
+			PrevPage:   bookmarkMeta.PrevPage,
+			NextPage:   bookmarkMeta.NextPage,
+		},
+	}, nil
+}
+
 // TODO 채팅 메시지 삭제
 func (uc *chatUsecase) DeleteChatMessage(senderID uint, request *req.DeleteChatMessageRequest) error {
 
@@ -487,6 +2014,114 @@ func (uc *chatUsecase) DeleteChatMessage(senderID uint, request *req.DeleteChatM
 	return nil
 }
 
+// TODO 채팅 메시지 수정 - 작성자만, 수정 가능 시간 내에서만
+func (uc *chatUsecase) UpdateChatMessage(senderID uint, request *req.UpdateChatMessageRequest) (*res.ChatMessagesResponse, error) {
+	content := strings.TrimSpace(request.Content)
+	if content == "" {
+		return nil, common.NewError(http.StatusBadRequest, "메시지 내용을 입력해주세요", nil)
+	}
+
+	if !uc.chatRepository.IsUserInChatRoom(senderID, request.ChatRoomID) {
+		log.Printf("채팅 메시지 수정 중 사용자 조회 오류: 채팅방 %d에 사용자 %d 없음", request.ChatRoomID, senderID)
+		return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+	}
+
+	chatMessage, err := uc.chatRepository.GetChatMessageById(request.ChatMessageID)
+	if err != nil {
+		log.Printf("채팅 메시지 수정 중 메시지 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 채팅 메시지입니다", err)
+	}
+
+	if chatMessage.ChatRoomID != request.ChatRoomID {
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 채팅 메시지입니다", nil)
+	}
+
+	if chatMessage.SenderID != senderID {
+		return nil, common.NewError(http.StatusForbidden, "본인이 보낸 메시지만 수정할 수 있습니다", nil)
+	}
+
+	if time.Since(chatMessage.CreatedAt) > chatMessageEditWindow {
+		return nil, common.NewError(http.StatusForbidden, fmt.Sprintf("메시지는 보낸 후 %d분 이내에만 수정할 수 있습니다", int(chatMessageEditWindow.Minutes())), nil)
+	}
+
+	if chatMessage.Content == content {
+		return nil, common.NewError(http.StatusBadRequest, "변경된 내용이 없습니다", nil)
+	}
+
+	editedAt := time.Now()
+	if err := uc.chatRepository.UpdateChatMessage(chatMessage.ID, chatMessage.Content, content, editedAt); err != nil {
+		log.Printf("채팅 메시지 수정 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅 메시지 수정에 실패했습니다", err)
+	}
+
+	response := &res.ChatMessagesResponse{
+		ChatMessageID: chatMessage.ID,
+		Content:       content,
+		SenderID:      chatMessage.SenderID,
+		SenderName:    chatMessage.SenderName,
+		SenderImage:   _util.SignProfileImageURL(chatMessage.SenderImage),
+		ChatRoomID:    chatMessage.ChatRoomID,
+		CreatedAt:     _util.ParseKst(chatMessage.CreatedAt).Format(time.DateTime),
+		IsEdited:      true,
+		EditedAt:      _util.ParseKst(editedAt).Format(time.DateTime),
+	}
+
+	//TODO 채팅방에 수정 이벤트 발행 -> 웹소켓 chat.message.edited
+	editedData, err := json.Marshal(map[string]interface{}{
+		"roomId":  response.ChatRoomID,
+		"message": response,
+	})
+	if err != nil {
+		log.Printf("채팅 메시지 수정 이벤트 직렬화 오류: %v", err)
+		return response, nil
+	}
+	if err := uc.natsPublisher.PublishEvent("chat.message.edited", editedData); err != nil {
+		log.Printf("채팅 메시지 수정 이벤트 발행 오류: %v", err)
+	}
+
+	return response, nil
+}
+
+// TODO 채팅 메시지 수정 이력 조회 - 채팅방 참여자만
+func (uc *chatUsecase) GetChatMessageHistory(userId uint, chatRoomID uint, chatMessageID string) (*res.ChatMessageHistoryResponse, error) {
+	if !uc.chatRepository.IsUserInChatRoom(userId, chatRoomID) {
+		log.Printf("채팅 메시지 수정 이력 조회 중 사용자 조회 오류: 채팅방 %d에 사용자 %d 없음", chatRoomID, userId)
+		return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+	}
+
+	chatMessage, err := uc.chatRepository.GetChatMessageById(chatMessageID)
+	if err != nil {
+		log.Printf("채팅 메시지 수정 이력 조회 중 메시지 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 채팅 메시지입니다", err)
+	}
+
+	if chatMessage.ChatRoomID != chatRoomID {
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 채팅 메시지입니다", nil)
+	}
+
+	history := make([]*res.ChatEditHistoryResponse, len(chatMessage.EditHistory))
+	for i, h := range chatMessage.EditHistory {
+		history[i] = &res.ChatEditHistoryResponse{
+			Content:  h.Content,
+			EditedAt: _util.ParseKst(h.EditedAt).Format(time.DateTime),
+		}
+	}
+
+	response := &res.ChatMessageHistoryResponse{
+		ChatMessageID: chatMessage.ID,
+		ChatRoomID:    chatMessage.ChatRoomID,
+		SenderID:      chatMessage.SenderID,
+		Content:       chatMessage.Content,
+		CreatedAt:     _util.ParseKst(chatMessage.CreatedAt).Format(time.DateTime),
+		History:       history,
+	}
+	if chatMessage.EditedAt != nil {
+		response.EditedAt = _util.ParseKst(*chatMessage.EditedAt).Format(time.DateTime)
+	}
+
+	return response, nil
+}
+
 func (uc *chatUsecase) SetChatRoomToRedis(roomId uint, chatRoomInfo map[string]interface{}) error {
 	if roomId == 0 || chatRoomInfo == nil {
 		return common.NewError(http.StatusBadRequest, "채팅방 또는 채팅방 ID가 유효하지 않습니다", nil)
@@ -529,3 +2164,316 @@ func (uc *chatUsecase) GetChatRoomByIdFromRedis(roomId uint) (*res.ChatRoomInfoR
 
 	return chatRoomResponse, nil
 }
+
+const (
+	scheduledChatMinDelay      = time.Minute         // 최소 1분 뒤
+	scheduledChatMaxDelay      = 30 * 24 * time.Hour // 최대 30일 뒤
+	scheduledChatDeliveryBatch = 100                 // 스케줄러 1회 전송 수
+)
+
+// send_at 파싱 - RFC3339(시간대 포함) 또는 KST 기준 "2006-01-02 15:04:05"
+func parseScheduledSendAt(sendAt string) (time.Time, error) {
+	sendAtTime, err := time.Parse(time.RFC3339, sendAt)
+	if err != nil {
+		loc, locErr := time.LoadLocation("Asia/Seoul")
+		if locErr != nil {
+			return time.Time{}, common.NewError(http.StatusInternalServerError, "시간대 로드 실패", locErr)
+		}
+		sendAtTime, err = time.ParseInLocation(time.DateTime, sendAt, loc)
+		if err != nil {
+			return time.Time{}, common.NewError(http.StatusBadRequest, "예약 시각 형식이 올바르지 않습니다", err)
+		}
+	}
+
+	now := time.Now()
+	if sendAtTime.Before(now.Add(scheduledChatMinDelay)) {
+		return time.Time{}, common.NewError(http.StatusBadRequest, "예약 시각은 1분 이후여야 합니다", nil)
+	}
+	if sendAtTime.After(now.Add(scheduledChatMaxDelay)) {
+		return time.Time{}, common.NewError(http.StatusBadRequest, "예약 시각은 30일 이내여야 합니다", nil)
+	}
+	return sendAtTime, nil
+}
+
+func toScheduledChatMessageResponse(scheduledMessage *entity.ScheduledChatMessage) *res.ScheduledChatMessageResponse {
+	return &res.ScheduledChatMessageResponse{
+		ID:              scheduledMessage.ID,
+		ChatRoomID:      scheduledMessage.ChatRoomID,
+		Content:         scheduledMessage.Content,
+		ParentMessageID: scheduledMessage.ParentMessageID,
+		ShowInRoom:      scheduledMessage.ShowInRoom,
+		SendAt:          _util.ParseKst(scheduledMessage.SendAt).Format(time.DateTime),
+		Status:          scheduledMessage.Status,
+		CreatedAt:       _util.ParseKst(scheduledMessage.CreatedAt).Format(time.DateTime),
+		UpdatedAt:       _util.ParseKst(scheduledMessage.UpdatedAt).Format(time.DateTime),
+	}
+}
+
+// TODO 예약 메시지 등록 - 채팅방 참여자만, 스레드 답글도 가능
+func (uc *chatUsecase) ScheduleChatMessage(userId uint, chatRoomID uint, request *req.ScheduleChatMessageRequest) (*res.ScheduledChatMessageResponse, error) {
+	if !uc.chatRepository.IsUserInChatRoom(userId, chatRoomID) {
+		log.Printf("예약 메시지 등록 중 사용자 조회 오류: 채팅방 %d에 사용자 %d 없음", chatRoomID, userId)
+		return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+	}
+
+	content := strings.TrimSpace(request.Content)
+	if content == "" {
+		return nil, common.NewError(http.StatusBadRequest, "메시지 내용이 없습니다", nil)
+	}
+
+	sendAt, err := parseScheduledSendAt(request.SendAt)
+	if err != nil {
+		return nil, err
+	}
+
+	//TODO 스레드 답글은 같은 채팅방의 원본 메시지에만 - 전송 시 SaveMessage에서 다시 확인
+	if request.ParentMessageID != "" {
+		parentMessage, err := uc.getChatRoomMessage(userId, chatRoomID, request.ParentMessageID)
+		if err != nil {
+			return nil, err
+		}
+		if parentMessage.ParentMessageID != "" {
+			return nil, common.NewError(http.StatusBadRequest, "스레드 답글에는 답글을 달 수 없습니다", nil)
+		}
+	}
+
+	scheduledMessage := &entity.ScheduledChatMessage{
+		SenderID:        userId,
+		ChatRoomID:      chatRoomID,
+		Content:         content,
+		ParentMessageID: request.ParentMessageID,
+		ShowInRoom:      request.ParentMessageID != "" && request.ShowInRoom,
+		SendAt:          sendAt,
+	}
+	if err := uc.chatRepository.CreateScheduledChatMessage(scheduledMessage); err != nil {
+		log.Printf("예약 메시지 등록 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "예약 메시지 등록에 실패했습니다", err)
+	}
+
+	return toScheduledChatMessageResponse(scheduledMessage), nil
+}
+
+// TODO 본인이 예약한 메시지 목록 (대기 중인 것만)
+func (uc *chatUsecase) GetScheduledChatMessages(userId uint, chatRoomID uint) ([]*res.ScheduledChatMessageResponse, error) {
+	if !uc.chatRepository.IsUserInChatRoom(userId, chatRoomID) {
+		log.Printf("예약 메시지 조회 중 사용자 조회 오류: 채팅방 %d에 사용자 %d 없음", chatRoomID, userId)
+		return nil, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil)
+	}
+
+	scheduledMessages, err := uc.chatRepository.GetScheduledChatMessages(userId, chatRoomID)
+	if err != nil {
+		log.Printf("예약 메시지 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "예약 메시지 조회에 실패했습니다", err)
+	}
+
+	response := make([]*res.ScheduledChatMessageResponse, len(scheduledMessages))
+	for i, scheduledMessage := range scheduledMessages {
+		response[i] = toScheduledChatMessageResponse(scheduledMessage)
+	}
+	return response, nil
+}
+
+// TODO 예약 메시지 수정 - 작성자만, 전송 전까지
+func (uc *chatUsecase) UpdateScheduledChatMessage(userId uint, chatRoomID uint, scheduledMessageID uint, request *req.UpdateScheduledChatMessageRequest) (*res.ScheduledChatMessageResponse, error) {
+	if _, err := uc.getOwnScheduledChatMessage(userId, chatRoomID, scheduledMessageID); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if request.Content != nil {
+		content := strings.TrimSpace(*request.Content)
+		if content == "" {
+			return nil, common.NewError(http.StatusBadRequest, "메시지 내용이 없습니다", nil)
+		}
+		updates["content"] = content
+	}
+	if request.SendAt != nil {
+		sendAt, err := parseScheduledSendAt(*request.SendAt)
+		if err != nil {
+			return nil, err
+		}
+		updates["send_at"] = sendAt
+	}
+	if len(updates) == 0 {
+		return nil, common.NewError(http.StatusBadRequest, "수정할 내용이 없습니다", nil)
+	}
+
+	updated, err := uc.chatRepository.UpdatePendingScheduledChatMessage(scheduledMessageID, updates)
+	if err != nil {
+		log.Printf("예약 메시지 수정 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "예약 메시지 수정에 실패했습니다", err)
+	}
+	if !updated {
+		return nil, common.NewError(http.StatusConflict, "이미 전송되었거나 취소된 예약 메시지입니다", nil)
+	}
+
+	scheduledMessage, err := uc.chatRepository.GetScheduledChatMessageByID(scheduledMessageID)
+	if err != nil || scheduledMessage == nil {
+		log.Printf("예약 메시지 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "예약 메시지 수정에 실패했습니다", err)
+	}
+	return toScheduledChatMessageResponse(scheduledMessage), nil
+}
+
+// TODO 예약 메시지 취소 - 작성자만, 전송 전까지
+func (uc *chatUsecase) CancelScheduledChatMessage(userId uint, chatRoomID uint, scheduledMessageID uint) error {
+	if _, err := uc.getOwnScheduledChatMessage(userId, chatRoomID, scheduledMessageID); err != nil {
+		return err
+	}
+
+	canceled, err := uc.chatRepository.UpdatePendingScheduledChatMessage(scheduledMessageID, map[string]interface{}{
+		"status": entity.ScheduledChatStatusCanceled,
+	})
+	if err != nil {
+		log.Printf("예약 메시지 취소 중 DB 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "예약 메시지 취소에 실패했습니다", err)
+	}
+	if !canceled {
+		return common.NewError(http.StatusConflict, "이미 전송되었거나 취소된 예약 메시지입니다", nil)
+	}
+	return nil
+}
+
+// TODO 본인이 예약한 해당 채팅방의 메시지인지 확인
+func (uc *chatUsecase) getOwnScheduledChatMessage(userId uint, chatRoomID uint, scheduledMessageID uint) (*entity.ScheduledChatMessage, error) {
+	scheduledMessage, err := uc.chatRepository.GetScheduledChatMessageByID(scheduledMessageID)
+	if err != nil {
+		log.Printf("예약 메시지 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "예약 메시지 조회에 실패했습니다", err)
+	}
+	if scheduledMessage == nil || scheduledMessage.SenderID != userId || scheduledMessage.ChatRoomID != chatRoomID {
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 예약 메시지입니다", nil)
+	}
+	return scheduledMessage, nil
+}
+
+// TODO 예약 메시지 전송 (스케줄러) - 일반 전송과 같은 SaveMessage + NATS 경로, 채팅방 브로드캐스트는 chat.message.sent
+func (uc *chatUsecase) DeliverScheduledChatMessages() error {
+	scheduledMessages, err := uc.chatRepository.ClaimDueScheduledChatMessages(time.Now(), scheduledChatDeliveryBatch)
+	if err != nil {
+		return err
+	}
+
+	for _, scheduledMessage := range scheduledMessages {
+		uc.deliverScheduledChatMessage(scheduledMessage)
+	}
+	return nil
+}
+
+// TODO 요금제 채팅 보관 기간이 지난 회사 팀 채팅방 메시지 삭제 - 보관 기간 0은 무제한
+func (uc *chatUsecase) PurgeExpiredChatMessages() error {
+	companies, err := uc.companyRepository.GetAllCompanies()
+	if err != nil {
+		return err
+	}
+
+	var failed []uint
+	for _, company := range companies {
+		plan := _companyEntity.GetCompanyPlan(company.Grade)
+		if plan.ChatRetentionDays <= 0 {
+			continue
+		}
+
+		chatRoomIds, err := uc.chatRepository.GetCompanyChatRoomIDs(company.ID)
+		if err != nil {
+			log.Printf("회사 팀 채팅방 조회 오류: 회사 ID %d, %v", company.ID, err)
+			failed = append(failed, company.ID)
+			continue
+		}
+
+		before := time.Now().AddDate(0, 0, -plan.ChatRetentionDays)
+		attachments, err := uc.chatRepository.DeleteChatMessagesBefore(chatRoomIds, before)
+		if err != nil {
+			log.Printf("보관 기간이 지난 채팅 메시지 삭제 오류: 회사 ID %d, %v", company.ID, err)
+			failed = append(failed, company.ID)
+			continue
+		}
+
+		uc.removeChatAttachmentFiles(company.ID, attachments)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("채팅 메시지 보관 기간 정리 실패: %v", failed)
+	}
+	return nil
+}
+
+// removeChatAttachmentFiles 메시지가 지워진 첨부파일 삭제 후 회사 업로드 용량 반영 - 실패해도 로그만 남김
+func (uc *chatUsecase) removeChatAttachmentFiles(companyId uint, attachments []*entity.ChatAttachment) {
+	var removedBytes int64
+	for _, attachment := range attachments {
+		size, err := _util.RemoveStaticFile(attachment.URL)
+		if err != nil {
+			log.Printf("채팅 첨부파일 삭제 오류: %s, %v", attachment.URL, err)
+			continue
+		}
+		removedBytes += size
+
+		if attachment.ThumbnailURL != "" {
+			if _, err := _util.RemoveStaticFile(attachment.ThumbnailURL); err != nil {
+				log.Printf("채팅 첨부파일 썸네일 삭제 오류: %s, %v", attachment.ThumbnailURL, err)
+			}
+		}
+	}
+
+	if removedBytes == 0 {
+		return
+	}
+	if err := uc.companyRepository.DecreaseCompanyStorageUsage(companyId, removedBytes); err != nil {
+		log.Printf("회사 업로드 용량 갱신 오류: 회사 ID %d, %v", companyId, err)
+	}
+}
+
+func (uc *chatUsecase) deliverScheduledChatMessage(scheduledMessage *entity.ScheduledChatMessage) {
+	complete := func(status string, chatMessageID string, failReason string) {
+		if err := uc.chatRepository.CompleteScheduledChatMessage(scheduledMessage.ID, status, chatMessageID, failReason); err != nil {
+			log.Printf("예약 메시지 %d 전송 결과 기록 오류: %v", scheduledMessage.ID, err)
+		}
+	}
+
+	//TODO 예약 후 채팅방을 나갔으면 전송하지 않음
+	if !uc.chatRepository.IsUserInChatRoom(scheduledMessage.SenderID, scheduledMessage.ChatRoomID) {
+		complete(entity.ScheduledChatStatusFailed, "", "채팅방에 참여 중이 아닙니다")
+		return
+	}
+
+	// 재시도해도 한 번만 저장되도록 예약 ID로 client_msg_id 고정
+	clientMsgID := fmt.Sprintf("scheduled-%d", scheduledMessage.ID)
+	chat, err := uc.SaveMessage(scheduledMessage.SenderID, scheduledMessage.ChatRoomID, scheduledMessage.Content,
+		scheduledMessage.ParentMessageID, scheduledMessage.ShowInRoom, clientMsgID, nil)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			// 이전 시도에서 이미 저장됨
+			if appError.StatusCode == http.StatusConflict && chat != nil {
+				complete(entity.ScheduledChatStatusSent, chat.ID, "")
+				return
+			}
+			complete(entity.ScheduledChatStatusFailed, "", appError.Message)
+			return
+		}
+		complete(entity.ScheduledChatStatusFailed, "", err.Error())
+		return
+	}
+
+	complete(entity.ScheduledChatStatusSent, chat.ID, "")
+
+	//TODO 스레드에만 보낸 답글은 SaveMessage에서 chat.thread.reply로 전달
+	if chat.ParentMessageID != "" && !chat.ShowInRoom {
+		return
+	}
+
+	sentData, err := json.Marshal(struct {
+		RoomID uint `json:"roomId"`
+		*res.ChatPayload
+	}{
+		RoomID:      chat.ChatRoomID,
+		ChatPayload: toChatPayload(chat),
+	})
+	if err != nil {
+		log.Printf("예약 메시지 이벤트 직렬화 오류: %v", err)
+		return
+	}
+	if err := uc.natsPublisher.PublishEvent("chat.message.sent", sentData); err != nil {
+		log.Printf("예약 메시지 이벤트 발행 오류: %v", err)
+	}
+}
@@ -317,7 +317,7 @@ func (u *commentUsecase) GetComments(userId uint, queryParams req.GetCommentQuer
 		var profileImage string
 		if !*comment.IsAnonymous {
 			userName = comment.UserName
-			profileImage = comment.ProfileImage
+			profileImage = _util.SignProfileImageURL(comment.ProfileImage)
 		}
 
 		commentRes[i] = &res.CommentResponse{
@@ -441,7 +441,7 @@ func (u *commentUsecase) GetReplies(userId uint, queryParams req.GetReplyQueryPa
 		var profileImage string
 		if !*reply.IsAnonymous {
 			userName = reply.UserName
-			profileImage = reply.ProfileImage
+			profileImage = _util.SignProfileImageURL(reply.ProfileImage)
 		}
 
 		parentId := uint(0)
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// TODO 이메일 도메인 가입 정책
+const (
+	EmailDomainJoinSuggest = "SUGGEST"   // 가입 제안만 하고 회사 관리자의 초대로 가입
+	EmailDomainJoinAuto    = "AUTO_JOIN" // 이메일 확인 시 바로 회사에 소속 (직접 가입도 가능)
+)
+
+// 도메인 DNS에 등록할 TXT 레코드 접두어
+const EmailDomainTxtPrefix = "link-domain-verification="
+
+// 누구나 가입할 수 있는 메일 서비스 도메인은 회사가 등록할 수 없음
+var publicEmailDomains = map[string]bool{
+	"gmail.com":   true,
+	"naver.com":   true,
+	"daum.net":    true,
+	"hanmail.net": true,
+	"kakao.com":   true,
+	"nate.com":    true,
+	"outlook.com": true,
+	"hotmail.com": true,
+	"yahoo.com":   true,
+	"icloud.com":  true,
+}
+
+type CompanyEmailDomain struct {
+	ID                uint       `json:"id"`
+	CompanyID         uint       `json:"company_id"`
+	CompanyName       string     `json:"company_name,omitempty"`
+	Domain            string     `json:"domain"`
+	VerificationToken string     `json:"-"`
+	JoinPolicy        string     `json:"join_policy"`
+	VerifiedAt        *time.Time `json:"verified_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+// TxtRecord 도메인 소유 확인용 TXT 레코드 값
+func (d *CompanyEmailDomain) TxtRecord() string {
+	return EmailDomainTxtPrefix + d.VerificationToken
+}
+
+// EmailDomainOf 이메일에서 소문자 도메인 추출, 형식이 잘못되면 빈 문자열
+func EmailDomainOf(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 || at == len(email)-1 {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(email[at+1:]))
+}
+
+func IsPublicEmailDomain(domain string) bool {
+	return publicEmailDomains[domain]
+}
@@ -18,4 +18,7 @@ type Company struct {
 	Teams                     []*map[string]interface{} `json:"teams,omitempty"`
 	CreatedAt                 time.Time                 `json:"created_at,omitempty"`
 	UpdatedAt                 time.Time                 `json:"updated_at,omitempty"`
+
+	DeletionRequestedAt *time.Time `json:"deletion_requested_at,omitempty"`
+	PurgeScheduledAt    *time.Time `json:"purge_scheduled_at,omitempty"` // 값이 있으면 삭제 예정(읽기 전용)
 }
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	InviteTargetCompany    = "COMPANY"
+	InviteTargetDepartment = "DEPARTMENT"
+	InviteTargetProject    = "PROJECT"
+)
+
+// TODO 초대 링크 상태 - 저장하지 않고 조회 시점에 계산
+const (
+	InviteLinkActive    = "ACTIVE"
+	InviteLinkExpired   = "EXPIRED"
+	InviteLinkExhausted = "EXHAUSTED"
+	InviteLinkRevoked   = "REVOKED"
+)
+
+type CompanyInviteLink struct {
+	ID             uint       `json:"id"`
+	Code           string     `json:"code"`
+	CompanyID      uint       `json:"company_id"`
+	CompanyName    string     `json:"company_name,omitempty"`
+	CreatorID      uint       `json:"creator_id"`
+	CreatorName    string     `json:"creator_name,omitempty"`
+	TargetType     string     `json:"target_type"`
+	DepartmentID   *uint      `json:"department_id,omitempty"`
+	DepartmentName string     `json:"department_name,omitempty"`
+	ProjectID      *uint      `json:"project_id,omitempty"`
+	ProjectName    string     `json:"project_name,omitempty"`
+	Role           int        `json:"role"`
+	EmailDomain    string     `json:"email_domain,omitempty"`
+	MaxUses        int        `json:"max_uses"`
+	UseCount       int        `json:"use_count"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	RevokedAt      *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// Status 폐기 > 만료 > 사용 횟수 소진 순으로 판단
+func (l *CompanyInviteLink) Status(now time.Time) string {
+	switch {
+	case l.RevokedAt != nil:
+		return InviteLinkRevoked
+	case l.ExpiresAt != nil && !now.Before(*l.ExpiresAt):
+		return InviteLinkExpired
+	case l.MaxUses > 0 && l.UseCount >= l.MaxUses:
+		return InviteLinkExhausted
+	}
+	return InviteLinkActive
+}
+
+// AllowsEmail 이메일 도메인 제한 확인 - 대소문자 구분 없음
+func (l *CompanyInviteLink) AllowsEmail(email string) bool {
+	if l.EmailDomain == "" {
+		return true
+	}
+	at := strings.LastIndex(email, "@")
+	return at >= 0 && strings.EqualFold(email[at+1:], l.EmailDomain)
+}
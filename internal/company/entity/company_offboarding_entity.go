@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+// CompanyDeletionGracePeriod 회사 삭제 요청 후 영구 삭제까지 유예 기간 (이 기간 동안 읽기 전용, 복구 가능)
+const CompanyDeletionGracePeriod = 30 * 24 * time.Hour
+
+// CompanyExport 회사 전체 데이터 내보내기 - 테이블 행을 그대로 담음 (비밀번호 등 민감 정보 제외)
+type CompanyExport struct {
+	Company      map[string]interface{}
+	Users        []map[string]interface{}
+	Organization CompanyExportOrganization
+	Posts        CompanyExportPosts
+	Boards       CompanyExportBoards
+	Chats        CompanyExportChats
+	ExportedAt   time.Time
+}
+
+type CompanyExportOrganization struct {
+	Departments       []map[string]interface{}
+	DepartmentMembers []map[string]interface{}
+	Positions         []map[string]interface{}
+	Roles             []map[string]interface{}
+	Teams             []map[string]interface{}
+	TeamMembers       []map[string]interface{}
+}
+
+type CompanyExportPosts struct {
+	Posts           []map[string]interface{}
+	PostImages      []map[string]interface{}
+	PostDepartments []map[string]interface{}
+	Comments        []map[string]interface{}
+}
+
+type CompanyExportBoards struct {
+	Projects      []map[string]interface{}
+	ProjectUsers  []map[string]interface{}
+	Boards        []map[string]interface{}
+	BoardColumns  []map[string]interface{}
+	BoardCards    []map[string]interface{}
+	CardAssignees []map[string]interface{}
+}
+
+// CompanyExportChats 회사 소유 채팅방(팀 채팅방)과 메시지
+type CompanyExportChats struct {
+	ChatRooms     []map[string]interface{}
+	ChatRoomUsers []map[string]interface{}
+	Messages      []map[string]interface{}
+}
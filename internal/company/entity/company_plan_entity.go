@@ -0,0 +1,74 @@
+package entity
+
+// TODO 회사 등급 - 인증 전 회사는 Free
+const (
+	CompanyGradeFree = iota
+	CompanyGradeBasic
+	CompanyGradePro
+)
+
+// TODO 등급별 요금제 한도 - 0이면 무제한
+type CompanyPlan struct {
+	Grade               int
+	Name                string
+	MaxMembers          int64
+	MaxProjects         int64
+	MaxBoardsPerProject int64
+	MaxStorageBytes     int64
+	ChatRetentionDays   int // 회사 팀 채팅방 메시지 보관 일수 - PurgeExpiredChatMessages가 매일 정리
+}
+
+type CompanyUsage struct {
+	MemberCount          int64
+	ProjectCount         int64
+	MaxProjectBoardCount int64 // 보드가 가장 많은 프로젝트의 보드 수
+	StorageUsedBytes     int64
+}
+
+const (
+	megaByte = int64(1024 * 1024)
+	gigaByte = 1024 * megaByte
+)
+
+var companyPlans = map[int]CompanyPlan{
+	CompanyGradeFree: {
+		Grade:               CompanyGradeFree,
+		Name:                "Free",
+		MaxMembers:          10,
+		MaxProjects:         3,
+		MaxBoardsPerProject: 3,
+		MaxStorageBytes:     500 * megaByte,
+		ChatRetentionDays:   30,
+	},
+	CompanyGradeBasic: {
+		Grade:               CompanyGradeBasic,
+		Name:                "Basic",
+		MaxMembers:          50,
+		MaxProjects:         20,
+		MaxBoardsPerProject: 10,
+		MaxStorageBytes:     10 * gigaByte,
+		ChatRetentionDays:   365,
+	},
+	CompanyGradePro: {
+		Grade:               CompanyGradePro,
+		Name:                "Pro",
+		MaxMembers:          1000,
+		MaxProjects:         0,
+		MaxBoardsPerProject: 0,
+		MaxStorageBytes:     200 * gigaByte,
+		ChatRetentionDays:   0,
+	},
+}
+
+// GetCompanyPlan 알 수 없는 등급은 Free 요금제로 취급
+func GetCompanyPlan(grade int) CompanyPlan {
+	if plan, ok := companyPlans[grade]; ok {
+		return plan
+	}
+	return companyPlans[CompanyGradeFree]
+}
+
+// IsPlanLimitExceeded 현재 사용량에 추가량을 더했을 때 한도를 넘는지 확인
+func IsPlanLimitExceeded(limit int64, used int64, adding int64) bool {
+	return limit > 0 && used+adding > limit
+}
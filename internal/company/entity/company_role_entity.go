@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// TODO 회사 역할에 부여할 수 있는 권한 - 회사 관리자(Role 3,4)는 모든 권한을 가짐
+const (
+	PermissionManagePositions   = "MANAGE_POSITIONS"
+	PermissionManageDepartments = "MANAGE_DEPARTMENTS"
+	PermissionModeratePosts     = "MODERATE_POSTS"
+	PermissionViewStats         = "VIEW_STATS"
+	PermissionInviteMembers     = "INVITE_MEMBERS"
+)
+
+var CompanyPermissions = []string{
+	PermissionManagePositions,
+	PermissionManageDepartments,
+	PermissionModeratePosts,
+	PermissionViewStats,
+	PermissionInviteMembers,
+}
+
+type CompanyRole struct {
+	ID          uint      `json:"id"`
+	CompanyID   uint      `json:"company_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Permissions []string  `json:"permissions"`
+	MemberCount int64     `json:"member_count"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func IsCompanyPermission(permission string) bool {
+	return slices.Contains(CompanyPermissions, permission)
+}
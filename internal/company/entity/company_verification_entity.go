@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+const (
+	CompanyVerificationPending  = "PENDING"
+	CompanyVerificationApproved = "APPROVED"
+	CompanyVerificationRejected = "REJECTED"
+)
+
+type CompanyVerification struct {
+	ID            uint                          `json:"id"`
+	CompanyID     uint                          `json:"company_id"`
+	CompanyName   string                        `json:"company_name,omitempty"`
+	RequesterID   uint                          `json:"requester_id"`
+	RequesterName string                        `json:"requester_name,omitempty"`
+	CpNumber      string                        `json:"cp_number"`
+	Status        string                        `json:"status"`
+	Reason        string                        `json:"reason,omitempty"`
+	ReviewerID    *uint                         `json:"reviewer_id,omitempty"`
+	ReviewedAt    *time.Time                    `json:"reviewed_at,omitempty"`
+	Documents     []CompanyVerificationDocument `json:"documents,omitempty"`
+	CreatedAt     time.Time                     `json:"created_at"`
+	UpdatedAt     time.Time                     `json:"updated_at"`
+}
+
+type CompanyVerificationDocument struct {
+	ID             uint   `json:"id"`
+	VerificationID uint   `json:"verification_id,omitempty"`
+	FileURL        string `json:"file_url"`
+	FileName       string `json:"file_name"`
+}
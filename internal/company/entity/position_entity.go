@@ -8,4 +8,7 @@ type Position struct {
 	CompanyID uint      `json:"company_id"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	//TODO 직급 순위 - 숫자가 클수록 높은 직급
+	Rank int `json:"rank"`
 }
@@ -0,0 +1,14 @@
+package entity
+
+// TODO 보고 라인 구성원 - 회사별 직책, 상급자 기준
+type ReportingMember struct {
+	UserID       uint    `json:"user_id"`
+	Name         string  `json:"name"`
+	Nickname     string  `json:"nickname"`
+	Email        string  `json:"email"`
+	Image        *string `json:"image,omitempty"`
+	PositionID   *uint   `json:"position_id,omitempty"`
+	PositionName string  `json:"position_name,omitempty"`
+	PositionRank int     `json:"position_rank"`
+	ManagerID    *uint   `json:"manager_id,omitempty"`
+}
@@ -0,0 +1,16 @@
+package repository
+
+import "link/internal/company/entity"
+
+type CompanyEmailDomainRepository interface {
+	CreateCompanyEmailDomain(domain *entity.CompanyEmailDomain) error
+	GetCompanyEmailDomainByID(domainID uint) (*entity.CompanyEmailDomain, error)
+	GetCompanyEmailDomainsByCompany(companyID uint) ([]entity.CompanyEmailDomain, error)
+	ExistsCompanyEmailDomain(companyID uint, domain string) (bool, error)
+	UpdateCompanyEmailDomainPolicy(domainID uint, joinPolicy string) error
+	DeleteCompanyEmailDomain(domainID uint) error
+
+	//TODO 인증 완료된 도메인 - 없으면 nil 반환
+	GetVerifiedCompanyEmailDomain(domain string) (*entity.CompanyEmailDomain, error)
+	VerifyCompanyEmailDomain(domainID uint) error
+}
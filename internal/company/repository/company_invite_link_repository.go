@@ -0,0 +1,15 @@
+package repository
+
+import "link/internal/company/entity"
+
+type CompanyInviteLinkRepository interface {
+	CreateCompanyInviteLink(link *entity.CompanyInviteLink) error
+	GetCompanyInviteLinkByID(linkID uint) (*entity.CompanyInviteLink, error)
+	GetCompanyInviteLinkByCode(code string) (*entity.CompanyInviteLink, error)
+	GetCompanyInviteLinksByCompany(companyID uint) ([]entity.CompanyInviteLink, error)
+	RevokeCompanyInviteLink(linkID uint) error
+	ExistsCompanyInviteLinkRedemption(linkID uint, userID uint) (bool, error)
+
+	//TODO 사용 횟수 차감, 회사 소속, 부서, 프로젝트 추가까지 한 트랜잭션
+	RedeemCompanyInviteLink(linkID uint, userID uint) error
+}
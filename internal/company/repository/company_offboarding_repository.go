@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"time"
+
+	"link/internal/company/entity"
+)
+
+type CompanyOffboardingRepository interface {
+	//TODO 삭제 예정(읽기 전용) 여부 - 삭제 예정이 아니면 nil
+	GetCompanyPurgeScheduledAt(companyID uint) (*time.Time, error)
+
+	//TODO 회사 전체 데이터 내보내기 (사용자, 조직, 게시물, 보드, 채팅)
+	GetCompanyExport(companyID uint) (*entity.CompanyExport, error)
+
+	//TODO 영구 삭제 - Postgres, MongoDB, Redis, 저장된 파일
+	GetCompanyIdsToPurge(before time.Time) ([]uint, error)
+	PurgeCompany(companyID uint) error
+}
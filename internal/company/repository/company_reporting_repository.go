@@ -0,0 +1,12 @@
+package repository
+
+import "link/internal/company/entity"
+
+// TODO 회사 보고 라인 (상급자, 직속 부하, 조직도)
+type CompanyReportingRepository interface {
+	GetReportingMember(companyID uint, userID uint) (*entity.ReportingMember, error)
+	GetReportingMembers(companyID uint) ([]entity.ReportingMember, error)
+	GetDirectReports(companyID uint, managerID uint) ([]entity.ReportingMember, error)
+	GetManagementChain(companyID uint, userID uint) ([]entity.ReportingMember, error)
+	UpdateManager(companyID uint, userID uint, managerID *uint) error
+}
@@ -1,17 +1,28 @@
 package repository
 
-import "link/internal/company/entity"
+import (
+	"link/internal/company/entity"
+	"time"
+)
 
 type CompanyRepository interface {
 	//TODO 회사 정보 관련
 	CreateCompany(company *entity.Company) (*entity.Company, error)
 	UpdateCompany(companyID uint, company *entity.Company) error
-	DeleteCompany(companyID uint) error
+
+	//TODO 회사 삭제 예약, 복구 - 실제 삭제는 CompanyOffboardingRepository.PurgeCompany
+	ScheduleCompanyDeletion(companyID uint, requestedAt time.Time, purgeAt time.Time) error
+	RestoreCompany(companyID uint) error
 
 	GetCompanyByID(companyID uint) (*entity.Company, error)
 	GetAllCompanies() ([]entity.Company, error)
 	SearchCompany(companyName string) ([]entity.Company, error)
 
+	//TODO 요금제 사용량 관련
+	GetCompanyUsage(companyID uint) (*entity.CompanyUsage, error)
+	IncreaseCompanyStorageUsage(companyID uint, bytes int64) error
+	DecreaseCompanyStorageUsage(companyID uint, bytes int64) error
+
 	//TODO 회사 직책 관련
 	CreateCompanyPosition(position *entity.Position) error
 	DeleteCompanyPosition(positionID uint) error
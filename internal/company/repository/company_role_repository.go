@@ -0,0 +1,15 @@
+package repository
+
+import "link/internal/company/entity"
+
+type CompanyRoleRepository interface {
+	CreateCompanyRole(role *entity.CompanyRole) error
+	GetCompanyRoleByID(roleID uint) (*entity.CompanyRole, error)
+	GetCompanyRolesByCompany(companyID uint) ([]entity.CompanyRole, error)
+	ExistsCompanyRoleName(companyID uint, name string, excludeRoleID uint) (bool, error)
+	UpdateCompanyRole(role *entity.CompanyRole) error
+	DeleteCompanyRole(roleID uint) error
+
+	//TODO 회사 소속 사용자에게 역할 부여, roleID가 nil이면 해제
+	AssignCompanyRole(userID uint, companyID uint, roleID *uint) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import "link/internal/company/entity"
+
+type CompanyVerificationRepository interface {
+	CreateCompanyVerification(verification *entity.CompanyVerification) error
+	GetCompanyVerificationByID(verificationID uint) (*entity.CompanyVerification, error)
+	GetPendingCompanyVerification(companyID uint) (*entity.CompanyVerification, error)
+	GetCompanyVerificationsByCompany(companyID uint) ([]entity.CompanyVerification, error)
+	GetCompanyVerificationsByStatus(status string, page int, limit int) ([]entity.CompanyVerification, int64, error)
+	GetCompanyVerificationDocumentByID(documentID uint) (*entity.CompanyVerificationDocument, error)
+	ExistsCpNumber(cpNumber string, excludeCompanyID uint) (bool, error)
+
+	//TODO 승인 시 회사 인증 처리까지 한 트랜잭션
+	ReviewCompanyVerification(verificationID uint, reviewerID uint, status string, reason string) error
+}
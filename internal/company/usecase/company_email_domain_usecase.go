@@ -0,0 +1,306 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"link/internal/company/entity"
+	_companyRepo "link/internal/company/repository"
+	_userEntity "link/internal/user/entity"
+	_userRepo "link/internal/user/repository"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+	_util "link/pkg/util"
+)
+
+const emailDomainLookupTimeout = 5 * time.Second
+
+var emailDomainPattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,}$`)
+
+type CompanyEmailDomainUsecase interface {
+	//TODO 회사 관리자 (Role 3,4) - 활성 회사 기준
+	ClaimCompanyEmailDomain(requestUserId uint, companyId uint, request req.ClaimCompanyEmailDomainRequest) (*res.CompanyEmailDomainResponse, error)
+	GetCompanyEmailDomains(requestUserId uint, companyId uint) ([]res.CompanyEmailDomainResponse, error)
+	VerifyCompanyEmailDomain(requestUserId uint, companyId uint, domainId uint) (*res.CompanyEmailDomainResponse, error)
+	UpdateCompanyEmailDomainPolicy(requestUserId uint, companyId uint, domainId uint, request req.UpdateCompanyEmailDomainRequest) error
+	DeleteCompanyEmailDomain(requestUserId uint, companyId uint, domainId uint) error
+
+	//TODO 사용자 - 이메일 도메인으로 가입 가능한 회사
+	GetSuggestedCompanies(requestUserId uint) ([]res.EmailDomainCompanyResponse, error)
+}
+
+type companyEmailDomainUsecase struct {
+	companyRepository            _companyRepo.CompanyRepository
+	companyEmailDomainRepository _companyRepo.CompanyEmailDomainRepository
+	userRepository               _userRepo.UserRepository
+}
+
+func NewCompanyEmailDomainUsecase(
+	companyRepository _companyRepo.CompanyRepository,
+	companyEmailDomainRepository _companyRepo.CompanyEmailDomainRepository,
+	userRepository _userRepo.UserRepository) CompanyEmailDomainUsecase {
+	return &companyEmailDomainUsecase{
+		companyRepository:            companyRepository,
+		companyEmailDomainRepository: companyEmailDomainRepository,
+		userRepository:               userRepository,
+	}
+}
+
+// TODO 이메일 도메인 등록 - 인증된 회사만, 등록 후 TXT 레코드로 소유 확인 필요
+func (u *companyEmailDomainUsecase) ClaimCompanyEmailDomain(requestUserId uint, companyId uint, request req.ClaimCompanyEmailDomainRequest) (*res.CompanyEmailDomainResponse, error) {
+	companyId, err := u.getDomainManager(requestUserId, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	company, err := u.companyRepository.GetCompanyByID(companyId)
+	if err != nil {
+		log.Printf("회사 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "회사 조회에 실패했습니다", err)
+	}
+	if !company.IsVerified {
+		return nil, common.NewError(http.StatusForbidden, "인증된 회사만 이메일 도메인을 등록할 수 있습니다", nil)
+	}
+
+	domain := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(request.Domain), "@"))
+	if !emailDomainPattern.MatchString(domain) {
+		return nil, common.NewError(http.StatusBadRequest, "유효하지 않은 이메일 도메인입니다", nil)
+	}
+	if entity.IsPublicEmailDomain(domain) {
+		return nil, common.NewError(http.StatusBadRequest, "공용 메일 서비스 도메인은 등록할 수 없습니다", nil)
+	}
+
+	exists, err := u.companyEmailDomainRepository.ExistsCompanyEmailDomain(companyId, domain)
+	if err != nil {
+		log.Printf("이메일 도메인 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "이메일 도메인 조회에 실패했습니다", err)
+	}
+	if exists {
+		return nil, common.NewError(http.StatusConflict, "이미 등록한 도메인입니다", nil)
+	}
+
+	verified, err := u.companyEmailDomainRepository.GetVerifiedCompanyEmailDomain(domain)
+	if err != nil {
+		log.Printf("이메일 도메인 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "이메일 도메인 조회에 실패했습니다", err)
+	}
+	if verified != nil {
+		return nil, common.NewError(http.StatusConflict, "다른 회사가 인증한 도메인입니다", nil)
+	}
+
+	token, err := _util.GenerateVerificationToken()
+	if err != nil {
+		log.Printf("도메인 인증 토큰 생성에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "이메일 도메인 등록에 실패했습니다", err)
+	}
+
+	emailDomain := &entity.CompanyEmailDomain{
+		CompanyID:         companyId,
+		Domain:            domain,
+		VerificationToken: token,
+		JoinPolicy:        entity.EmailDomainJoinSuggest,
+	}
+	if request.JoinPolicy != "" {
+		emailDomain.JoinPolicy = request.JoinPolicy
+	}
+
+	if err := u.companyEmailDomainRepository.CreateCompanyEmailDomain(emailDomain); err != nil {
+		log.Printf("이메일 도메인 등록에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "이메일 도메인 등록에 실패했습니다", err)
+	}
+
+	response := toCompanyEmailDomainResponse(*emailDomain)
+	return &response, nil
+}
+
+// TODO 이메일 도메인 목록
+func (u *companyEmailDomainUsecase) GetCompanyEmailDomains(requestUserId uint, companyId uint) ([]res.CompanyEmailDomainResponse, error) {
+	companyId, err := u.getDomainManager(requestUserId, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	domains, err := u.companyEmailDomainRepository.GetCompanyEmailDomainsByCompany(companyId)
+	if err != nil {
+		log.Printf("이메일 도메인 목록 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "이메일 도메인 목록 조회에 실패했습니다", err)
+	}
+
+	response := make([]res.CompanyEmailDomainResponse, len(domains))
+	for i, domain := range domains {
+		response[i] = toCompanyEmailDomainResponse(domain)
+	}
+	return response, nil
+}
+
+// TODO 도메인 소유 확인 - DNS TXT 레코드에 인증 토큰이 있어야 함
+func (u *companyEmailDomainUsecase) VerifyCompanyEmailDomain(requestUserId uint, companyId uint, domainId uint) (*res.CompanyEmailDomainResponse, error) {
+	emailDomain, err := u.getCompanyEmailDomain(requestUserId, companyId, domainId)
+	if err != nil {
+		return nil, err
+	}
+	if emailDomain.VerifiedAt != nil {
+		return nil, common.NewError(http.StatusConflict, "이미 인증된 도메인입니다", nil)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), emailDomainLookupTimeout)
+	defer cancel()
+
+	records, err := net.DefaultResolver.LookupTXT(ctx, emailDomain.Domain)
+	if err != nil {
+		log.Printf("도메인 TXT 레코드 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusBadRequest, "도메인 TXT 레코드를 조회할 수 없습니다", err)
+	}
+
+	found := false
+	for _, record := range records {
+		if strings.TrimSpace(record) == emailDomain.TxtRecord() {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, common.NewError(http.StatusBadRequest, "도메인 TXT 레코드에서 인증 토큰을 찾을 수 없습니다", nil)
+	}
+
+	if err := u.companyEmailDomainRepository.VerifyCompanyEmailDomain(domainId); err != nil {
+		log.Printf("이메일 도메인 인증에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusConflict, "이메일 도메인 인증에 실패했습니다. 다른 회사가 인증한 도메인인지 확인해주세요", err)
+	}
+
+	verifiedAt := time.Now()
+	emailDomain.VerifiedAt = &verifiedAt
+	response := toCompanyEmailDomainResponse(*emailDomain)
+	return &response, nil
+}
+
+// TODO 가입 정책 변경 - SUGGEST, AUTO_JOIN
+func (u *companyEmailDomainUsecase) UpdateCompanyEmailDomainPolicy(requestUserId uint, companyId uint, domainId uint, request req.UpdateCompanyEmailDomainRequest) error {
+	if _, err := u.getCompanyEmailDomain(requestUserId, companyId, domainId); err != nil {
+		return err
+	}
+
+	if err := u.companyEmailDomainRepository.UpdateCompanyEmailDomainPolicy(domainId, request.JoinPolicy); err != nil {
+		log.Printf("이메일 도메인 가입 정책 변경에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "이메일 도메인 가입 정책 변경에 실패했습니다", err)
+	}
+	return nil
+}
+
+// TODO 이메일 도메인 삭제 - 이미 가입한 사용자는 유지
+func (u *companyEmailDomainUsecase) DeleteCompanyEmailDomain(requestUserId uint, companyId uint, domainId uint) error {
+	if _, err := u.getCompanyEmailDomain(requestUserId, companyId, domainId); err != nil {
+		return err
+	}
+
+	if err := u.companyEmailDomainRepository.DeleteCompanyEmailDomain(domainId); err != nil {
+		log.Printf("이메일 도메인 삭제에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "이메일 도메인 삭제에 실패했습니다", err)
+	}
+	return nil
+}
+
+// TODO 가입 제안 회사 - 이메일 도메인을 인증한 회사 중 아직 소속되지 않은 회사
+func (u *companyEmailDomainUsecase) GetSuggestedCompanies(requestUserId uint) ([]res.EmailDomainCompanyResponse, error) {
+	user, err := u.userRepository.GetUserByID(requestUserId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "사용자 조회에 실패했습니다", err)
+	}
+
+	response := []res.EmailDomainCompanyResponse{}
+
+	domain := entity.EmailDomainOf(_util.GetValueOrDefault(user.Email, ""))
+	if domain == "" {
+		return response, nil
+	}
+
+	//TODO 이메일 확인 전에는 주소만 입력하면 되므로 가입 제안하지 않음
+	verified, err := u.userRepository.IsEmailVerified(requestUserId, _util.GetValueOrDefault(user.Email, ""))
+	if err != nil {
+		log.Printf("이메일 확인 여부 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "이메일 확인 여부 조회에 실패했습니다", err)
+	}
+	if !verified {
+		return response, nil
+	}
+
+	emailDomain, err := u.companyEmailDomainRepository.GetVerifiedCompanyEmailDomain(domain)
+	if err != nil {
+		log.Printf("이메일 도메인 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "이메일 도메인 조회에 실패했습니다", err)
+	}
+	if emailDomain == nil {
+		return response, nil
+	}
+
+	membership, err := u.userRepository.GetCompanyMembership(requestUserId, emailDomain.CompanyID)
+	if err != nil {
+		log.Printf("회사 소속 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 소속 조회에 실패했습니다", err)
+	}
+	if membership != nil {
+		return response, nil
+	}
+
+	return append(response, res.EmailDomainCompanyResponse{
+		CompanyID:   emailDomain.CompanyID,
+		CompanyName: emailDomain.CompanyName,
+		Domain:      emailDomain.Domain,
+		JoinPolicy:  emailDomain.JoinPolicy,
+	}), nil
+}
+
+func (u *companyEmailDomainUsecase) getCompanyEmailDomain(requestUserId uint, companyId uint, domainId uint) (*entity.CompanyEmailDomain, error) {
+	companyId, err := u.getDomainManager(requestUserId, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	emailDomain, err := u.companyEmailDomainRepository.GetCompanyEmailDomainByID(domainId)
+	if err != nil || emailDomain.CompanyID != companyId {
+		log.Printf("이메일 도메인 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 이메일 도메인입니다", err)
+	}
+	return emailDomain, nil
+}
+
+func (u *companyEmailDomainUsecase) getDomainManager(requestUserId uint, companyId uint) (uint, error) {
+	requestUser, err := u.userRepository.GetUserByIDInCompany(requestUserId, companyId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return 0, common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+	}
+
+	if requestUser.UserProfile == nil || requestUser.UserProfile.CompanyID == nil || *requestUser.UserProfile.CompanyID == 0 {
+		return 0, common.NewError(http.StatusBadRequest, "사용자가 소속된 회사가 없습니다", nil)
+	}
+
+	if requestUser.Role > _userEntity.RoleCompanySubManager {
+		log.Printf("권한이 없는 사용자가 이메일 도메인을 관리하려 했습니다: 사용자 ID %d", requestUserId)
+		return 0, common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+	}
+
+	return *requestUser.UserProfile.CompanyID, nil
+}
+
+func toCompanyEmailDomainResponse(domain entity.CompanyEmailDomain) res.CompanyEmailDomainResponse {
+	response := res.CompanyEmailDomainResponse{
+		ID:         domain.ID,
+		Domain:     domain.Domain,
+		JoinPolicy: domain.JoinPolicy,
+		IsVerified: domain.VerifiedAt != nil,
+		TxtRecord:  domain.TxtRecord(),
+		CreatedAt:  _util.ParseKst(domain.CreatedAt).Format(time.DateTime),
+	}
+	if domain.VerifiedAt != nil {
+		response.VerifiedAt = _util.ParseKst(*domain.VerifiedAt).Format(time.DateTime)
+	}
+	return response
+}
@@ -0,0 +1,363 @@
+package usecase
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"link/internal/company/entity"
+	_companyRepo "link/internal/company/repository"
+	_departmentRepo "link/internal/department/repository"
+	_projectRepo "link/internal/project/repository"
+	_userEntity "link/internal/user/entity"
+	_userRepo "link/internal/user/repository"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+	_util "link/pkg/util"
+)
+
+const defaultInviteLinkExpiration = 7 * 24 * time.Hour
+
+type CompanyInviteLinkUsecase interface {
+	//TODO 회사 관리자 (Role 3,4) - 활성 회사 기준
+	CreateCompanyInviteLink(requestUserId uint, companyId uint, request req.CreateCompanyInviteLinkRequest) (*res.CompanyInviteLinkResponse, error)
+	GetCompanyInviteLinks(requestUserId uint, companyId uint) ([]res.CompanyInviteLinkResponse, error)
+	RevokeCompanyInviteLink(requestUserId uint, companyId uint, linkId uint) error
+
+	//TODO 초대 링크를 받은 사용자
+	GetCompanyInviteLinkPreview(code string) (*res.CompanyInviteLinkPreviewResponse, error)
+	RedeemCompanyInviteLink(requestUserId uint, code string) (*res.RedeemCompanyInviteLinkResponse, error)
+}
+
+type companyInviteLinkUsecase struct {
+	companyRepository           _companyRepo.CompanyRepository
+	companyInviteLinkRepository _companyRepo.CompanyInviteLinkRepository
+	userRepository              _userRepo.UserRepository
+	departmentRepository        _departmentRepo.DepartmentRepository
+	projectRepository           _projectRepo.ProjectRepository
+	companyPlanUsecase          CompanyPlanUsecase
+}
+
+func NewCompanyInviteLinkUsecase(
+	companyRepository _companyRepo.CompanyRepository,
+	companyInviteLinkRepository _companyRepo.CompanyInviteLinkRepository,
+	userRepository _userRepo.UserRepository,
+	departmentRepository _departmentRepo.DepartmentRepository,
+	projectRepository _projectRepo.ProjectRepository,
+	companyPlanUsecase CompanyPlanUsecase) CompanyInviteLinkUsecase {
+	return &companyInviteLinkUsecase{
+		companyRepository:           companyRepository,
+		companyInviteLinkRepository: companyInviteLinkRepository,
+		userRepository:              userRepository,
+		departmentRepository:        departmentRepository,
+		projectRepository:           projectRepository,
+		companyPlanUsecase:          companyPlanUsecase,
+	}
+}
+
+// TODO 초대 링크 생성 - 자신보다 높은 역할로는 초대할 수 없음
+func (u *companyInviteLinkUsecase) CreateCompanyInviteLink(requestUserId uint, companyId uint, request req.CreateCompanyInviteLinkRequest) (*res.CompanyInviteLinkResponse, error) {
+	requestUser, companyId, err := u.getInviteManager(requestUserId, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	role := _userEntity.UserRole(request.Role)
+	if request.Role == 0 {
+		role = _userEntity.RoleUser
+	}
+	if role < requestUser.Role {
+		return nil, common.NewError(http.StatusForbidden, "자신보다 높은 역할로 초대할 수 없습니다", nil)
+	}
+
+	link := &entity.CompanyInviteLink{
+		CompanyID:    companyId,
+		CreatorID:    requestUserId,
+		TargetType:   request.TargetType,
+		DepartmentID: request.DepartmentID,
+		Role:         int(role),
+		MaxUses:      request.MaxUses,
+	}
+
+	if request.TargetType == entity.InviteTargetDepartment && request.DepartmentID == nil {
+		return nil, common.NewError(http.StatusBadRequest, "부서 초대 링크는 department_id가 필요합니다", nil)
+	}
+	if request.DepartmentID != nil {
+		if _, err := u.departmentRepository.GetDepartmentByID(companyId, *request.DepartmentID); err != nil {
+			log.Printf("부서 조회에 실패했습니다: %v", err)
+			return nil, common.NewError(http.StatusNotFound, "존재하지 않는 부서입니다", err)
+		}
+	}
+
+	if request.TargetType == entity.InviteTargetProject {
+		if request.ProjectID == nil {
+			return nil, common.NewError(http.StatusBadRequest, "프로젝트 초대 링크는 project_id가 필요합니다", nil)
+		}
+		project, err := u.projectRepository.GetProjectByProjectID(*request.ProjectID)
+		if err != nil || project.CompanyID != companyId {
+			log.Printf("프로젝트 조회에 실패했습니다: %v", err)
+			return nil, common.NewError(http.StatusNotFound, "존재하지 않는 프로젝트입니다", err)
+		}
+		link.ProjectID = request.ProjectID
+	}
+
+	emailDomain := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(request.EmailDomain), "@"))
+	if emailDomain != "" && (!strings.Contains(emailDomain, ".") || strings.ContainsAny(emailDomain, "@ ")) {
+		return nil, common.NewError(http.StatusBadRequest, "유효하지 않은 이메일 도메인입니다", nil)
+	}
+	link.EmailDomain = emailDomain
+
+	expiration := defaultInviteLinkExpiration
+	if request.ExpiresInHours > 0 {
+		expiration = time.Duration(request.ExpiresInHours) * time.Hour
+	}
+	expiresAt := time.Now().Add(expiration)
+	link.ExpiresAt = &expiresAt
+
+	link.Code, err = _util.GenerateInviteCode()
+	if err != nil {
+		log.Printf("초대 코드 생성에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "초대 링크 생성에 실패했습니다", err)
+	}
+
+	if err := u.companyInviteLinkRepository.CreateCompanyInviteLink(link); err != nil {
+		log.Printf("초대 링크 생성에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "초대 링크 생성에 실패했습니다", err)
+	}
+
+	// 회사, 부서, 프로젝트 이름 포함해서 반환
+	created, err := u.companyInviteLinkRepository.GetCompanyInviteLinkByID(link.ID)
+	if err != nil {
+		log.Printf("초대 링크 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "초대 링크 조회에 실패했습니다", err)
+	}
+
+	response := toCompanyInviteLinkResponse(*created)
+	return &response, nil
+}
+
+// TODO 초대 링크 목록 - 만료, 폐기된 링크 포함
+func (u *companyInviteLinkUsecase) GetCompanyInviteLinks(requestUserId uint, companyId uint) ([]res.CompanyInviteLinkResponse, error) {
+	_, companyId, err := u.getInviteManager(requestUserId, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := u.companyInviteLinkRepository.GetCompanyInviteLinksByCompany(companyId)
+	if err != nil {
+		log.Printf("초대 링크 목록 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "초대 링크 목록 조회에 실패했습니다", err)
+	}
+
+	response := make([]res.CompanyInviteLinkResponse, len(links))
+	for i, link := range links {
+		response[i] = toCompanyInviteLinkResponse(link)
+	}
+	return response, nil
+}
+
+// TODO 초대 링크 폐기 - 이미 가입한 사용자는 유지
+func (u *companyInviteLinkUsecase) RevokeCompanyInviteLink(requestUserId uint, companyId uint, linkId uint) error {
+	_, companyId, err := u.getInviteManager(requestUserId, companyId)
+	if err != nil {
+		return err
+	}
+
+	link, err := u.companyInviteLinkRepository.GetCompanyInviteLinkByID(linkId)
+	if err != nil || link.CompanyID != companyId {
+		log.Printf("초대 링크 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusNotFound, "존재하지 않는 초대 링크입니다", err)
+	}
+	if link.RevokedAt != nil {
+		return common.NewError(http.StatusConflict, "이미 폐기된 초대 링크입니다", nil)
+	}
+
+	if err := u.companyInviteLinkRepository.RevokeCompanyInviteLink(linkId); err != nil {
+		log.Printf("초대 링크 폐기에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "초대 링크 폐기에 실패했습니다", err)
+	}
+
+	return nil
+}
+
+// TODO 초대 링크 미리보기 - 어느 회사, 부서, 프로젝트로 초대되는지 확인
+func (u *companyInviteLinkUsecase) GetCompanyInviteLinkPreview(code string) (*res.CompanyInviteLinkPreviewResponse, error) {
+	link, err := u.companyInviteLinkRepository.GetCompanyInviteLinkByCode(code)
+	if err != nil {
+		log.Printf("초대 링크 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 초대 링크입니다", err)
+	}
+
+	response := &res.CompanyInviteLinkPreviewResponse{
+		CompanyID:      link.CompanyID,
+		CompanyName:    link.CompanyName,
+		TargetType:     link.TargetType,
+		DepartmentName: link.DepartmentName,
+		ProjectName:    link.ProjectName,
+		Role:           link.Role,
+		Status:         link.Status(time.Now()),
+	}
+	if link.ExpiresAt != nil {
+		response.ExpiresAt = _util.ParseKst(*link.ExpiresAt).Format(time.DateTime)
+	}
+	return response, nil
+}
+
+// TODO 초대 링크 사용 - 회사 소속 추가 후 링크에 지정된 부서, 프로젝트까지 추가
+func (u *companyInviteLinkUsecase) RedeemCompanyInviteLink(requestUserId uint, code string) (*res.RedeemCompanyInviteLinkResponse, error) {
+	link, err := u.companyInviteLinkRepository.GetCompanyInviteLinkByCode(code)
+	if err != nil {
+		log.Printf("초대 링크 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 초대 링크입니다", err)
+	}
+	if err := checkInviteLinkStatus(link); err != nil {
+		return nil, err
+	}
+
+	user, err := u.userRepository.GetUserByID(requestUserId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "사용자 조회에 실패했습니다", err)
+	}
+	if !link.AllowsEmail(_util.GetValueOrDefault(user.Email, "")) {
+		return nil, common.NewError(http.StatusForbidden, fmt.Sprintf("%s 이메일 사용자만 사용할 수 있는 초대 링크입니다", link.EmailDomain), nil)
+	}
+
+	redeemed, err := u.companyInviteLinkRepository.ExistsCompanyInviteLinkRedemption(link.ID, requestUserId)
+	if err != nil {
+		log.Printf("초대 링크 사용 이력 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "초대 링크 사용 이력 조회에 실패했습니다", err)
+	}
+	if redeemed {
+		return nil, common.NewError(http.StatusConflict, "이미 사용한 초대 링크입니다", nil)
+	}
+
+	membership, err := u.userRepository.GetCompanyMembership(requestUserId, link.CompanyID)
+	if err != nil {
+		log.Printf("회사 소속 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 소속 조회에 실패했습니다", err)
+	}
+
+	if membership == nil {
+		if err := u.companyPlanUsecase.CheckMemberQuota(link.CompanyID); err != nil {
+			return nil, err
+		}
+	} else if err := u.checkAlreadyJoined(requestUserId, link, membership); err != nil {
+		return nil, err
+	}
+
+	if err := u.companyInviteLinkRepository.RedeemCompanyInviteLink(link.ID, requestUserId); err != nil {
+		log.Printf("초대 링크 사용에 실패했습니다: %v", err)
+		// 확인 이후 다른 사용자가 마지막 사용 횟수를 가져갔거나 폐기된 경우
+		if latest, getErr := u.companyInviteLinkRepository.GetCompanyInviteLinkByID(link.ID); getErr == nil {
+			if statusErr := checkInviteLinkStatus(latest); statusErr != nil {
+				return nil, statusErr
+			}
+		}
+		return nil, common.NewError(http.StatusInternalServerError, "초대 링크 사용에 실패했습니다", err)
+	}
+
+	response := &res.RedeemCompanyInviteLinkResponse{
+		CompanyID:    link.CompanyID,
+		CompanyName:  link.CompanyName,
+		TargetType:   link.TargetType,
+		DepartmentID: link.DepartmentID,
+		ProjectID:    link.ProjectID,
+		Role:         link.Role,
+	}
+	if membership != nil {
+		response.Role = int(membership.Role)
+	}
+	return response, nil
+}
+
+// 이미 회사에 소속된 사용자는 링크로 새로 추가될 부서나 프로젝트가 있을 때만 사용 가능
+func (u *companyInviteLinkUsecase) checkAlreadyJoined(userId uint, link *entity.CompanyInviteLink, membership *_userEntity.CompanyMembership) error {
+	switch link.TargetType {
+	case entity.InviteTargetProject:
+		inProject, err := u.projectRepository.InUserInProject(userId, *link.ProjectID)
+		if err == nil && inProject {
+			return common.NewError(http.StatusConflict, "이미 참여 중인 프로젝트입니다", nil)
+		}
+	case entity.InviteTargetDepartment:
+		departmentIds := _util.ExtractValuesFromMapSlice[uint](membership.Departments, "id")
+		for _, departmentId := range departmentIds {
+			if departmentId == *link.DepartmentID {
+				return common.NewError(http.StatusConflict, "이미 소속된 부서입니다", nil)
+			}
+		}
+	default:
+		return common.NewError(http.StatusConflict, "이미 소속된 회사입니다", nil)
+	}
+	return nil
+}
+
+func (u *companyInviteLinkUsecase) getInviteManager(requestUserId uint, companyId uint) (*_userEntity.User, uint, error) {
+	requestUser, err := u.userRepository.GetUserByIDInCompany(requestUserId, companyId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return nil, 0, common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+	}
+
+	if requestUser.UserProfile == nil || requestUser.UserProfile.CompanyID == nil || *requestUser.UserProfile.CompanyID == 0 {
+		return nil, 0, common.NewError(http.StatusBadRequest, "사용자가 소속된 회사가 없습니다", nil)
+	}
+
+	//TODO 회사 관리자 또는 INVITE_MEMBERS 권한이 있는 회사 역할
+	allowed, err := requestUser.HasCompanyPermission(entity.PermissionInviteMembers, u.userRepository.GetCompanyPermissions)
+	if err != nil {
+		log.Printf("회사 역할 권한 조회에 실패했습니다: %v", err)
+		return nil, 0, common.NewError(http.StatusInternalServerError, "회사 역할 권한 조회에 실패했습니다", err)
+	}
+	if !allowed {
+		log.Printf("권한이 없는 사용자가 초대 링크를 관리하려 했습니다: 사용자 ID %d", requestUserId)
+		return nil, 0, common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+	}
+
+	return requestUser, *requestUser.UserProfile.CompanyID, nil
+}
+
+func checkInviteLinkStatus(link *entity.CompanyInviteLink) error {
+	switch link.Status(time.Now()) {
+	case entity.InviteLinkRevoked:
+		return common.NewError(http.StatusBadRequest, "폐기된 초대 링크입니다", nil)
+	case entity.InviteLinkExpired:
+		return common.NewError(http.StatusBadRequest, "만료된 초대 링크입니다", nil)
+	case entity.InviteLinkExhausted:
+		return common.NewError(http.StatusBadRequest, "사용 가능 횟수를 모두 사용한 초대 링크입니다", nil)
+	}
+	return nil
+}
+
+func toCompanyInviteLinkResponse(link entity.CompanyInviteLink) res.CompanyInviteLinkResponse {
+	response := res.CompanyInviteLinkResponse{
+		ID:             link.ID,
+		Code:           link.Code,
+		InviteURL:      fmt.Sprintf("/api/invite/%s", link.Code),
+		CompanyID:      link.CompanyID,
+		CompanyName:    link.CompanyName,
+		TargetType:     link.TargetType,
+		DepartmentID:   link.DepartmentID,
+		DepartmentName: link.DepartmentName,
+		ProjectID:      link.ProjectID,
+		ProjectName:    link.ProjectName,
+		Role:           link.Role,
+		EmailDomain:    link.EmailDomain,
+		MaxUses:        link.MaxUses,
+		UseCount:       link.UseCount,
+		Status:         link.Status(time.Now()),
+		CreatorID:      link.CreatorID,
+		CreatorName:    link.CreatorName,
+		CreatedAt:      _util.ParseKst(link.CreatedAt).Format(time.DateTime),
+	}
+	if link.ExpiresAt != nil {
+		response.ExpiresAt = _util.ParseKst(*link.ExpiresAt).Format(time.DateTime)
+	}
+	if link.RevokedAt != nil {
+		response.RevokedAt = _util.ParseKst(*link.RevokedAt).Format(time.DateTime)
+	}
+	return response
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	_companyRepo "link/internal/company/repository"
+	_userEntity "link/internal/user/entity"
+	_userRepo "link/internal/user/repository"
+
+	"link/pkg/common"
+	"link/pkg/dto/res"
+	_util "link/pkg/util"
+)
+
+type CompanyOffboardingUsecase interface {
+	//TODO 운영자(Role 1,2)는 모든 회사, 회사 관리자(Role 3)는 소속 회사만
+	ExportCompanyData(requestUserId uint, companyId uint) (*res.CompanyExportResponse, error)
+
+	//TODO 읽기 전용 미들웨어에서 사용 - 삭제 예정 회사는 쓰기 금지
+	CheckCompanyWritable(userId uint, companyId uint) error
+	CheckTargetCompanyWritable(userId uint, companyId uint) error
+
+	//TODO 스케줄러에서 사용 - 유예 기간이 지난 회사 영구 삭제
+	PurgeExpiredCompanies() error
+}
+
+type companyOffboardingUsecase struct {
+	companyOffboardingRepository _companyRepo.CompanyOffboardingRepository
+	userRepository               _userRepo.UserRepository
+}
+
+func NewCompanyOffboardingUsecase(
+	companyOffboardingRepository _companyRepo.CompanyOffboardingRepository,
+	userRepository _userRepo.UserRepository) CompanyOffboardingUsecase {
+	return &companyOffboardingUsecase{
+		companyOffboardingRepository: companyOffboardingRepository,
+		userRepository:               userRepository,
+	}
+}
+
+// TODO 회사 전체 데이터 내보내기 (사용자, 조직, 게시물, 보드, 채팅)
+func (u *companyOffboardingUsecase) ExportCompanyData(requestUserId uint, companyId uint) (*res.CompanyExportResponse, error) {
+	requestUser, err := u.userRepository.GetUserByID(requestUserId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+	}
+
+	if requestUser.Role > _userEntity.RoleSubAdmin || companyId == 0 {
+		companyUser, err := u.userRepository.GetUserByIDInCompany(requestUserId, companyId)
+		if err != nil {
+			log.Printf("사용자 조회에 실패했습니다: %v", err)
+			return nil, common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+		}
+		if companyUser.UserProfile == nil || companyUser.UserProfile.CompanyID == nil || *companyUser.UserProfile.CompanyID == 0 {
+			return nil, common.NewError(http.StatusBadRequest, "사용자가 소속된 회사가 없습니다", nil)
+		}
+		if companyUser.Role > _userEntity.RoleCompanyManager {
+			log.Printf("권한이 없는 사용자가 회사 데이터를 내보내려 했습니다: 사용자 ID %d", requestUserId)
+			return nil, common.NewError(http.StatusForbidden, "회사 관리자만 회사 데이터를 내보낼 수 있습니다", nil)
+		}
+		companyId = *companyUser.UserProfile.CompanyID
+	}
+
+	export, err := u.companyOffboardingRepository.GetCompanyExport(companyId)
+	if err != nil {
+		log.Printf("회사 데이터 내보내기에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 데이터 내보내기에 실패했습니다", err)
+	}
+
+	exportedAt := _util.ParseKst(export.ExportedAt)
+	return &res.CompanyExportResponse{
+		FileName:   fmt.Sprintf("company_%d_export_%s.json", companyId, exportedAt.Format("20060102150405")),
+		ExportedAt: exportedAt.Format(time.DateTime),
+		Company:    export.Company,
+		Users:      export.Users,
+		Organization: res.CompanyExportOrganizationResponse{
+			Departments:       export.Organization.Departments,
+			DepartmentMembers: export.Organization.DepartmentMembers,
+			Positions:         export.Organization.Positions,
+			Roles:             export.Organization.Roles,
+			Teams:             export.Organization.Teams,
+			TeamMembers:       export.Organization.TeamMembers,
+		},
+		Posts: res.CompanyExportPostsResponse{
+			Posts:           export.Posts.Posts,
+			PostImages:      export.Posts.PostImages,
+			PostDepartments: export.Posts.PostDepartments,
+			Comments:        export.Posts.Comments,
+		},
+		Boards: res.CompanyExportBoardsResponse{
+			Projects:      export.Boards.Projects,
+			ProjectUsers:  export.Boards.ProjectUsers,
+			Boards:        export.Boards.Boards,
+			BoardColumns:  export.Boards.BoardColumns,
+			BoardCards:    export.Boards.BoardCards,
+			CardAssignees: export.Boards.CardAssignees,
+		},
+		Chats: res.CompanyExportChatsResponse{
+			ChatRooms:     export.Chats.ChatRooms,
+			ChatRoomUsers: export.Chats.ChatRoomUsers,
+			Messages:      export.Chats.Messages,
+		},
+	}, nil
+}
+
+// TODO 활성 회사가 삭제 예정이면 쓰기 금지 - 운영자, 회사 미소속 사용자는 통과
+func (u *companyOffboardingUsecase) CheckCompanyWritable(userId uint, companyId uint) error {
+	user, err := u.userRepository.GetUserByIDInCompany(userId, companyId)
+	if err != nil {
+		return nil // 사용자, 소속 확인은 각 요청에서 처리
+	}
+	if user.Role <= _userEntity.RoleSubAdmin || user.UserProfile == nil || user.UserProfile.CompanyID == nil {
+		return nil
+	}
+
+	return u.checkCompanyNotScheduledForPurge(*user.UserProfile.CompanyID)
+}
+
+// TODO 경로, 쿼리, 본문에 지정한 회사가 삭제 예정이면 쓰기 금지 - 소속 여부와 관계없이 확인, 운영자는 통과
+func (u *companyOffboardingUsecase) CheckTargetCompanyWritable(userId uint, companyId uint) error {
+	user, err := u.userRepository.GetUserByID(userId)
+	if err != nil {
+		return nil // 사용자 확인은 각 요청에서 처리
+	}
+	if user.Role <= _userEntity.RoleSubAdmin {
+		return nil
+	}
+
+	return u.checkCompanyNotScheduledForPurge(companyId)
+}
+
+func (u *companyOffboardingUsecase) checkCompanyNotScheduledForPurge(companyId uint) error {
+	purgeAt, err := u.companyOffboardingRepository.GetCompanyPurgeScheduledAt(companyId)
+	if err != nil {
+		log.Printf("회사 삭제 예정 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 삭제 예정 조회에 실패했습니다", err)
+	}
+	if purgeAt != nil {
+		return common.NewError(http.StatusForbidden,
+			fmt.Sprintf("삭제 예정인 회사는 읽기 전용입니다 (%s 이후 영구 삭제)", _util.ParseKst(*purgeAt).Format(time.DateTime)), nil)
+	}
+	return nil
+}
+
+// TODO 유예 기간이 지난 회사 영구 삭제 - 한 회사가 실패해도 나머지는 진행
+func (u *companyOffboardingUsecase) PurgeExpiredCompanies() error {
+	companyIds, err := u.companyOffboardingRepository.GetCompanyIdsToPurge(time.Now())
+	if err != nil {
+		return err
+	}
+
+	var failed []uint
+	for _, companyId := range companyIds {
+		if err := u.companyOffboardingRepository.PurgeCompany(companyId); err != nil {
+			log.Printf("회사 영구 삭제에 실패했습니다: 회사 ID %d, %v", companyId, err)
+			failed = append(failed, companyId)
+			continue
+		}
+		log.Printf("회사 영구 삭제 완료: 회사 ID %d", companyId)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("회사 영구 삭제 실패: %v", failed)
+	}
+	return nil
+}
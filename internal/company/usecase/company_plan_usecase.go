@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"link/internal/company/entity"
+	_companyRepo "link/internal/company/repository"
+	_userRepo "link/internal/user/repository"
+
+	"link/pkg/common"
+	"link/pkg/dto/res"
+)
+
+type CompanyPlanUsecase interface {
+	GetCompanyUsage(requestUserId uint, activeCompanyId uint) (*res.GetCompanyUsageResponse, error)
+
+	//TODO 업로드 미들웨어에서 사용 - 활성 회사 기준, 회사 미소속 사용자는 제한 없음
+	CheckStorageQuota(userId uint, activeCompanyId uint, bytes int64) error
+	AddStorageUsage(userId uint, activeCompanyId uint, bytes int64) error
+
+	//TODO 회사 소속 추가 전 요금제 최대 인원 확인
+	CheckMemberQuota(companyId uint) error
+}
+
+type companyPlanUsecase struct {
+	companyRepository _companyRepo.CompanyRepository
+	userRepository    _userRepo.UserRepository
+}
+
+func NewCompanyPlanUsecase(companyRepository _companyRepo.CompanyRepository, userRepository _userRepo.UserRepository) CompanyPlanUsecase {
+	return &companyPlanUsecase{companyRepository: companyRepository, userRepository: userRepository}
+}
+
+// TODO 회사 요금제 사용량 조회 (Role 3,4 또는 VIEW_STATS 권한)
+func (u *companyPlanUsecase) GetCompanyUsage(requestUserId uint, activeCompanyId uint) (*res.GetCompanyUsageResponse, error) {
+	user, err := u.userRepository.GetUserByIDInCompany(requestUserId, activeCompanyId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "사용자 조회에 실패했습니다", err)
+	}
+	allowed, err := user.HasCompanyPermission(entity.PermissionViewStats, u.userRepository.GetCompanyPermissions)
+	if err != nil {
+		log.Printf("회사 역할 권한 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 역할 권한 조회에 실패했습니다", err)
+	}
+	if !allowed {
+		return nil, common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+	}
+	if user.UserProfile.CompanyID == nil {
+		return nil, common.NewError(http.StatusBadRequest, "사용자가 소속된 회사가 없습니다", nil)
+	}
+
+	company, err := u.companyRepository.GetCompanyByID(*user.UserProfile.CompanyID)
+	if err != nil {
+		log.Printf("회사 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 조회에 실패했습니다", err)
+	}
+
+	usage, err := u.companyRepository.GetCompanyUsage(company.ID)
+	if err != nil {
+		log.Printf("회사 사용량 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 사용량 조회에 실패했습니다", err)
+	}
+
+	plan := entity.GetCompanyPlan(company.Grade)
+
+	return &res.GetCompanyUsageResponse{
+		CompanyID:         company.ID,
+		Grade:             plan.Grade,
+		PlanName:          plan.Name,
+		Members:           toCompanyUsageItem(usage.MemberCount, plan.MaxMembers),
+		Projects:          toCompanyUsageItem(usage.ProjectCount, plan.MaxProjects),
+		BoardsPerProject:  toCompanyUsageItem(usage.MaxProjectBoardCount, plan.MaxBoardsPerProject),
+		StorageBytes:      toCompanyUsageItem(usage.StorageUsedBytes, plan.MaxStorageBytes),
+		ChatRetentionDays: plan.ChatRetentionDays,
+	}, nil
+}
+
+// TODO 업로드 전 저장 용량 확인
+func (u *companyPlanUsecase) CheckStorageQuota(userId uint, activeCompanyId uint, bytes int64) error {
+	companyId, err := u.getUserCompanyID(userId, activeCompanyId)
+	if err != nil || companyId == nil {
+		return err
+	}
+
+	company, err := u.companyRepository.GetCompanyByID(*companyId)
+	if err != nil {
+		log.Printf("회사 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 조회에 실패했습니다", err)
+	}
+
+	usage, err := u.companyRepository.GetCompanyUsage(company.ID)
+	if err != nil {
+		log.Printf("회사 사용량 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 사용량 조회에 실패했습니다", err)
+	}
+
+	plan := entity.GetCompanyPlan(company.Grade)
+	if entity.IsPlanLimitExceeded(plan.MaxStorageBytes, usage.StorageUsedBytes, bytes) {
+		return common.NewError(http.StatusPaymentRequired, fmt.Sprintf("%s 요금제의 저장 용량을 초과했습니다. 상위 요금제로 변경해주세요", plan.Name), nil)
+	}
+
+	return nil
+}
+
+// TODO 업로드 완료 후 사용량 반영
+func (u *companyPlanUsecase) AddStorageUsage(userId uint, activeCompanyId uint, bytes int64) error {
+	companyId, err := u.getUserCompanyID(userId, activeCompanyId)
+	if err != nil || companyId == nil {
+		return err
+	}
+
+	if err := u.companyRepository.IncreaseCompanyStorageUsage(*companyId, bytes); err != nil {
+		log.Printf("회사 업로드 용량 갱신에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 업로드 용량 갱신에 실패했습니다", err)
+	}
+
+	return nil
+}
+
+// TODO 회사 소속 추가 전 최대 인원 확인
+func (u *companyPlanUsecase) CheckMemberQuota(companyId uint) error {
+	company, err := u.companyRepository.GetCompanyByID(companyId)
+	if err != nil {
+		log.Printf("회사 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusNotFound, "존재하지 않는 회사입니다", err)
+	}
+
+	usage, err := u.companyRepository.GetCompanyUsage(companyId)
+	if err != nil {
+		log.Printf("회사 사용량 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 사용량 조회에 실패했습니다", err)
+	}
+
+	plan := entity.GetCompanyPlan(company.Grade)
+	if entity.IsPlanLimitExceeded(plan.MaxMembers, usage.MemberCount, 1) {
+		log.Printf("요금제 최대 인원 초과: 회사 ID %d, 요금제 %s", companyId, plan.Name)
+		return common.NewError(http.StatusPaymentRequired, fmt.Sprintf("%s 요금제의 최대 인원(%d명)을 초과했습니다. 상위 요금제로 변경해주세요", plan.Name, plan.MaxMembers), nil)
+	}
+
+	return nil
+}
+
+func (u *companyPlanUsecase) getUserCompanyID(userId uint, activeCompanyId uint) (*uint, error) {
+	user, err := u.userRepository.GetUserByIDInCompany(userId, activeCompanyId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "사용자 조회에 실패했습니다", err)
+	}
+	return user.UserProfile.CompanyID, nil
+}
+
+func toCompanyUsageItem(used int64, limit int64) res.CompanyUsageItemResponse {
+	item := res.CompanyUsageItemResponse{Used: used, Limit: limit}
+	if limit > 0 {
+		item.Remaining = max(limit-used, 0)
+		item.UsageRate = float64(used) / float64(limit) * 100
+	}
+	return item
+}
@@ -0,0 +1,243 @@
+package usecase
+
+import (
+	"log"
+	"net/http"
+
+	"link/internal/company/entity"
+	_companyRepo "link/internal/company/repository"
+	_userRepo "link/internal/user/repository"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+	_util "link/pkg/util"
+)
+
+type CompanyReportingUsecase interface {
+	//TODO 상급자 지정 (Role 3,4 또는 MANAGE_POSITIONS 권한) - 활성 회사 기준
+	UpdateManager(requestUserId uint, companyId uint, targetUserId uint, request req.UpdateManagerRequest) error
+
+	//TODO 같은 회사 구성원 누구나 조회 가능
+	GetDirectReports(requestUserId uint, companyId uint, targetUserId uint) ([]res.ReportingMemberResponse, error)
+	GetManagementChain(requestUserId uint, companyId uint, targetUserId uint) (*res.ManagementChainResponse, error)
+	GetReportingChart(requestUserId uint, companyId uint) (*res.ReportingChartResponse, error)
+}
+
+type companyReportingUsecase struct {
+	companyReportingRepository _companyRepo.CompanyReportingRepository
+	userRepository             _userRepo.UserRepository
+}
+
+func NewCompanyReportingUsecase(companyReportingRepository _companyRepo.CompanyReportingRepository, userRepository _userRepo.UserRepository) CompanyReportingUsecase {
+	return &companyReportingUsecase{companyReportingRepository: companyReportingRepository, userRepository: userRepository}
+}
+
+// TODO 상급자 지정 - 같은 회사 구성원만, 보고 라인 순환 불가
+func (u *companyReportingUsecase) UpdateManager(requestUserId uint, companyId uint, targetUserId uint, request req.UpdateManagerRequest) error {
+	requestUser, err := u.userRepository.GetUserByIDInCompany(requestUserId, companyId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+	}
+	if requestUser.UserProfile == nil || requestUser.UserProfile.CompanyID == nil || *requestUser.UserProfile.CompanyID == 0 {
+		return common.NewError(http.StatusBadRequest, "사용자가 소속된 회사가 없습니다", nil)
+	}
+	companyId = *requestUser.UserProfile.CompanyID
+
+	allowed, err := requestUser.HasCompanyPermission(entity.PermissionManagePositions, u.userRepository.GetCompanyPermissions)
+	if err != nil {
+		log.Printf("회사 역할 권한 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 역할 권한 조회에 실패했습니다", err)
+	}
+	if !allowed {
+		log.Printf("권한이 없는 사용자가 상급자를 지정하려 했습니다: 사용자 ID %d", requestUserId)
+		return common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+	}
+
+	if _, err := u.getReportingMember(companyId, targetUserId); err != nil {
+		return err
+	}
+
+	if request.ManagerID != nil {
+		if *request.ManagerID == targetUserId {
+			return common.NewError(http.StatusBadRequest, "자기 자신을 상급자로 지정할 수 없습니다", nil)
+		}
+		if _, err := u.getReportingMember(companyId, *request.ManagerID); err != nil {
+			return common.NewError(http.StatusBadRequest, "상급자는 같은 회사 구성원이어야 합니다", err)
+		}
+
+		//TODO 지정할 상급자의 상급자 라인에 대상이 있으면 순환
+		chain, err := u.companyReportingRepository.GetManagementChain(companyId, *request.ManagerID)
+		if err != nil {
+			log.Printf("상급자 조회에 실패했습니다: %v", err)
+			return common.NewError(http.StatusInternalServerError, "상급자 조회에 실패했습니다", err)
+		}
+		for _, manager := range chain {
+			if manager.UserID == targetUserId {
+				return common.NewError(http.StatusBadRequest, "하위 구성원을 상급자로 지정할 수 없습니다", nil)
+			}
+		}
+	}
+
+	if err := u.companyReportingRepository.UpdateManager(companyId, targetUserId, request.ManagerID); err != nil {
+		log.Printf("상급자 지정에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "상급자 지정에 실패했습니다", err)
+	}
+
+	return nil
+}
+
+// TODO 직속 부하 목록 - 높은 직급부터
+func (u *companyReportingUsecase) GetDirectReports(requestUserId uint, companyId uint, targetUserId uint) ([]res.ReportingMemberResponse, error) {
+	companyId, err := u.getMemberCompanyID(requestUserId, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.getReportingMember(companyId, targetUserId); err != nil {
+		return nil, err
+	}
+
+	reports, err := u.companyReportingRepository.GetDirectReports(companyId, targetUserId)
+	if err != nil {
+		log.Printf("직속 부하 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "직속 부하 조회에 실패했습니다", err)
+	}
+
+	response := make([]res.ReportingMemberResponse, len(reports))
+	for i, report := range reports {
+		response[i] = toReportingMemberResponse(report)
+	}
+	return response, nil
+}
+
+// TODO 상급자 라인 - 직속 상급자부터 최상위까지
+func (u *companyReportingUsecase) GetManagementChain(requestUserId uint, companyId uint, targetUserId uint) (*res.ManagementChainResponse, error) {
+	companyId, err := u.getMemberCompanyID(requestUserId, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := u.getReportingMember(companyId, targetUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, err := u.companyReportingRepository.GetManagementChain(companyId, targetUserId)
+	if err != nil {
+		log.Printf("상급자 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "상급자 조회에 실패했습니다", err)
+	}
+
+	managers := make([]res.ReportingMemberResponse, len(chain))
+	for i, manager := range chain {
+		managers[i] = toReportingMemberResponse(manager)
+	}
+	return &res.ManagementChainResponse{
+		User:     toReportingMemberResponse(*member),
+		Managers: managers,
+	}, nil
+}
+
+// TODO 보고 라인 조직도 - 같은 상급자 아래에서는 높은 직급부터
+func (u *companyReportingUsecase) GetReportingChart(requestUserId uint, companyId uint) (*res.ReportingChartResponse, error) {
+	companyId, err := u.getMemberCompanyID(requestUserId, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := u.companyReportingRepository.GetReportingMembers(companyId)
+	if err != nil {
+		log.Printf("회사 구성원 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 구성원 조회에 실패했습니다", err)
+	}
+
+	// members는 직급순으로 정렬되어 있으므로 순서대로 붙이면 하위 목록도 직급순
+	nodes := make(map[uint]*res.ReportingChartNodeResponse, len(members))
+	for _, member := range members {
+		nodes[member.UserID] = &res.ReportingChartNodeResponse{
+			ReportingMemberResponse: toReportingMemberResponse(member),
+			DirectReports:           []*res.ReportingChartNodeResponse{},
+		}
+	}
+
+	childIds := make(map[uint][]uint, len(members))
+	rootIds := []uint{}
+	for _, member := range members {
+		if member.ManagerID != nil && *member.ManagerID != member.UserID {
+			if _, ok := nodes[*member.ManagerID]; ok {
+				childIds[*member.ManagerID] = append(childIds[*member.ManagerID], member.UserID)
+				continue
+			}
+		}
+		rootIds = append(rootIds, member.UserID)
+	}
+
+	// 데이터가 꼬여 순환이 생겨도 한 번씩만 붙임
+	visited := make(map[uint]bool, len(members))
+	var attach func(id uint) *res.ReportingChartNodeResponse
+	attach = func(id uint) *res.ReportingChartNodeResponse {
+		visited[id] = true
+		node := nodes[id]
+		for _, childId := range childIds[id] {
+			if visited[childId] {
+				continue
+			}
+			node.DirectReports = append(node.DirectReports, attach(childId))
+		}
+		return node
+	}
+
+	roots := []*res.ReportingChartNodeResponse{}
+	for _, id := range rootIds {
+		roots = append(roots, attach(id))
+	}
+	for _, member := range members {
+		if !visited[member.UserID] {
+			roots = append(roots, attach(member.UserID))
+		}
+	}
+
+	return &res.ReportingChartResponse{
+		CompanyID: companyId,
+		Roots:     roots,
+	}, nil
+}
+
+func (u *companyReportingUsecase) getMemberCompanyID(requestUserId uint, companyId uint) (uint, error) {
+	requestUser, err := u.userRepository.GetUserByIDInCompany(requestUserId, companyId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return 0, common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+	}
+	if requestUser.UserProfile == nil || requestUser.UserProfile.CompanyID == nil || *requestUser.UserProfile.CompanyID == 0 {
+		return 0, common.NewError(http.StatusBadRequest, "사용자가 소속된 회사가 없습니다", nil)
+	}
+	return *requestUser.UserProfile.CompanyID, nil
+}
+
+func (u *companyReportingUsecase) getReportingMember(companyId uint, userId uint) (*entity.ReportingMember, error) {
+	member, err := u.companyReportingRepository.GetReportingMember(companyId, userId)
+	if err != nil {
+		log.Printf("회사 구성원 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 구성원 조회에 실패했습니다", err)
+	}
+	if member == nil {
+		return nil, common.NewError(http.StatusNotFound, "회사 구성원이 아닙니다", nil)
+	}
+	return member, nil
+}
+
+func toReportingMemberResponse(member entity.ReportingMember) res.ReportingMemberResponse {
+	return res.ReportingMemberResponse{
+		ID:           member.UserID,
+		Name:         member.Name,
+		Nickname:     member.Nickname,
+		Email:        member.Email,
+		Image:        _util.SignProfileImageURL(_util.GetValueOrDefault(member.Image, "")),
+		PositionID:   member.PositionID,
+		PositionName: member.PositionName,
+		PositionRank: member.PositionRank,
+		ManagerID:    member.ManagerID,
+	}
+}
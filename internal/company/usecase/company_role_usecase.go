@@ -0,0 +1,274 @@
+package usecase
+
+import (
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"link/internal/company/entity"
+	_companyRepo "link/internal/company/repository"
+	_userEntity "link/internal/user/entity"
+	_userRepo "link/internal/user/repository"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+	_util "link/pkg/util"
+)
+
+type CompanyRoleUsecase interface {
+	//TODO 회사 관리자 (Role 3,4) - 활성 회사 기준
+	CreateCompanyRole(requestUserId uint, companyId uint, request req.CompanyRoleRequest) (*res.CompanyRoleResponse, error)
+	GetCompanyRoles(requestUserId uint, companyId uint) ([]res.CompanyRoleResponse, error)
+	UpdateCompanyRole(requestUserId uint, companyId uint, roleId uint, request req.CompanyRoleRequest) (*res.CompanyRoleResponse, error)
+	DeleteCompanyRole(requestUserId uint, companyId uint, roleId uint) error
+	AssignCompanyRole(requestUserId uint, companyId uint, targetUserId uint, request req.AssignCompanyRoleRequest) error
+
+	//TODO 활성 회사에서 내 권한 확인
+	GetMyCompanyPermissions(requestUserId uint, companyId uint) (*res.CompanyPermissionsResponse, error)
+}
+
+type companyRoleUsecase struct {
+	companyRoleRepository _companyRepo.CompanyRoleRepository
+	userRepository        _userRepo.UserRepository
+}
+
+func NewCompanyRoleUsecase(companyRoleRepository _companyRepo.CompanyRoleRepository, userRepository _userRepo.UserRepository) CompanyRoleUsecase {
+	return &companyRoleUsecase{companyRoleRepository: companyRoleRepository, userRepository: userRepository}
+}
+
+// TODO 회사 역할 생성
+func (u *companyRoleUsecase) CreateCompanyRole(requestUserId uint, companyId uint, request req.CompanyRoleRequest) (*res.CompanyRoleResponse, error) {
+	companyId, err := u.getRoleManager(requestUserId, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := u.toCompanyRole(companyId, 0, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.companyRoleRepository.CreateCompanyRole(role); err != nil {
+		log.Printf("회사 역할 생성에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 역할 생성에 실패했습니다", err)
+	}
+
+	response := toCompanyRoleResponse(*role)
+	return &response, nil
+}
+
+// TODO 회사 역할 목록
+func (u *companyRoleUsecase) GetCompanyRoles(requestUserId uint, companyId uint) ([]res.CompanyRoleResponse, error) {
+	companyId, err := u.getRoleManager(requestUserId, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := u.companyRoleRepository.GetCompanyRolesByCompany(companyId)
+	if err != nil {
+		log.Printf("회사 역할 목록 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 역할 목록 조회에 실패했습니다", err)
+	}
+
+	response := make([]res.CompanyRoleResponse, len(roles))
+	for i, role := range roles {
+		response[i] = toCompanyRoleResponse(role)
+	}
+	return response, nil
+}
+
+// TODO 회사 역할 수정 - 권한 목록은 요청한 목록으로 교체
+func (u *companyRoleUsecase) UpdateCompanyRole(requestUserId uint, companyId uint, roleId uint, request req.CompanyRoleRequest) (*res.CompanyRoleResponse, error) {
+	companyId, err := u.getRoleManager(requestUserId, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := u.getCompanyRole(companyId, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := u.toCompanyRole(companyId, roleId, request)
+	if err != nil {
+		return nil, err
+	}
+	role.ID = roleId
+
+	if err := u.companyRoleRepository.UpdateCompanyRole(role); err != nil {
+		log.Printf("회사 역할 수정에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 역할 수정에 실패했습니다", err)
+	}
+
+	role.MemberCount = existing.MemberCount
+	role.CreatedAt = existing.CreatedAt
+	role.UpdatedAt = time.Now()
+	response := toCompanyRoleResponse(*role)
+	return &response, nil
+}
+
+// TODO 회사 역할 삭제 - 역할을 부여받은 사용자는 역할이 해제됨
+func (u *companyRoleUsecase) DeleteCompanyRole(requestUserId uint, companyId uint, roleId uint) error {
+	companyId, err := u.getRoleManager(requestUserId, companyId)
+	if err != nil {
+		return err
+	}
+
+	if _, err := u.getCompanyRole(companyId, roleId); err != nil {
+		return err
+	}
+
+	if err := u.companyRoleRepository.DeleteCompanyRole(roleId); err != nil {
+		log.Printf("회사 역할 삭제에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 역할 삭제에 실패했습니다", err)
+	}
+	return nil
+}
+
+// TODO 회사 소속 사용자에게 역할 부여 또는 해제
+func (u *companyRoleUsecase) AssignCompanyRole(requestUserId uint, companyId uint, targetUserId uint, request req.AssignCompanyRoleRequest) error {
+	companyId, err := u.getRoleManager(requestUserId, companyId)
+	if err != nil {
+		return err
+	}
+
+	if request.RoleID != nil {
+		if _, err := u.getCompanyRole(companyId, *request.RoleID); err != nil {
+			return err
+		}
+	}
+
+	membership, err := u.userRepository.GetCompanyMembership(targetUserId, companyId)
+	if err != nil {
+		log.Printf("회사 소속 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 소속 조회에 실패했습니다", err)
+	}
+	if membership == nil {
+		return common.NewError(http.StatusNotFound, "회사에 소속되지 않은 사용자입니다", nil)
+	}
+
+	if err := u.companyRoleRepository.AssignCompanyRole(targetUserId, companyId, request.RoleID); err != nil {
+		log.Printf("회사 역할 부여에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 역할 부여에 실패했습니다", err)
+	}
+	return nil
+}
+
+// TODO 내 권한 - 회사 관리자는 모든 권한
+func (u *companyRoleUsecase) GetMyCompanyPermissions(requestUserId uint, companyId uint) (*res.CompanyPermissionsResponse, error) {
+	user, err := u.userRepository.GetUserByIDInCompany(requestUserId, companyId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+	}
+	if user.UserProfile == nil || user.UserProfile.CompanyID == nil {
+		return nil, common.NewError(http.StatusBadRequest, "사용자가 소속된 회사가 없습니다", nil)
+	}
+	companyId = *user.UserProfile.CompanyID
+
+	response := &res.CompanyPermissionsResponse{
+		CompanyID:   companyId,
+		Role:        uint(user.Role),
+		Permissions: []string{},
+	}
+
+	membership, err := u.userRepository.GetCompanyMembership(requestUserId, companyId)
+	if err != nil {
+		log.Printf("회사 소속 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 소속 조회에 실패했습니다", err)
+	}
+	if membership != nil {
+		response.CustomRoleID = membership.CustomRoleID
+		response.CustomRoleName = membership.CustomRoleName
+	}
+
+	if user.Role <= _userEntity.RoleCompanySubManager {
+		response.Permissions = append(response.Permissions, entity.CompanyPermissions...)
+		return response, nil
+	}
+
+	permissions, err := u.userRepository.GetCompanyPermissions(requestUserId, companyId)
+	if err != nil {
+		log.Printf("회사 역할 권한 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 역할 권한 조회에 실패했습니다", err)
+	}
+	response.Permissions = append(response.Permissions, permissions...)
+	return response, nil
+}
+
+func (u *companyRoleUsecase) toCompanyRole(companyId uint, roleId uint, request req.CompanyRoleRequest) (*entity.CompanyRole, error) {
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		return nil, common.NewError(http.StatusBadRequest, "역할 이름을 입력해주세요", nil)
+	}
+
+	exists, err := u.companyRoleRepository.ExistsCompanyRoleName(companyId, name, roleId)
+	if err != nil {
+		log.Printf("회사 역할 이름 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 역할 이름 조회에 실패했습니다", err)
+	}
+	if exists {
+		return nil, common.NewError(http.StatusConflict, "이미 존재하는 역할 이름입니다", nil)
+	}
+
+	permissions := make([]string, 0, len(request.Permissions))
+	for _, permission := range request.Permissions {
+		permission = strings.ToUpper(strings.TrimSpace(permission))
+		if !entity.IsCompanyPermission(permission) {
+			return nil, common.NewError(http.StatusBadRequest, "유효하지 않은 권한입니다: "+permission, nil)
+		}
+		if !slices.Contains(permissions, permission) {
+			permissions = append(permissions, permission)
+		}
+	}
+
+	return &entity.CompanyRole{
+		CompanyID:   companyId,
+		Name:        name,
+		Description: strings.TrimSpace(request.Description),
+		Permissions: permissions,
+	}, nil
+}
+
+func (u *companyRoleUsecase) getCompanyRole(companyId uint, roleId uint) (*entity.CompanyRole, error) {
+	role, err := u.companyRoleRepository.GetCompanyRoleByID(roleId)
+	if err != nil || role.CompanyID != companyId {
+		log.Printf("회사 역할 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 역할입니다", err)
+	}
+	return role, nil
+}
+
+// 회사 역할 관리는 회사 관리자만 - 회사 역할로는 역할을 관리할 수 없음
+func (u *companyRoleUsecase) getRoleManager(requestUserId uint, companyId uint) (uint, error) {
+	requestUser, err := u.userRepository.GetUserByIDInCompany(requestUserId, companyId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return 0, common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+	}
+
+	if requestUser.UserProfile == nil || requestUser.UserProfile.CompanyID == nil || *requestUser.UserProfile.CompanyID == 0 {
+		return 0, common.NewError(http.StatusBadRequest, "사용자가 소속된 회사가 없습니다", nil)
+	}
+
+	if requestUser.Role > _userEntity.RoleCompanySubManager {
+		log.Printf("권한이 없는 사용자가 회사 역할을 관리하려 했습니다: 사용자 ID %d", requestUserId)
+		return 0, common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+	}
+
+	return *requestUser.UserProfile.CompanyID, nil
+}
+
+func toCompanyRoleResponse(role entity.CompanyRole) res.CompanyRoleResponse {
+	return res.CompanyRoleResponse{
+		ID:          role.ID,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		MemberCount: role.MemberCount,
+		CreatedAt:   _util.ParseKst(role.CreatedAt).Format(time.DateTime),
+		UpdatedAt:   _util.ParseKst(role.UpdatedAt).Format(time.DateTime),
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"link/internal/company/entity"
 	_companyRepo "link/internal/company/repository"
+	_departmentRepo "link/internal/department/repository"
 	_userEntity "link/internal/user/entity"
 	_userRepo "link/internal/user/repository"
 
@@ -23,22 +24,26 @@ type CompanyUsecase interface {
 	SearchCompany(companyName string) ([]res.GetCompanyInfoResponse, error)
 
 	AddUserToCompany(requestUserId uint, userId uint, companyId uint) error
-	GetOrganizationByCompany(requestUserId uint) (*res.OrganizationResponse, error)
+	GetOrganizationByCompany(requestUserId uint, activeCompanyId uint) (*res.OrganizationResponse, error)
+	GetOrganizationTree(requestUserId uint, activeCompanyId uint) (*res.OrganizationTreeResponse, error)
 
 	CreateCompanyPosition(requestUserId uint, companyId uint, request req.CompanyPositionRequest) error
-	GetCompanyPositionList(requestUserId uint) ([]res.GetCompanyPositionResponse, error)
-	GetCompanyPositionDetail(requestUserId uint, positionId uint) (*res.GetCompanyPositionResponse, error)
-	DeleteCompanyPosition(requestUserId uint, positionId uint) error
-	UpdateCompanyPosition(requestUserId uint, positionId uint, request req.UpdateCompanyPositionRequest) error
+	GetCompanyPositionList(requestUserId uint, activeCompanyId uint) ([]res.GetCompanyPositionResponse, error)
+	GetCompanyPositionDetail(requestUserId uint, activeCompanyId uint, positionId uint) (*res.GetCompanyPositionResponse, error)
+	DeleteCompanyPosition(requestUserId uint, activeCompanyId uint, positionId uint) error
+	UpdateCompanyPosition(requestUserId uint, activeCompanyId uint, positionId uint, request req.UpdateCompanyPositionRequest) error
 }
 
 type companyUsecase struct {
-	companyRepository _companyRepo.CompanyRepository
-	userRepository    _userRepo.UserRepository
+	companyRepository            _companyRepo.CompanyRepository
+	userRepository               _userRepo.UserRepository
+	departmentRepository         _departmentRepo.DepartmentRepository
+	companyEmailDomainRepository _companyRepo.CompanyEmailDomainRepository
+	companyPlanUsecase           CompanyPlanUsecase
 }
 
-func NewCompanyUsecase(companyRepository _companyRepo.CompanyRepository, userRepository _userRepo.UserRepository) CompanyUsecase {
-	return &companyUsecase{companyRepository: companyRepository, userRepository: userRepository}
+func NewCompanyUsecase(companyRepository _companyRepo.CompanyRepository, userRepository _userRepo.UserRepository, departmentRepository _departmentRepo.DepartmentRepository, companyEmailDomainRepository _companyRepo.CompanyEmailDomainRepository, companyPlanUsecase CompanyPlanUsecase) CompanyUsecase {
+	return &companyUsecase{companyRepository: companyRepository, userRepository: userRepository, departmentRepository: departmentRepository, companyEmailDomainRepository: companyEmailDomainRepository, companyPlanUsecase: companyPlanUsecase}
 }
 
 // TODO 회사 전체 목록 조회
@@ -84,6 +89,9 @@ func (u *companyUsecase) GetCompanyInfo(id uint) (*res.GetCompanyInfoResponse, e
 		RepresentativeEmail:   company.RepresentativeEmail,
 		RepresentativeAddress: company.RepresentativeAddress,
 	}
+	if company.PurgeScheduledAt != nil {
+		response.PurgeScheduledAt = _util.ParseKst(*company.PurgeScheduledAt).Format(time.DateTime)
+	}
 
 	return response, nil
 }
@@ -114,44 +122,104 @@ func (u *companyUsecase) SearchCompany(companyName string) ([]res.GetCompanyInfo
 // TODO 회사에 사용자 추가
 func (u *companyUsecase) AddUserToCompany(requestUserId uint, userId uint, companyId uint) error {
 	//TODO requestUserId의 Role이 3이상이여야하고 3이라면, 자기 회사만 가능
+	//TODO 본인을 추가하는 경우는 확인된 이메일의 도메인을 AUTO_JOIN 정책으로 인증한 회사에만 가능
 
-	adminUser, err := u.userRepository.GetUserByID(requestUserId)
+	user, err := u.userRepository.GetUserByID(userId)
 	if err != nil {
 		return common.NewError(http.StatusInternalServerError, "서버 에러", err)
 	}
-	if adminUser.Role > _userEntity.RoleCompanySubManager {
-		log.Println("권한이 없습니다")
-		return common.NewError(http.StatusForbidden, "권한이 없습니다", err)
+
+	//TODO 다른 회사 소속 여부와 관계없이 같은 회사에 중복 소속만 막음
+	membership, err := u.userRepository.GetCompanyMembership(*user.ID, companyId)
+	if err != nil {
+		return common.NewError(http.StatusInternalServerError, "서버 에러", err)
+	}
+	if membership != nil {
+		log.Println("이미 회사에 소속된 사용자입니다")
+		return common.NewError(http.StatusBadRequest, "이미 회사에 소속된 사용자입니다", nil)
 	}
 
-	user, err := u.userRepository.GetUserByID(userId)
+	if requestUserId == userId {
+		if err := u.checkEmailDomainJoin(user, companyId); err != nil {
+			return err
+		}
+	} else {
+		// 추가하려는 회사 기준 역할로 확인
+		adminUser, err := u.userRepository.GetUserByIDInCompany(requestUserId, companyId)
+		if err != nil {
+			return common.NewError(http.StatusInternalServerError, "서버 에러", err)
+		}
+		//TODO 회사 관리자 또는 INVITE_MEMBERS 권한이 있는 회사 역할
+		allowed, err := adminUser.HasCompanyPermission(entity.PermissionInviteMembers, u.userRepository.GetCompanyPermissions)
+		if err != nil {
+			return common.NewError(http.StatusInternalServerError, "서버 에러", err)
+		}
+		if !allowed {
+			log.Println("권한이 없습니다")
+			return common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+		}
+
+		//TODO 만약에 Role이 3이라면 자기 회사만 사용자 추가 가능
+		if adminUser.Role > _userEntity.RoleSubAdmin && (adminUser.UserProfile.CompanyID == nil || *adminUser.UserProfile.CompanyID != companyId) {
+			log.Println("권한이 없습니다")
+			return common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+		}
+	}
+
+	//TODO 요금제 최대 인원 확인
+	if err := u.companyPlanUsecase.CheckMemberQuota(companyId); err != nil {
+		return err
+	}
+
+	//TODO 회사 소속 추가 - 대표 회사가 없으면 대표 회사로 지정됨
+	err = u.userRepository.CreateCompanyMembership(&_userEntity.CompanyMembership{
+		UserID:    userId,
+		CompanyID: companyId,
+		Role:      _userEntity.RoleUser,
+	})
 	if err != nil {
 		return common.NewError(http.StatusInternalServerError, "서버 에러", err)
 	}
 
-	if user.UserProfile.CompanyID != nil {
-		log.Println("이미 회사에 소속된 사용자입니다")
-		return common.NewError(http.StatusBadRequest, "이미 회사에 소속된 사용자입니다", err)
+	return nil
+}
+
+// 확인된 사용자 이메일의 도메인을 해당 회사가 인증했고 가입 정책이 자동 가입인지 확인
+func (u *companyUsecase) checkEmailDomainJoin(user *_userEntity.User, companyId uint) error {
+	email := _util.GetValueOrDefault(user.Email, "")
+	domain := entity.EmailDomainOf(email)
+	if domain == "" {
+		return common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
 	}
 
-	//TODO 만약에 Role이 3이라면 자기 회사만 사용자 추가 가능
-	if *adminUser.UserProfile.CompanyID != companyId && adminUser.Role > _userEntity.RoleCompanySubManager {
-		log.Println("권한이 없습니다")
-		return common.NewError(http.StatusForbidden, "권한이 없습니다", err)
+	verified, err := u.userRepository.IsEmailVerified(*user.ID, email)
+	if err != nil {
+		return common.NewError(http.StatusInternalServerError, "서버 에러", err)
+	}
+	if !verified {
+		return common.NewError(http.StatusForbidden, "이메일 확인 후 가입할 수 있습니다", nil)
 	}
 
-	//TODO 사용자 companyId 업데이트
-	err = u.userRepository.UpdateUser(userId, nil, map[string]interface{}{"company_id": companyId})
+	emailDomain, err := u.companyEmailDomainRepository.GetVerifiedCompanyEmailDomain(domain)
 	if err != nil {
 		return common.NewError(http.StatusInternalServerError, "서버 에러", err)
 	}
+	if emailDomain == nil || emailDomain.CompanyID != companyId {
+		log.Printf("이메일 도메인이 등록되지 않은 회사에 가입하려 했습니다: 사용자 ID %d, 회사 ID %d", *user.ID, companyId)
+		return common.NewError(http.StatusForbidden, "이메일 도메인으로 가입할 수 없는 회사입니다", nil)
+	}
 
+	//TODO 가입 제안(SUGGEST)은 회사 관리자의 초대로만 가입
+	if emailDomain.JoinPolicy != entity.EmailDomainJoinAuto {
+		log.Printf("가입 제안 정책 회사에 직접 가입하려 했습니다: 사용자 ID %d, 회사 ID %d", *user.ID, companyId)
+		return common.NewError(http.StatusForbidden, "회사 관리자의 초대가 필요합니다", nil)
+	}
 	return nil
 }
 
 // TODO 회사 조직도 조회
-func (u *companyUsecase) GetOrganizationByCompany(requestUserId uint) (*res.OrganizationResponse, error) {
-	user, err := u.userRepository.GetUserByID(requestUserId)
+func (u *companyUsecase) GetOrganizationByCompany(requestUserId uint, activeCompanyId uint) (*res.OrganizationResponse, error) {
+	user, err := u.userRepository.GetUserByIDInCompany(requestUserId, activeCompanyId)
 	if err != nil {
 		fmt.Printf("사용자 조회에 실패했습니다: %v", err)
 		return nil, common.NewError(http.StatusInternalServerError, "사용자 조회에 실패했습니다", err)
@@ -192,7 +260,7 @@ func (u *companyUsecase) GetOrganizationByCompany(requestUserId uint) (*res.Orga
 
 		var image string
 		if user.UserProfile.Image != nil {
-			image = *user.UserProfile.Image
+			image = _util.SignProfileImageURL(*user.UserProfile.Image)
 		}
 
 		// 사용자가 소속된 부서가 있는 경우와 없는 경우 처리
@@ -263,19 +331,171 @@ func (u *companyUsecase) GetOrganizationByCompany(requestUserId uint) (*res.Orga
 
 }
 
+// TODO 계층형 회사 조직도 조회 (본부 -> 부서 -> 팀)
+func (u *companyUsecase) GetOrganizationTree(requestUserId uint, activeCompanyId uint) (*res.OrganizationTreeResponse, error) {
+	user, err := u.userRepository.GetUserByIDInCompany(requestUserId, activeCompanyId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "사용자 조회에 실패했습니다", err)
+	}
+
+	if user.UserProfile.CompanyID == nil {
+		log.Printf("사용자가 소속된 회사가 없습니다: 사용자 ID %d", requestUserId)
+		return nil, common.NewError(http.StatusBadRequest, "사용자가 소속된 회사가 없습니다", nil)
+	}
+
+	companyId := *user.UserProfile.CompanyID
+	companyName := ""
+	if user.UserProfile.Company != nil {
+		companyName, _ = (*user.UserProfile.Company)["name"].(string)
+	}
+
+	departments, err := u.departmentRepository.GetDepartments(companyId)
+	if err != nil {
+		log.Printf("부서 목록 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "부서 목록 조회에 실패했습니다", err)
+	}
+
+	users, err := u.userRepository.GetUsersByCompany(companyId, nil)
+	if err != nil {
+		log.Printf("회사 사용자 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 사용자 조회에 실패했습니다", err)
+	}
+
+	nodes := make(map[uint]*res.OrganizationTreeNodeResponse, len(departments))
+	for _, department := range departments {
+		nodes[department.ID] = &res.OrganizationTreeNodeResponse{
+			DepartmentId:   department.ID,
+			DepartmentName: department.Name,
+			ParentId:       department.ParentID,
+			Members:        []res.GetOrganizationUserInfoResponse{},
+			Children:       []*res.OrganizationTreeNodeResponse{},
+		}
+	}
+
+	userInfos := make(map[uint]res.GetOrganizationUserInfoResponse, len(users))
+	memberIds := make(map[uint]map[uint]struct{}, len(departments))
+	unassignedUsers := []res.GetOrganizationUserInfoResponse{}
+
+	for _, user := range users {
+		info := toOrganizationUserInfo(user)
+		userInfos[info.ID] = info
+
+		assigned := false
+		for _, dept := range user.UserProfile.Departments {
+			deptID, ok := (*dept)["id"].(uint)
+			if !ok {
+				continue
+			}
+			node, exists := nodes[deptID]
+			if !exists {
+				continue
+			}
+			node.Members = append(node.Members, info)
+			if memberIds[deptID] == nil {
+				memberIds[deptID] = make(map[uint]struct{})
+			}
+			memberIds[deptID][info.ID] = struct{}{}
+			assigned = true
+		}
+
+		if !assigned {
+			unassignedUsers = append(unassignedUsers, info)
+		}
+	}
+
+	// 트리 구성 - 상위 부서가 없거나 다른 회사 부서면 최상위로
+	roots := []*res.OrganizationTreeNodeResponse{}
+	for _, department := range departments {
+		node := nodes[department.ID]
+		node.MemberCount = len(node.Members)
+		if department.DepartmentLeaderID != nil {
+			if leader, ok := userInfos[*department.DepartmentLeaderID]; ok {
+				node.Leader = &leader
+			}
+		}
+
+		if department.ParentID != nil {
+			if parent, ok := nodes[*department.ParentID]; ok && parent != node {
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+
+	// 하위 부서 포함 인원 (여러 부서에 속한 사용자는 한 번만)
+	visited := make(map[uint]bool, len(nodes))
+	var countMembers func(node *res.OrganizationTreeNodeResponse) map[uint]struct{}
+	countMembers = func(node *res.OrganizationTreeNodeResponse) map[uint]struct{} {
+		visited[node.DepartmentId] = true
+		members := make(map[uint]struct{}, len(memberIds[node.DepartmentId]))
+		for id := range memberIds[node.DepartmentId] {
+			members[id] = struct{}{}
+		}
+		for _, child := range node.Children {
+			if visited[child.DepartmentId] {
+				continue
+			}
+			for id := range countMembers(child) {
+				members[id] = struct{}{}
+			}
+		}
+		node.TotalMemberCount = len(members)
+		return members
+	}
+	for _, root := range roots {
+		countMembers(root)
+	}
+
+	return &res.OrganizationTreeResponse{
+		CompanyId:       companyId,
+		CompanyName:     companyName,
+		Departments:     roots,
+		UnassignedUsers: unassignedUsers,
+	}, nil
+}
+
+func toOrganizationUserInfo(user _userEntity.User) res.GetOrganizationUserInfoResponse {
+	info := res.GetOrganizationUserInfoResponse{
+		ID:        _util.GetValueOrDefault(user.ID, 0),
+		Email:     _util.GetValueOrDefault(user.Email, ""),
+		Name:      _util.GetValueOrDefault(user.Name, ""),
+		Phone:     _util.GetValueOrDefault(user.Phone, ""),
+		Nickname:  _util.GetValueOrDefault(user.Nickname, ""),
+		Role:      uint(user.Role),
+		EntryDate: user.UserProfile.EntryDate,
+	}
+
+	if user.UserProfile.Image != nil {
+		info.Image = _util.SignProfileImageURL(*user.UserProfile.Image)
+	}
+	if user.UserProfile.PositionId != nil {
+		info.PositionId = *user.UserProfile.PositionId
+	}
+	if user.UserProfile.Position != nil {
+		if posName, ok := (*user.UserProfile.Position)["name"].(string); ok {
+			info.PositionName = posName
+		}
+	}
+
+	return info
+}
+
 // TODO 본인 회사 직책 생성 (Role 3,4)
 func (u *companyUsecase) CreateCompanyPosition(requestUserId uint, companyId uint, request req.CompanyPositionRequest) error {
 
-	requestUser, err := u.userRepository.GetUserByID(requestUserId)
+	//TODO 직책을 만들 회사 기준 소속, 권한 확인
+	requestUser, err := u.userRepository.GetUserByIDInCompany(requestUserId, companyId)
 	if err != nil {
 		return common.NewError(http.StatusInternalServerError, "서버 에러", err)
 	}
 
-	if requestUser.Role > _userEntity.RoleCompanySubManager {
-		return common.NewError(http.StatusForbidden, "관리자 권한이 없습니다", nil)
+	if err := u.checkPositionPermission(requestUser); err != nil {
+		return err
 	}
 
-	if requestUser.Role >= _userEntity.RoleCompanyManager && requestUser.Role <= _userEntity.RoleCompanySubManager && *requestUser.UserProfile.CompanyID != companyId {
+	if requestUser.Role > _userEntity.RoleSubAdmin && (requestUser.UserProfile.CompanyID == nil || *requestUser.UserProfile.CompanyID != companyId) {
 		return common.NewError(http.StatusBadRequest, "본인 회사 직책만 생성 가능합니다", nil)
 	}
 
@@ -291,6 +511,7 @@ func (u *companyUsecase) CreateCompanyPosition(requestUserId uint, companyId uin
 	companyPosition := &entity.Position{
 		CompanyID: company.ID,
 		Name:      request.Name,
+		Rank:      request.Rank,
 	}
 
 	err = u.companyRepository.CreateCompanyPosition(companyPosition)
@@ -303,8 +524,8 @@ func (u *companyUsecase) CreateCompanyPosition(requestUserId uint, companyId uin
 }
 
 // TODO 회사 직책 리스트 조회
-func (u *companyUsecase) GetCompanyPositionList(requestUserId uint) ([]res.GetCompanyPositionResponse, error) {
-	user, err := u.userRepository.GetUserByID(requestUserId)
+func (u *companyUsecase) GetCompanyPositionList(requestUserId uint, activeCompanyId uint) ([]res.GetCompanyPositionResponse, error) {
+	user, err := u.userRepository.GetUserByIDInCompany(requestUserId, activeCompanyId)
 	if err != nil {
 		return nil, common.NewError(http.StatusBadRequest, "존재 하지 않는 사용자 입니다", err)
 	}
@@ -324,6 +545,7 @@ func (u *companyUsecase) GetCompanyPositionList(requestUserId uint) ([]res.GetCo
 			ID:        position.ID,
 			Name:      position.Name,
 			CompanyID: position.CompanyID,
+			Rank:      position.Rank,
 			CreatedAt: _util.ParseKst(position.CreatedAt).Format(time.DateTime),
 			UpdatedAt: _util.ParseKst(position.UpdatedAt).Format(time.DateTime),
 		}
@@ -334,12 +556,16 @@ func (u *companyUsecase) GetCompanyPositionList(requestUserId uint) ([]res.GetCo
 }
 
 // TODO 회사 직책 상세 보기
-func (u *companyUsecase) GetCompanyPositionDetail(requestUserId uint, positionId uint) (*res.GetCompanyPositionResponse, error) {
-	user, err := u.userRepository.GetUserByID(requestUserId)
+func (u *companyUsecase) GetCompanyPositionDetail(requestUserId uint, activeCompanyId uint, positionId uint) (*res.GetCompanyPositionResponse, error) {
+	user, err := u.userRepository.GetUserByIDInCompany(requestUserId, activeCompanyId)
 	if err != nil {
 		return nil, common.NewError(http.StatusBadRequest, "존재 하지 않는 사용자 입니다", err)
 	}
 
+	if user.UserProfile.CompanyID == nil {
+		return nil, common.NewError(http.StatusBadRequest, "회사가 존재하지 않습니다", nil)
+	}
+
 	companyPosition, err := u.companyRepository.GetCompanyPositionByID(positionId)
 	if err != nil {
 		return nil, common.NewError(http.StatusInternalServerError, "서버 에러", err)
@@ -353,20 +579,21 @@ func (u *companyUsecase) GetCompanyPositionDetail(requestUserId uint, positionId
 		ID:        companyPosition.ID,
 		Name:      companyPosition.Name,
 		CompanyID: companyPosition.CompanyID,
+		Rank:      companyPosition.Rank,
 		CreatedAt: _util.ParseKst(companyPosition.CreatedAt).Format(time.DateTime),
 		UpdatedAt: _util.ParseKst(companyPosition.UpdatedAt).Format(time.DateTime),
 	}, nil
 }
 
 // TODO 직책 삭제
-func (u *companyUsecase) DeleteCompanyPosition(requestUserId uint, positionId uint) error {
-	requestUser, err := u.userRepository.GetUserByID(requestUserId)
+func (u *companyUsecase) DeleteCompanyPosition(requestUserId uint, activeCompanyId uint, positionId uint) error {
+	requestUser, err := u.userRepository.GetUserByIDInCompany(requestUserId, activeCompanyId)
 	if err != nil {
 		return common.NewError(http.StatusInternalServerError, "서버 에러", err)
 	}
 
-	if requestUser.Role > _userEntity.RoleCompanySubManager {
-		return common.NewError(http.StatusForbidden, "관리자 권한이 없습니다", nil)
+	if err := u.checkPositionPermission(requestUser); err != nil {
+		return err
 	}
 
 	companyPosition, err := u.companyRepository.GetCompanyPositionByID(positionId)
@@ -374,7 +601,7 @@ func (u *companyUsecase) DeleteCompanyPosition(requestUserId uint, positionId ui
 		return common.NewError(http.StatusNotFound, "직책이 존재하지 않습니다", err)
 	}
 
-	if companyPosition.CompanyID != *requestUser.UserProfile.CompanyID {
+	if requestUser.UserProfile.CompanyID == nil || companyPosition.CompanyID != *requestUser.UserProfile.CompanyID {
 		return common.NewError(http.StatusForbidden, "본인 회사 직책이 아닙니다", nil)
 	}
 
@@ -387,14 +614,14 @@ func (u *companyUsecase) DeleteCompanyPosition(requestUserId uint, positionId ui
 }
 
 // TODO 회사 직책 수정
-func (u *companyUsecase) UpdateCompanyPosition(requestUserId uint, positionId uint, request req.UpdateCompanyPositionRequest) error {
-	requestUser, err := u.userRepository.GetUserByID(requestUserId)
+func (u *companyUsecase) UpdateCompanyPosition(requestUserId uint, activeCompanyId uint, positionId uint, request req.UpdateCompanyPositionRequest) error {
+	requestUser, err := u.userRepository.GetUserByIDInCompany(requestUserId, activeCompanyId)
 	if err != nil {
 		return common.NewError(http.StatusBadRequest, "존재 하지 않는 사용자 입니다", err)
 	}
 
-	if requestUser.Role > _userEntity.RoleCompanySubManager {
-		return common.NewError(http.StatusForbidden, "관리자 권한이 없습니다", nil)
+	if err := u.checkPositionPermission(requestUser); err != nil {
+		return err
 	}
 
 	companyPosition, err := u.companyRepository.GetCompanyPositionByID(positionId)
@@ -402,13 +629,16 @@ func (u *companyUsecase) UpdateCompanyPosition(requestUserId uint, positionId ui
 		return common.NewError(http.StatusNotFound, "직책이 존재하지 않습니다", err)
 	}
 
-	if companyPosition.CompanyID != *requestUser.UserProfile.CompanyID {
+	if requestUser.UserProfile.CompanyID == nil || companyPosition.CompanyID != *requestUser.UserProfile.CompanyID {
 		return common.NewError(http.StatusForbidden, "본인 회사 직책이 아닙니다", nil)
 	}
 
 	updatedPosition := map[string]interface{}{
 		"name": request.Name,
 	}
+	if request.Rank != nil {
+		updatedPosition["rank"] = *request.Rank
+	}
 
 	err = u.companyRepository.UpdateCompanyPosition(positionId, updatedPosition)
 	if err != nil {
@@ -419,3 +649,15 @@ func (u *companyUsecase) UpdateCompanyPosition(requestUserId uint, positionId ui
 }
 
 //TODO 회사 평점 생성 - 리뷰 생성 후 평점 생성
+
+// TODO 회사 관리자 또는 MANAGE_POSITIONS 권한이 있는 회사 역할만 직책 관리
+func (u *companyUsecase) checkPositionPermission(requestUser *_userEntity.User) error {
+	allowed, err := requestUser.HasCompanyPermission(entity.PermissionManagePositions, u.userRepository.GetCompanyPermissions)
+	if err != nil {
+		return common.NewError(http.StatusInternalServerError, "서버 에러", err)
+	}
+	if !allowed {
+		return common.NewError(http.StatusForbidden, "관리자 권한이 없습니다", nil)
+	}
+	return nil
+}
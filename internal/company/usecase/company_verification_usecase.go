@@ -0,0 +1,395 @@
+package usecase
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"link/internal/company/entity"
+	_companyRepo "link/internal/company/repository"
+	_userEntity "link/internal/user/entity"
+	_userRepo "link/internal/user/repository"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+	_nats "link/pkg/nats"
+	_util "link/pkg/util"
+)
+
+// 인증 서류는 static 라우트로 공개하지 않고 다운로드 API로만 제공
+const CompanyDocumentPrefix = "/static/company_documents/"
+
+type CompanyVerificationUsecase interface {
+	//TODO 회사 관리자
+	SubmitCompanyVerification(requestUserId uint, activeCompanyId uint, request req.SubmitCompanyVerificationRequest) (*res.CompanyVerificationResponse, error)
+	GetCompanyVerifications(requestUserId uint, activeCompanyId uint) ([]res.CompanyVerificationResponse, error)
+	GetCompanyVerificationDocument(requestUserId uint, documentId uint) (filePath string, fileName string, err error)
+
+	//TODO 운영자
+	GetCompanyVerificationQueue(requestUserId uint, status string, page int, limit int) (*res.GetCompanyVerificationsResponse, error)
+	ReviewCompanyVerification(requestUserId uint, verificationId uint, request req.ReviewCompanyVerificationRequest) error
+}
+
+type companyVerificationUsecase struct {
+	companyRepository             _companyRepo.CompanyRepository
+	companyVerificationRepository _companyRepo.CompanyVerificationRepository
+	userRepository                _userRepo.UserRepository
+	natsPublisher                 *_nats.NatsPublisher
+}
+
+func NewCompanyVerificationUsecase(
+	companyRepository _companyRepo.CompanyRepository,
+	companyVerificationRepository _companyRepo.CompanyVerificationRepository,
+	userRepository _userRepo.UserRepository,
+	natsPublisher *_nats.NatsPublisher) CompanyVerificationUsecase {
+	return &companyVerificationUsecase{
+		companyRepository:             companyRepository,
+		companyVerificationRepository: companyVerificationRepository,
+		userRepository:                userRepository,
+		natsPublisher:                 natsPublisher,
+	}
+}
+
+// TODO 회사 인증 요청 (Role 3,4) - 사업자등록번호와 사업자등록증 등 서류 제출
+func (u *companyVerificationUsecase) SubmitCompanyVerification(requestUserId uint, activeCompanyId uint, request req.SubmitCompanyVerificationRequest) (*res.CompanyVerificationResponse, error) {
+	requestUser, companyId, err := u.getCompanyManager(requestUserId, activeCompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(request.DocumentUrls) == 0 {
+		return nil, common.NewError(http.StatusBadRequest, "사업자등록 서류를 첨부해주세요", nil)
+	}
+
+	cpNumber, err := _util.NormalizeBusinessNumber(request.CpNumber)
+	if err != nil {
+		return nil, common.NewError(http.StatusBadRequest, err.Error(), err)
+	}
+
+	company, err := u.companyRepository.GetCompanyByID(companyId)
+	if err != nil {
+		log.Printf("회사 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "회사 조회에 실패했습니다", err)
+	}
+	if company.IsVerified {
+		return nil, common.NewError(http.StatusConflict, "이미 인증된 회사입니다", nil)
+	}
+
+	pending, err := u.companyVerificationRepository.GetPendingCompanyVerification(companyId)
+	if err != nil {
+		log.Printf("회사 인증 요청 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 인증 요청 조회에 실패했습니다", err)
+	}
+	if pending != nil {
+		return nil, common.NewError(http.StatusConflict, "이미 검토 중인 인증 요청이 있습니다", nil)
+	}
+
+	exists, err := u.companyVerificationRepository.ExistsCpNumber(cpNumber, companyId)
+	if err != nil {
+		log.Printf("사업자등록번호 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "사업자등록번호 조회에 실패했습니다", err)
+	}
+	if exists {
+		return nil, common.NewError(http.StatusConflict, "다른 회사에 등록된 사업자등록번호입니다", nil)
+	}
+
+	documents := make([]entity.CompanyVerificationDocument, len(request.DocumentUrls))
+	for i, documentUrl := range request.DocumentUrls {
+		documents[i] = entity.CompanyVerificationDocument{FileURL: documentUrl}
+		if i < len(request.DocumentNames) {
+			documents[i].FileName = request.DocumentNames[i]
+		}
+	}
+
+	verification := &entity.CompanyVerification{
+		CompanyID:   companyId,
+		CompanyName: company.CpName,
+		RequesterID: requestUserId,
+		CpNumber:    cpNumber,
+		Documents:   documents,
+	}
+	if requestUser.Name != nil {
+		verification.RequesterName = *requestUser.Name
+	}
+
+	if err := u.companyVerificationRepository.CreateCompanyVerification(verification); err != nil {
+		log.Printf("회사 인증 요청 생성에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 인증 요청에 실패했습니다", err)
+	}
+
+	// 생성 직후에는 서류 ID가 없으므로 다시 조회
+	created, err := u.companyVerificationRepository.GetCompanyVerificationByID(verification.ID)
+	if err != nil {
+		log.Printf("회사 인증 요청 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 인증 요청 조회에 실패했습니다", err)
+	}
+
+	response := toCompanyVerificationResponse(*created)
+	return &response, nil
+}
+
+// TODO 우리 회사 인증 요청 이력 (Role 3,4)
+func (u *companyVerificationUsecase) GetCompanyVerifications(requestUserId uint, activeCompanyId uint) ([]res.CompanyVerificationResponse, error) {
+	_, companyId, err := u.getCompanyManager(requestUserId, activeCompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	verifications, err := u.companyVerificationRepository.GetCompanyVerificationsByCompany(companyId)
+	if err != nil {
+		log.Printf("회사 인증 요청 목록 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 인증 요청 목록 조회에 실패했습니다", err)
+	}
+
+	response := make([]res.CompanyVerificationResponse, len(verifications))
+	for i, verification := range verifications {
+		response[i] = toCompanyVerificationResponse(verification)
+	}
+	return response, nil
+}
+
+// TODO 인증 서류 다운로드 - 운영자 또는 해당 회사 관리자만
+func (u *companyVerificationUsecase) GetCompanyVerificationDocument(requestUserId uint, documentId uint) (string, string, error) {
+	requestUser, err := u.userRepository.GetUserByID(requestUserId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return "", "", common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+	}
+
+	document, err := u.companyVerificationRepository.GetCompanyVerificationDocumentByID(documentId)
+	if err != nil {
+		log.Printf("회사 인증 서류 조회에 실패했습니다: %v", err)
+		return "", "", common.NewError(http.StatusNotFound, "존재하지 않는 서류입니다", err)
+	}
+
+	if requestUser.Role > _userEntity.RoleSubAdmin {
+		verification, err := u.companyVerificationRepository.GetCompanyVerificationByID(document.VerificationID)
+		if err != nil {
+			log.Printf("회사 인증 요청 조회에 실패했습니다: %v", err)
+			return "", "", common.NewError(http.StatusNotFound, "존재하지 않는 서류입니다", err)
+		}
+
+		//TODO 서류를 낸 회사에서의 역할로 확인
+		companyManager, err := u.userRepository.GetUserByIDInCompany(requestUserId, verification.CompanyID)
+		if err != nil {
+			log.Printf("사용자 조회에 실패했습니다: %v", err)
+			return "", "", common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+		}
+		if companyManager.Role > _userEntity.RoleCompanySubManager ||
+			companyManager.UserProfile.CompanyID == nil ||
+			*companyManager.UserProfile.CompanyID != verification.CompanyID {
+			return "", "", common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+		}
+	}
+
+	// 저장된 URL은 업로드 미들웨어가 만든 값이지만 경로 조작은 한 번 더 막음
+	cleaned := path.Clean(document.FileURL)
+	if !strings.HasPrefix(cleaned, CompanyDocumentPrefix) {
+		return "", "", common.NewError(http.StatusNotFound, "존재하지 않는 서류입니다", nil)
+	}
+
+	return "." + cleaned, document.FileName, nil
+}
+
+// TODO 운영자 인증 요청 검토 목록 (Role 1,2) - 기본은 대기중, 오래된 요청부터
+func (u *companyVerificationUsecase) GetCompanyVerificationQueue(requestUserId uint, status string, page int, limit int) (*res.GetCompanyVerificationsResponse, error) {
+	if err := u.checkAdmin(requestUserId); err != nil {
+		return nil, err
+	}
+
+	status = strings.ToUpper(status)
+	if status != entity.CompanyVerificationPending && status != entity.CompanyVerificationApproved && status != entity.CompanyVerificationRejected {
+		return nil, common.NewError(http.StatusBadRequest, "유효하지 않은 status 값입니다", nil)
+	}
+
+	verifications, totalCount, err := u.companyVerificationRepository.GetCompanyVerificationsByStatus(status, page, limit)
+	if err != nil {
+		log.Printf("회사 인증 요청 목록 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 인증 요청 목록 조회에 실패했습니다", err)
+	}
+
+	response := make([]res.CompanyVerificationResponse, len(verifications))
+	for i, verification := range verifications {
+		response[i] = toCompanyVerificationResponse(verification)
+	}
+
+	totalPages := int(math.Ceil(float64(totalCount) / float64(limit)))
+	meta := &res.PaginationMeta{
+		TotalCount: int(totalCount),
+		TotalPages: totalPages,
+		PageSize:   limit,
+	}
+	if page > 1 {
+		meta.PrevPage = page - 1
+	}
+	if page < totalPages {
+		meta.NextPage = page + 1
+	}
+
+	return &res.GetCompanyVerificationsResponse{
+		Verifications: response,
+		Meta:          meta,
+	}, nil
+}
+
+// TODO 운영자 인증 요청 승인/반려 (Role 1,2) - 승인 시 회사 인증 및 Basic 등급
+func (u *companyVerificationUsecase) ReviewCompanyVerification(requestUserId uint, verificationId uint, request req.ReviewCompanyVerificationRequest) error {
+	if err := u.checkAdmin(requestUserId); err != nil {
+		return err
+	}
+
+	reason := strings.TrimSpace(request.Reason)
+	if request.Status == entity.CompanyVerificationRejected && reason == "" {
+		return common.NewError(http.StatusBadRequest, "반려 사유를 입력해주세요", nil)
+	}
+
+	verification, err := u.companyVerificationRepository.GetCompanyVerificationByID(verificationId)
+	if err != nil {
+		log.Printf("회사 인증 요청 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusNotFound, "존재하지 않는 인증 요청입니다", err)
+	}
+	if verification.Status != entity.CompanyVerificationPending {
+		return common.NewError(http.StatusConflict, "이미 처리된 인증 요청입니다", nil)
+	}
+
+	if request.Status == entity.CompanyVerificationApproved {
+		// 검토하는 사이 다른 회사가 같은 번호로 인증되었을 수 있음
+		exists, err := u.companyVerificationRepository.ExistsCpNumber(verification.CpNumber, verification.CompanyID)
+		if err != nil {
+			log.Printf("사업자등록번호 조회에 실패했습니다: %v", err)
+			return common.NewError(http.StatusInternalServerError, "사업자등록번호 조회에 실패했습니다", err)
+		}
+		if exists {
+			return common.NewError(http.StatusConflict, "다른 회사에 등록된 사업자등록번호입니다", nil)
+		}
+	}
+
+	if err := u.companyVerificationRepository.ReviewCompanyVerification(verificationId, requestUserId, request.Status, reason); err != nil {
+		log.Printf("회사 인증 요청 처리에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 인증 요청 처리에 실패했습니다", err)
+	}
+
+	verification.Status = request.Status
+	verification.Reason = reason
+	u.publishVerificationNotification(requestUserId, verification)
+
+	return nil
+}
+
+// 인증 결과를 요청한 회사 관리자에게 알림
+func (u *companyVerificationUsecase) publishVerificationNotification(reviewerId uint, verification *entity.CompanyVerification) {
+	content := fmt.Sprintf("[COMPANY_VERIFICATION] %s 회사 인증이 승인되었습니다", verification.CompanyName)
+	if verification.Status == entity.CompanyVerificationRejected {
+		content = fmt.Sprintf("[COMPANY_VERIFICATION] %s 회사 인증이 반려되었습니다. 사유: %s", verification.CompanyName, verification.Reason)
+	}
+
+	payload := map[string]interface{}{
+		"doc_id":      uuid.New().String(),
+		"sender_id":   reviewerId,
+		"receiver_id": verification.RequesterID,
+		"title":       "COMPANY_VERIFICATION",
+		"content":     content,
+		"alarm_type":  "COMPANY_VERIFICATION",
+		"status":      verification.Status,
+		"is_read":     false,
+		"company_id":  verification.CompanyID,
+		"target_type": "COMPANY",
+		"target_id":   verification.CompanyID,
+		"timestamp":   time.Now(),
+	}
+
+	natsData := map[string]interface{}{
+		"topic":   "link.event.notification.company.verification",
+		"payload": payload,
+	}
+
+	jsonData, err := json.Marshal(natsData)
+	if err != nil {
+		log.Printf("NATS 데이터 직렬화 오류: %v", err)
+		return
+	}
+	wsData, err := json.Marshal(payload)
+	if err != nil {
+		log.Printf("NATS 데이터 직렬화 오류: %v", err)
+		return
+	}
+
+	go func() {
+		if err := u.natsPublisher.PublishEvent("link.event.notification.company.verification", jsonData); err != nil {
+			log.Printf("NATS 메시지 전송 실패: %v", err)
+		}
+		// 실시간 알림 (WsHandler.subscribeToNotifications)
+		if err := u.natsPublisher.PublishEvent("notification.created", wsData); err != nil {
+			log.Printf("NATS 메시지 전송 실패: %v", err)
+		}
+	}()
+}
+
+func (u *companyVerificationUsecase) getCompanyManager(requestUserId uint, activeCompanyId uint) (*_userEntity.User, uint, error) {
+	requestUser, err := u.userRepository.GetUserByIDInCompany(requestUserId, activeCompanyId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return nil, 0, common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+	}
+
+	if requestUser.Role > _userEntity.RoleCompanySubManager {
+		log.Printf("권한이 없는 사용자가 회사 인증을 요청했습니다: 사용자 ID %d", requestUserId)
+		return nil, 0, common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+	}
+
+	if requestUser.UserProfile == nil || requestUser.UserProfile.CompanyID == nil || *requestUser.UserProfile.CompanyID == 0 {
+		return nil, 0, common.NewError(http.StatusBadRequest, "사용자가 소속된 회사가 없습니다", nil)
+	}
+
+	return requestUser, *requestUser.UserProfile.CompanyID, nil
+}
+
+func (u *companyVerificationUsecase) checkAdmin(requestUserId uint) error {
+	adminUser, err := u.userRepository.GetUserByID(requestUserId)
+	if err != nil {
+		log.Printf("관리자 계정 조회 중 오류 발생: %v", err)
+		return common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
+	}
+
+	if adminUser.Role > _userEntity.RoleSubAdmin {
+		log.Printf("권한이 없는 사용자가 회사 인증 요청을 처리하려 했습니다: 요청자 ID %d", requestUserId)
+		return common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+	}
+
+	return nil
+}
+
+func toCompanyVerificationResponse(verification entity.CompanyVerification) res.CompanyVerificationResponse {
+	documents := make([]res.CompanyVerificationDocumentResponse, len(verification.Documents))
+	for i, document := range verification.Documents {
+		documents[i] = res.CompanyVerificationDocumentResponse{
+			ID:          document.ID,
+			FileName:    document.FileName,
+			DownloadURL: fmt.Sprintf("/api/company/verification/document/%d", document.ID),
+		}
+	}
+
+	response := res.CompanyVerificationResponse{
+		ID:            verification.ID,
+		CompanyID:     verification.CompanyID,
+		CompanyName:   verification.CompanyName,
+		RequesterID:   verification.RequesterID,
+		RequesterName: verification.RequesterName,
+		CpNumber:      verification.CpNumber,
+		Status:        verification.Status,
+		Reason:        verification.Reason,
+		Documents:     documents,
+		CreatedAt:     _util.ParseKst(verification.CreatedAt).Format(time.DateTime),
+	}
+	if verification.ReviewedAt != nil {
+		response.ReviewedAt = _util.ParseKst(*verification.ReviewedAt).Format(time.DateTime)
+	}
+	return response
+}
@@ -12,6 +12,7 @@ type Department struct {
 	DepartmentLeader   *map[uint]interface{} `json:"department_leader,omitempty"`
 	CompanyID          uint                  `json:"company_id"`
 	CompanyName        string                `json:"company_name"`
+	ParentID           *uint                 `json:"parent_id,omitempty"`
 	CreatedAt          time.Time             `json:"created_at,omitempty"`
 	UpdatedAt          time.Time             `json:"updated_at,omitempty"`
 }
@@ -10,5 +10,20 @@ type DepartmentRepository interface {
 	UpdateDepartment(companyId uint, departmentID uint, updates map[string]interface{}) error
 	DeleteDepartment(companyId uint, departmentID uint) error
 
+	//TODO 부서 계층
+	GetSubDepartmentIds(companyId uint, departmentID uint) ([]uint, error)
+	MoveDepartment(companyId uint, departmentID uint, parentID *uint) error
+
+	//TODO 부서 통합, 분리
+	ExistsDepartmentName(companyId uint, name string, excludeDepartmentID uint) (bool, error)
+	GetDepartmentUserIds(departmentID uint) ([]uint, error)
+	GetDepartmentPostIds(departmentID uint) ([]uint, error)
+	GetChildDepartmentIds(companyId uint, departmentID uint) ([]uint, error)
+	MergeDepartment(companyId uint, sourceDepartmentID uint, targetDepartmentID uint) error
+	SplitDepartment(companyId uint, sourceDepartmentID uint, department *entity.Department, userIds []uint, postIds []uint) error
+
 	DeleteUserDepartment(userId uint) error
+
+	//TODO 직급 순위 - 부서장 기본값
+	GetTopRankedUserID(companyId uint, userIds []uint) (*uint, error)
 }
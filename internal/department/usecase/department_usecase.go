@@ -1,12 +1,13 @@
 package usecase
 
 import (
+	"fmt"
 	"log"
 	"net/http"
 
+	_companyEntity "link/internal/company/entity"
 	_departmentEntity "link/internal/department/entity"
 	_departmentRepo "link/internal/department/repository"
-	_userEntity "link/internal/user/entity"
 	_userRepo "link/internal/user/repository"
 	"link/pkg/common"
 	"link/pkg/dto/req"
@@ -14,11 +15,17 @@ import (
 )
 
 type DepartmentUsecase interface {
-	CreateDepartment(request *_departmentEntity.Department, requestUserId uint) (*_departmentEntity.Department, error)
-	GetDepartments(requestUserId uint) ([]res.DepartmentListResponse, error)
-	GetDepartment(requestUserId uint, departmentID uint) (*_departmentEntity.Department, error)
-	UpdateDepartment(requestUserId uint, targetDepartmentID uint, request req.UpdateDepartmentRequest) (*_departmentEntity.Department, error)
-	DeleteDepartment(requestUserId uint, departmentID uint) error
+	CreateDepartment(request *_departmentEntity.Department, requestUserId uint, activeCompanyId uint) (*_departmentEntity.Department, error)
+	GetDepartments(requestUserId uint, activeCompanyId uint) ([]res.DepartmentListResponse, error)
+	GetDepartment(requestUserId uint, activeCompanyId uint, departmentID uint) (*_departmentEntity.Department, error)
+	UpdateDepartment(requestUserId uint, activeCompanyId uint, targetDepartmentID uint, request req.UpdateDepartmentRequest) (*_departmentEntity.Department, error)
+	DeleteDepartment(requestUserId uint, activeCompanyId uint, departmentID uint) error
+	MoveDepartment(requestUserId uint, activeCompanyId uint, departmentID uint, request req.MoveDepartmentRequest) error
+
+	PreviewMergeDepartment(requestUserId uint, activeCompanyId uint, departmentID uint, request req.MergeDepartmentRequest) (*res.DepartmentReorganizeResponse, error)
+	MergeDepartment(requestUserId uint, activeCompanyId uint, departmentID uint, request req.MergeDepartmentRequest) (*res.DepartmentReorganizeResponse, error)
+	PreviewSplitDepartment(requestUserId uint, activeCompanyId uint, departmentID uint, request req.SplitDepartmentRequest) (*res.DepartmentReorganizeResponse, error)
+	SplitDepartment(requestUserId uint, activeCompanyId uint, departmentID uint, request req.SplitDepartmentRequest) (*res.DepartmentReorganizeResponse, error)
 }
 
 type departmentUsecase struct {
@@ -32,18 +39,23 @@ func NewDepartmentUsecase(departmentRepository _departmentRepo.DepartmentReposit
 
 // TODO 모두 회사 관리자가 해야함
 // TODO 부서 생성
-func (du *departmentUsecase) CreateDepartment(department *_departmentEntity.Department, requestUserId uint) (*_departmentEntity.Department, error) {
+func (du *departmentUsecase) CreateDepartment(department *_departmentEntity.Department, requestUserId uint, activeCompanyId uint) (*_departmentEntity.Department, error) {
 
 	//TODO 요청하는 계정이 관리자 계정인지 확인
-	requestUser, err := du.userRepository.GetUserByID(requestUserId)
+	requestUser, err := du.userRepository.GetUserByIDInCompany(requestUserId, activeCompanyId)
 	if err != nil {
 		log.Printf("사용자 조회에 실패했습니다: %v", err)
 		return nil, common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
 	}
 
-	if requestUser.Role > _userEntity.RoleCompanySubManager {
+	allowed, err := requestUser.HasCompanyPermission(_companyEntity.PermissionManageDepartments, du.userRepository.GetCompanyPermissions)
+	if err != nil {
+		log.Printf("회사 역할 권한 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 역할 권한 조회에 실패했습니다", err)
+	}
+	if !allowed {
 		log.Printf("권한이 없는 사용자가 부서를 생성하려 했습니다: 사용자 ID %d", requestUserId)
-		return nil, common.NewError(http.StatusForbidden, "권한이 없습니다", err)
+		return nil, common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
 	}
 
 	if requestUser.UserProfile.CompanyID == nil {
@@ -52,6 +64,24 @@ func (du *departmentUsecase) CreateDepartment(department *_departmentEntity.Depa
 	}
 	department.CompanyID = *requestUser.UserProfile.CompanyID
 
+	//TODO 상위 부서는 같은 회사 부서여야함
+	if department.ParentID != nil {
+		if _, err := du.departmentRepository.GetDepartmentByID(department.CompanyID, *department.ParentID); err != nil {
+			log.Printf("상위 부서 조회에 실패했습니다: %v", err)
+			return nil, common.NewError(http.StatusBadRequest, "존재하지 않는 상위 부서입니다", err)
+		}
+	}
+
+	//TODO 부서 이름은 회사 내에서 중복 불가
+	exists, err := du.departmentRepository.ExistsDepartmentName(department.CompanyID, department.Name, 0)
+	if err != nil {
+		log.Printf("부서 이름 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "부서 이름 조회에 실패했습니다", err)
+	}
+	if exists {
+		return nil, common.NewError(http.StatusConflict, "이미 존재하는 부서 이름입니다", nil)
+	}
+
 	if err := du.departmentRepository.CreateDepartment(department); err != nil {
 		log.Printf("department 생성 중 DB 오류: %v", err)
 		return nil, common.NewError(http.StatusInternalServerError, "department 생성에 실패했습니다", err)
@@ -61,13 +91,17 @@ func (du *departmentUsecase) CreateDepartment(department *_departmentEntity.Depa
 }
 
 // TODO 부서 리스트 조회
-func (du *departmentUsecase) GetDepartments(requestUserId uint) ([]res.DepartmentListResponse, error) {
-	requestUser, err := du.userRepository.GetUserByID(requestUserId)
+func (du *departmentUsecase) GetDepartments(requestUserId uint, activeCompanyId uint) ([]res.DepartmentListResponse, error) {
+	requestUser, err := du.userRepository.GetUserByIDInCompany(requestUserId, activeCompanyId)
 	if err != nil {
 		log.Printf("사용자 조회에 실패했습니다: %v", err)
 		return nil, common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
 	}
 
+	if requestUser.UserProfile.CompanyID == nil {
+		log.Printf("요청 사용자의 회사 ID가 없습니다")
+		return nil, common.NewError(http.StatusBadRequest, "요청 사용자의 회사 ID가 없습니다", nil)
+	}
 	companyId := requestUser.UserProfile.CompanyID
 
 	departments, err := du.departmentRepository.GetDepartments(*companyId)
@@ -83,6 +117,7 @@ func (du *departmentUsecase) GetDepartments(requestUserId uint) ([]res.Departmen
 			Name:               department.Name,
 			CompanyID:          department.CompanyID,
 			DepartmentLeaderID: department.DepartmentLeaderID,
+			ParentID:           department.ParentID,
 			CreatedAt:          department.CreatedAt,
 			UpdatedAt:          department.UpdatedAt,
 		})
@@ -92,14 +127,18 @@ func (du *departmentUsecase) GetDepartments(requestUserId uint) ([]res.Departmen
 }
 
 // TODO 부서 상세 조회
-func (du *departmentUsecase) GetDepartment(requestUserId uint, departmentID uint) (*_departmentEntity.Department, error) {
+func (du *departmentUsecase) GetDepartment(requestUserId uint, activeCompanyId uint, departmentID uint) (*_departmentEntity.Department, error) {
 
-	requestUser, err := du.userRepository.GetUserByID(requestUserId)
+	requestUser, err := du.userRepository.GetUserByIDInCompany(requestUserId, activeCompanyId)
 	if err != nil {
 		log.Printf("사용자 조회에 실패했습니다: %v", err)
 		return nil, common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
 	}
 
+	if requestUser.UserProfile.CompanyID == nil {
+		log.Printf("요청 사용자의 회사 ID가 없습니다")
+		return nil, common.NewError(http.StatusBadRequest, "요청 사용자의 회사 ID가 없습니다", nil)
+	}
 	companyId := requestUser.UserProfile.CompanyID
 
 	department, err := du.departmentRepository.GetDepartmentByID(*companyId, departmentID)
@@ -112,18 +151,27 @@ func (du *departmentUsecase) GetDepartment(requestUserId uint, departmentID uint
 }
 
 // TODO 부서 수정 (관리자 이상만 가능)
-func (du *departmentUsecase) UpdateDepartment(requestUserId uint, targetDepartmentID uint, request req.UpdateDepartmentRequest) (*_departmentEntity.Department, error) {
-	requestUser, err := du.userRepository.GetUserByID(requestUserId)
+func (du *departmentUsecase) UpdateDepartment(requestUserId uint, activeCompanyId uint, targetDepartmentID uint, request req.UpdateDepartmentRequest) (*_departmentEntity.Department, error) {
+	requestUser, err := du.userRepository.GetUserByIDInCompany(requestUserId, activeCompanyId)
 	if err != nil {
 		log.Printf("사용자 조회에 실패했습니다: %v", err)
 		return nil, common.NewError(http.StatusNotFound, "요청 사용자를 찾을 수 없습니다", err)
 	}
 
-	if requestUser.Role > _userEntity.RoleCompanySubManager {
+	allowed, err := requestUser.HasCompanyPermission(_companyEntity.PermissionManageDepartments, du.userRepository.GetCompanyPermissions)
+	if err != nil {
+		log.Printf("회사 역할 권한 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 역할 권한 조회에 실패했습니다", err)
+	}
+	if !allowed {
 		log.Printf("권한이 없는 사용자가 부서를 수정하려 했습니다: 사용자 ID %d", requestUserId)
-		return nil, common.NewError(http.StatusForbidden, "권한이 없습니다", err)
+		return nil, common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
 	}
 
+	if requestUser.UserProfile.CompanyID == nil {
+		log.Printf("요청 사용자의 회사 ID가 없습니다")
+		return nil, common.NewError(http.StatusBadRequest, "요청 사용자의 회사 ID가 없습니다", nil)
+	}
 	companyId := requestUser.UserProfile.CompanyID
 
 	_, err = du.departmentRepository.GetDepartmentByID(*companyId, targetDepartmentID)
@@ -134,10 +182,27 @@ func (du *departmentUsecase) UpdateDepartment(requestUserId uint, targetDepartme
 
 	updates := make(map[string]interface{})
 	if request.Name != nil {
+		exists, err := du.departmentRepository.ExistsDepartmentName(*companyId, *request.Name, targetDepartmentID)
+		if err != nil {
+			log.Printf("부서 이름 조회 중 DB 오류: %v", err)
+			return nil, common.NewError(http.StatusInternalServerError, "부서 이름 조회에 실패했습니다", err)
+		}
+		if exists {
+			return nil, common.NewError(http.StatusConflict, "이미 존재하는 부서 이름입니다", nil)
+		}
 		updates["name"] = *request.Name
 	}
 	if request.DepartmentLeaderID != nil {
 		updates["department_leader_id"] = *request.DepartmentLeaderID
+	} else if request.AutoLeader {
+		leaderId, err := du.getTopRankedMemberID(*companyId, targetDepartmentID)
+		if err != nil {
+			return nil, err
+		}
+		if leaderId == nil {
+			return nil, common.NewError(http.StatusBadRequest, "부서 구성원이 없습니다", nil)
+		}
+		updates["department_leader_id"] = *leaderId
 	}
 
 	err = du.departmentRepository.UpdateDepartment(*companyId, targetDepartmentID, updates)
@@ -150,19 +215,28 @@ func (du *departmentUsecase) UpdateDepartment(requestUserId uint, targetDepartme
 }
 
 // TODO 부서 삭제 (관리자 이상만 가능)
-func (du *departmentUsecase) DeleteDepartment(requestUserId uint, departmentID uint) error {
+func (du *departmentUsecase) DeleteDepartment(requestUserId uint, activeCompanyId uint, departmentID uint) error {
 
-	adminUser, err := du.userRepository.GetUserByID(requestUserId)
+	adminUser, err := du.userRepository.GetUserByIDInCompany(requestUserId, activeCompanyId)
 	if err != nil {
 		log.Printf("사용자 조회에 실패했습니다: %v", err)
 		return common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
 	}
 
-	if adminUser.Role > _userEntity.RoleCompanySubManager {
+	allowed, err := adminUser.HasCompanyPermission(_companyEntity.PermissionManageDepartments, du.userRepository.GetCompanyPermissions)
+	if err != nil {
+		log.Printf("회사 역할 권한 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 역할 권한 조회에 실패했습니다", err)
+	}
+	if !allowed {
 		log.Printf("권한이 없는 사용자가 부서를 삭제하려 했습니다: 사용자 ID %d", requestUserId)
-		return common.NewError(http.StatusForbidden, "권한이 없습니다", err)
+		return common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
 	}
 
+	if adminUser.UserProfile.CompanyID == nil {
+		log.Printf("요청 사용자의 회사 ID가 없습니다")
+		return common.NewError(http.StatusBadRequest, "요청 사용자의 회사 ID가 없습니다", nil)
+	}
 	companyId := adminUser.UserProfile.CompanyID
 
 	_, err = du.departmentRepository.GetDepartmentByID(*companyId, departmentID)
@@ -180,6 +254,358 @@ func (du *departmentUsecase) DeleteDepartment(requestUserId uint, departmentID u
 	return nil
 }
 
+// TODO 부서 이동 (관리자 이상만 가능) - 자기 자신이나 하위 부서 밑으로는 이동 불가
+func (du *departmentUsecase) MoveDepartment(requestUserId uint, activeCompanyId uint, departmentID uint, request req.MoveDepartmentRequest) error {
+	requestUser, err := du.userRepository.GetUserByIDInCompany(requestUserId, activeCompanyId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+	}
+
+	allowed, err := requestUser.HasCompanyPermission(_companyEntity.PermissionManageDepartments, du.userRepository.GetCompanyPermissions)
+	if err != nil {
+		log.Printf("회사 역할 권한 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 역할 권한 조회에 실패했습니다", err)
+	}
+	if !allowed {
+		log.Printf("권한이 없는 사용자가 부서를 이동하려 했습니다: 사용자 ID %d", requestUserId)
+		return common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+	}
+
+	if requestUser.UserProfile.CompanyID == nil {
+		log.Printf("요청 사용자의 회사 ID가 없습니다")
+		return common.NewError(http.StatusBadRequest, "요청 사용자의 회사 ID가 없습니다", nil)
+	}
+	companyId := *requestUser.UserProfile.CompanyID
+
+	if _, err := du.departmentRepository.GetDepartmentByID(companyId, departmentID); err != nil {
+		log.Printf("부서 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusNotFound, "존재하지 않는 부서입니다", err)
+	}
+
+	if request.ParentID != nil {
+		if _, err := du.departmentRepository.GetDepartmentByID(companyId, *request.ParentID); err != nil {
+			log.Printf("상위 부서 조회에 실패했습니다: %v", err)
+			return common.NewError(http.StatusBadRequest, "존재하지 않는 상위 부서입니다", err)
+		}
+
+		subDepartmentIds, err := du.departmentRepository.GetSubDepartmentIds(companyId, departmentID)
+		if err != nil {
+			log.Printf("하위 부서 조회에 실패했습니다: %v", err)
+			return common.NewError(http.StatusInternalServerError, "하위 부서 조회에 실패했습니다", err)
+		}
+		for _, id := range subDepartmentIds {
+			if id == *request.ParentID {
+				return common.NewError(http.StatusBadRequest, "자기 자신이나 하위 부서 밑으로 이동할 수 없습니다", nil)
+			}
+		}
+	}
+
+	if err := du.departmentRepository.MoveDepartment(companyId, departmentID, request.ParentID); err != nil {
+		log.Printf("부서 이동에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "부서 이동에 실패했습니다", err)
+	}
+
+	return nil
+}
+
+// TODO 부서 통합 미리보기 (관리자 이상만 가능)
+func (du *departmentUsecase) PreviewMergeDepartment(requestUserId uint, activeCompanyId uint, departmentID uint, request req.MergeDepartmentRequest) (*res.DepartmentReorganizeResponse, error) {
+	companyId, err := du.getManagerCompanyID(requestUserId, activeCompanyId)
+	if err != nil {
+		return nil, err
+	}
+	return du.buildMergePreview(companyId, departmentID, request.TargetDepartmentID)
+}
+
+// TODO 부서 통합 (관리자 이상만 가능) - 구성원, 게시물, 하위 부서를 target으로 옮기고 source 삭제
+func (du *departmentUsecase) MergeDepartment(requestUserId uint, activeCompanyId uint, departmentID uint, request req.MergeDepartmentRequest) (*res.DepartmentReorganizeResponse, error) {
+	companyId, err := du.getManagerCompanyID(requestUserId, activeCompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	preview, err := du.buildMergePreview(companyId, departmentID, request.TargetDepartmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := du.departmentRepository.MergeDepartment(companyId, departmentID, request.TargetDepartmentID); err != nil {
+		log.Printf("부서 통합에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "부서 통합에 실패했습니다", err)
+	}
+
+	return preview, nil
+}
+
+// TODO 부서 분리 미리보기 (관리자 이상만 가능)
+func (du *departmentUsecase) PreviewSplitDepartment(requestUserId uint, activeCompanyId uint, departmentID uint, request req.SplitDepartmentRequest) (*res.DepartmentReorganizeResponse, error) {
+	companyId, err := du.getManagerCompanyID(requestUserId, activeCompanyId)
+	if err != nil {
+		return nil, err
+	}
+	return du.buildSplitPreview(companyId, departmentID, request)
+}
+
+// TODO 부서 분리 (관리자 이상만 가능) - 선택한 구성원과 게시물을 새 부서로
+func (du *departmentUsecase) SplitDepartment(requestUserId uint, activeCompanyId uint, departmentID uint, request req.SplitDepartmentRequest) (*res.DepartmentReorganizeResponse, error) {
+	companyId, err := du.getManagerCompanyID(requestUserId, activeCompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	preview, err := du.buildSplitPreview(companyId, departmentID, request)
+	if err != nil {
+		return nil, err
+	}
+
+	//TODO 부서장을 지정하지 않으면 분리되는 구성원 중 가장 높은 직급
+	leaderId := request.DepartmentLeaderID
+	if leaderId == nil {
+		leaderId, err = du.departmentRepository.GetTopRankedUserID(companyId, request.UserIds)
+		if err != nil {
+			log.Printf("직급 순위 조회에 실패했습니다: %v", err)
+			return nil, common.NewError(http.StatusInternalServerError, "직급 순위 조회에 실패했습니다", err)
+		}
+	}
+
+	department := &_departmentEntity.Department{
+		Name:               request.Name,
+		ParentID:           preview.TargetDepartment.ParentID,
+		DepartmentLeaderID: leaderId,
+	}
+	if err := du.departmentRepository.SplitDepartment(companyId, departmentID, department, request.UserIds, request.PostIds); err != nil {
+		log.Printf("부서 분리에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "부서 분리에 실패했습니다", err)
+	}
+
+	preview.TargetDepartment.ID = department.ID
+	return preview, nil
+}
+
+// 부서 구성원 중 가장 높은 직급 - 구성원이 없으면 nil
+func (du *departmentUsecase) getTopRankedMemberID(companyId uint, departmentID uint) (*uint, error) {
+	userIds, err := du.departmentRepository.GetDepartmentUserIds(departmentID)
+	if err != nil {
+		log.Printf("부서 구성원 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "부서 구성원 조회에 실패했습니다", err)
+	}
+
+	leaderId, err := du.departmentRepository.GetTopRankedUserID(companyId, userIds)
+	if err != nil {
+		log.Printf("직급 순위 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "직급 순위 조회에 실패했습니다", err)
+	}
+	return leaderId, nil
+}
+
+func (du *departmentUsecase) getManagerCompanyID(requestUserId uint, activeCompanyId uint) (uint, error) {
+	requestUser, err := du.userRepository.GetUserByIDInCompany(requestUserId, activeCompanyId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return 0, common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+	}
+
+	allowed, err := requestUser.HasCompanyPermission(_companyEntity.PermissionManageDepartments, du.userRepository.GetCompanyPermissions)
+	if err != nil {
+		log.Printf("회사 역할 권한 조회에 실패했습니다: %v", err)
+		return 0, common.NewError(http.StatusInternalServerError, "회사 역할 권한 조회에 실패했습니다", err)
+	}
+	if !allowed {
+		log.Printf("권한이 없는 사용자가 부서 개편을 시도했습니다: 사용자 ID %d", requestUserId)
+		return 0, common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+	}
+
+	if requestUser.UserProfile.CompanyID == nil {
+		log.Printf("요청 사용자의 회사 ID가 없습니다")
+		return 0, common.NewError(http.StatusBadRequest, "요청 사용자의 회사 ID가 없습니다", nil)
+	}
+
+	return *requestUser.UserProfile.CompanyID, nil
+}
+
+func (du *departmentUsecase) buildMergePreview(companyId uint, sourceID uint, targetID uint) (*res.DepartmentReorganizeResponse, error) {
+	if sourceID == targetID {
+		return nil, common.NewError(http.StatusBadRequest, "같은 부서로 통합할 수 없습니다", nil)
+	}
+
+	source, err := du.departmentRepository.GetDepartmentByID(companyId, sourceID)
+	if err != nil {
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 부서입니다", err)
+	}
+	target, err := du.departmentRepository.GetDepartmentByID(companyId, targetID)
+	if err != nil {
+		return nil, common.NewError(http.StatusNotFound, "통합 대상 부서가 존재하지 않습니다", err)
+	}
+
+	// 하위 부서로 통합하면 source의 하위 부서가 target 밑으로 가면서 순환이 생김
+	subDepartmentIds, err := du.departmentRepository.GetSubDepartmentIds(companyId, sourceID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "하위 부서 조회에 실패했습니다", err)
+	}
+	for _, id := range subDepartmentIds {
+		if id == targetID {
+			return nil, common.NewError(http.StatusBadRequest, "하위 부서로는 통합할 수 없습니다", nil)
+		}
+	}
+
+	sourceUserIds, err := du.departmentRepository.GetDepartmentUserIds(sourceID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "부서 구성원 조회에 실패했습니다", err)
+	}
+	targetUserIds, err := du.departmentRepository.GetDepartmentUserIds(targetID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "부서 구성원 조회에 실패했습니다", err)
+	}
+	postIds, err := du.departmentRepository.GetDepartmentPostIds(sourceID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "부서 게시물 조회에 실패했습니다", err)
+	}
+	childIds, err := du.departmentRepository.GetChildDepartmentIds(companyId, sourceID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "하위 부서 조회에 실패했습니다", err)
+	}
+
+	targetMembers := make(map[uint]struct{}, len(targetUserIds))
+	for _, id := range targetUserIds {
+		targetMembers[id] = struct{}{}
+	}
+	movedUserIds := make([]uint, 0, len(sourceUserIds))
+	alreadyMemberUserIds := make([]uint, 0)
+	for _, id := range sourceUserIds {
+		if _, ok := targetMembers[id]; ok {
+			alreadyMemberUserIds = append(alreadyMemberUserIds, id)
+			continue
+		}
+		movedUserIds = append(movedUserIds, id)
+	}
+
+	movedUsers, err := du.getMemberSummaries(movedUserIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.DepartmentReorganizeResponse{
+		Type:                    "MERGE",
+		SourceDepartment:        res.DepartmentSummaryResponse{ID: source.ID, Name: source.Name, ParentID: source.ParentID},
+		TargetDepartment:        res.DepartmentSummaryResponse{ID: target.ID, Name: target.Name, ParentID: target.ParentID},
+		MovedUsers:              movedUsers,
+		AlreadyMemberUserIds:    alreadyMemberUserIds,
+		RemainingUserCount:      0,
+		MovedPostIds:            postIds,
+		MovedChildDepartmentIds: childIds,
+		SourceDeleted:           true,
+	}, nil
+}
+
+func (du *departmentUsecase) buildSplitPreview(companyId uint, sourceID uint, request req.SplitDepartmentRequest) (*res.DepartmentReorganizeResponse, error) {
+	source, err := du.departmentRepository.GetDepartmentByID(companyId, sourceID)
+	if err != nil {
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 부서입니다", err)
+	}
+
+	exists, err := du.departmentRepository.ExistsDepartmentName(companyId, request.Name, 0)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "부서 이름 조회에 실패했습니다", err)
+	}
+	if exists {
+		return nil, common.NewError(http.StatusConflict, "이미 존재하는 부서 이름입니다", nil)
+	}
+
+	parentID := source.ParentID
+	if request.ParentID != nil {
+		if _, err := du.departmentRepository.GetDepartmentByID(companyId, *request.ParentID); err != nil {
+			return nil, common.NewError(http.StatusBadRequest, "존재하지 않는 상위 부서입니다", err)
+		}
+		parentID = request.ParentID
+	}
+
+	sourceUserIds, err := du.departmentRepository.GetDepartmentUserIds(sourceID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "부서 구성원 조회에 실패했습니다", err)
+	}
+	if err := requireSubset(request.UserIds, sourceUserIds); err != nil {
+		return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("해당 부서 소속이 아닌 사용자입니다: %v", err), nil)
+	}
+
+	postIds, err := du.departmentRepository.GetDepartmentPostIds(sourceID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "부서 게시물 조회에 실패했습니다", err)
+	}
+	if err := requireSubset(request.PostIds, postIds); err != nil {
+		return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("해당 부서 게시물이 아닙니다: %v", err), nil)
+	}
+
+	if request.DepartmentLeaderID != nil {
+		if err := requireSubset([]uint{*request.DepartmentLeaderID}, request.UserIds); err != nil {
+			return nil, common.NewError(http.StatusBadRequest, "부서장은 분리되는 구성원 중에서 지정해야 합니다", nil)
+		}
+	}
+
+	movedUsers, err := du.getMemberSummaries(request.UserIds)
+	if err != nil {
+		return nil, err
+	}
+
+	movedPostIds := request.PostIds
+	if movedPostIds == nil {
+		movedPostIds = []uint{}
+	}
+
+	return &res.DepartmentReorganizeResponse{
+		Type:               "SPLIT",
+		SourceDepartment:   res.DepartmentSummaryResponse{ID: source.ID, Name: source.Name, ParentID: source.ParentID},
+		TargetDepartment:   res.DepartmentSummaryResponse{Name: request.Name, ParentID: parentID},
+		MovedUsers:         movedUsers,
+		RemainingUserCount: len(sourceUserIds) - len(movedUsers),
+		MovedPostIds:       movedPostIds,
+		SourceDeleted:      false,
+	}, nil
+}
+
+func (du *departmentUsecase) getMemberSummaries(userIds []uint) ([]res.DepartmentMemberSummaryResponse, error) {
+	summaries := make([]res.DepartmentMemberSummaryResponse, 0, len(userIds))
+	if len(userIds) == 0 {
+		return summaries, nil
+	}
+
+	users, err := du.userRepository.GetUserByIds(userIds)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "사용자 조회에 실패했습니다", err)
+	}
+	for _, user := range users {
+		summary := res.DepartmentMemberSummaryResponse{}
+		if user.ID != nil {
+			summary.ID = *user.ID
+		}
+		if user.Name != nil {
+			summary.Name = *user.Name
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+// requireSubset ids가 모두 allowed에 있는지 확인 (중복 id도 거부)
+func requireSubset(ids []uint, allowed []uint) error {
+	allowedSet := make(map[uint]struct{}, len(allowed))
+	for _, id := range allowed {
+		allowedSet[id] = struct{}{}
+	}
+	seen := make(map[uint]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := allowedSet[id]; !ok {
+			return fmt.Errorf("%d", id)
+		}
+		if _, ok := seen[id]; ok {
+			return fmt.Errorf("%d (중복)", id)
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
+}
+
 //TODO 부서 삭제 요청(부서 관리자만 가능)
 
 //TODO 부서 수정 요청 (부서 관리자만 가능) - 따로 요청 기록 테이블을 만들어야하나?
@@ -10,4 +10,7 @@ type LikeRepository interface {
 	CreateCommentLike(like *entity.Like) error
 	GetCommentLikeByID(userId uint, commentId uint) (*entity.Like, error)
 	DeleteCommentLike(likeId uint) error
+
+	//TODO 이모지 카탈로그 - 채팅 메시지 반응에서도 사용
+	GetOrCreateEmoji(unified string, content string) (*entity.Like, error)
 }
@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	_chatRepository "link/internal/chat/repository"
+	_postRepository "link/internal/post/repository"
+	_teamRepository "link/internal/team/repository"
+	_userRepository "link/internal/user/repository"
+	"link/pkg/common"
+	"link/pkg/dto/res"
+	_util "link/pkg/util"
+)
+
+const (
+	PostMediaPrefix    = "/static/posts/"
+	ProfileMediaPrefix = "/static/profiles/"
+	ChatMediaPrefix    = "/static/chats/"
+)
+
+type MediaUsecase interface {
+	CheckPostMediaAccess(requestUserId uint, mediaPath string) error
+	CheckProfileMediaAccess(requestUserId uint, mediaPath string) error
+	CheckChatMediaAccess(requestUserId uint, mediaPath string) error
+	GetSignedMediaURL(requestUserId uint, mediaPath string) (*res.GetSignedMediaURLResponse, error)
+}
+
+type mediaUsecase struct {
+	postRepo _postRepository.PostRepository
+	userRepo _userRepository.UserRepository
+	teamRepo _teamRepository.TeamRepository
+	chatRepo _chatRepository.ChatRepository
+}
+
+func NewMediaUsecase(postRepo _postRepository.PostRepository, userRepo _userRepository.UserRepository, teamRepo _teamRepository.TeamRepository, chatRepo _chatRepository.ChatRepository) MediaUsecase {
+	return &mediaUsecase{
+		postRepo: postRepo,
+		userRepo: userRepo,
+		teamRepo: teamRepo,
+		chatRepo: chatRepo,
+	}
+}
+
+// TODO 게시물 이미지 접근 권한 확인 - 게시물 공개 범위(public, company, department, team) 기준
+func (uc *mediaUsecase) CheckPostMediaAccess(requestUserId uint, mediaPath string) error {
+	post, err := uc.postRepo.GetPostByImageURL(mediaPath)
+	if err != nil {
+		fmt.Printf("이미지 게시물 조회 실패: %v", err)
+		return common.NewError(http.StatusNotFound, "존재하지 않는 이미지입니다", err)
+	}
+
+	//TODO 대표 회사가 아닌 게시물 회사 기준 소속, 부서로 확인
+	var postCompanyId uint
+	if post.CompanyID != nil {
+		postCompanyId = *post.CompanyID
+	}
+	requestUser, err := uc.userRepo.GetUserByIDInCompany(requestUserId, postCompanyId)
+	if err != nil {
+		fmt.Printf("사용자 조회 실패: %v", err)
+		return common.NewError(http.StatusUnauthorized, "사용자가 없습니다", err)
+	}
+
+	//TODO 팀 게시물은 팀 구성원만
+	if strings.ToLower(post.Visibility) == "team" && post.TeamID != nil && *requestUser.ID != post.UserID {
+		member, err := uc.teamRepo.GetTeamMember(*post.TeamID, requestUserId)
+		if err != nil {
+			fmt.Printf("팀 구성원 조회 실패: %v", err)
+			return common.NewError(http.StatusInternalServerError, "팀 구성원 조회 실패", err)
+		}
+		if member == nil {
+			fmt.Printf("이미지 접근 권한이 없습니다: 사용자 ID %d, 게시물 ID %d", requestUserId, post.ID)
+			return common.NewError(http.StatusForbidden, "이미지 접근 권한이 없습니다", nil)
+		}
+		return nil
+	}
+
+	if !post.CanBeViewedBy(requestUser) {
+		fmt.Printf("이미지 접근 권한이 없습니다: 사용자 ID %d, 게시물 ID %d", requestUserId, post.ID)
+		return common.NewError(http.StatusForbidden, "이미지 접근 권한이 없습니다", nil)
+	}
+
+	return nil
+}
+
+// TODO 프로필 이미지 접근 권한 확인 - 인증된 사용자면 조회 가능
+func (uc *mediaUsecase) CheckProfileMediaAccess(requestUserId uint, mediaPath string) error {
+	_, err := uc.userRepo.GetUserByID(requestUserId)
+	if err != nil {
+		fmt.Printf("사용자 조회 실패: %v", err)
+		return common.NewError(http.StatusUnauthorized, "사용자가 없습니다", err)
+	}
+
+	return nil
+}
+
+// TODO 채팅 첨부파일 접근 권한 확인 - 채팅방 참여자만 (경로: /static/chats/{채팅방 ID}/...)
+func (uc *mediaUsecase) CheckChatMediaAccess(requestUserId uint, mediaPath string) error {
+	roomSegment := strings.SplitN(strings.TrimPrefix(mediaPath, ChatMediaPrefix), "/", 2)[0]
+	chatRoomId, err := strconv.ParseUint(roomSegment, 10, 64)
+	if err != nil {
+		fmt.Printf("채팅 첨부파일 경로 파싱 실패: %v", err)
+		return common.NewError(http.StatusNotFound, "존재하지 않는 파일입니다", err)
+	}
+
+	if !uc.chatRepo.IsUserInChatRoom(requestUserId, uint(chatRoomId)) {
+		fmt.Printf("파일 접근 권한이 없습니다: 사용자 ID %d, 채팅방 ID %d", requestUserId, chatRoomId)
+		return common.NewError(http.StatusForbidden, "파일 접근 권한이 없습니다", nil)
+	}
+
+	return nil
+}
+
+// TODO 접근 가능한 미디어에 대해 짧은 만료시간의 서명 URL 발급
+func (uc *mediaUsecase) GetSignedMediaURL(requestUserId uint, mediaPath string) (*res.GetSignedMediaURLResponse, error) {
+	switch {
+	case strings.HasPrefix(mediaPath, PostMediaPrefix):
+		if err := uc.CheckPostMediaAccess(requestUserId, mediaPath); err != nil {
+			return nil, err
+		}
+	case strings.HasPrefix(mediaPath, ProfileMediaPrefix):
+		if err := uc.CheckProfileMediaAccess(requestUserId, mediaPath); err != nil {
+			return nil, err
+		}
+	case strings.HasPrefix(mediaPath, ChatMediaPrefix):
+		if err := uc.CheckChatMediaAccess(requestUserId, mediaPath); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, common.NewError(http.StatusBadRequest, "서명할 수 없는 경로입니다", nil)
+	}
+
+	signedUrl, expiresAt := _util.SignMediaURL(mediaPath)
+
+	return &res.GetSignedMediaURLResponse{
+		URL:       signedUrl,
+		ExpiresAt: _util.ParseKst(expiresAt).Format(time.DateTime),
+	}, nil
+}
@@ -13,6 +13,8 @@ import (
 	_notificationEntity "link/internal/notification/entity"
 	_notificationRepo "link/internal/notification/repository"
 	_projectRepo "link/internal/project/repository"
+	_teamEntity "link/internal/team/entity"
+	_teamRepo "link/internal/team/repository"
 	_userEntity "link/internal/user/entity"
 	_userRepo "link/internal/user/repository"
 	"link/pkg/common"
@@ -27,6 +29,7 @@ import (
 type NotificationUsecase interface {
 	GetNotifications(userId uint, queryParams *req.GetNotificationsQueryParams) (*res.GetNotificationsResponse, error)
 	CreateMention(req req.SendMentionNotificationRequest) (*res.CreateNotificationResponse, error)
+	CreateTeamMention(senderId uint, companyId uint, req req.SendTeamMentionNotificationRequest) ([]*res.CreateNotificationResponse, error)
 	CreateInvite(req req.NotificationRequest) (*res.CreateNotificationResponse, error)
 	CreateRequest(req req.NotificationRequest) (*res.CreateNotificationResponse, error)
 	UpdateInviteNotificationStatus(receiverId uint, targetDocID string, status string) (*res.UpdateNotificationStatusResponseMessage, error)
@@ -39,6 +42,7 @@ type notificationUsecase struct {
 	companyRepo      _companyRepo.CompanyRepository
 	departmentRepo   _departmentRepo.DepartmentRepository
 	projectRepo      _projectRepo.ProjectRepository
+	teamRepo         _teamRepo.TeamRepository
 	natsPublisher    *_nats.NatsPublisher
 	natsSubscriber   *_nats.NatsSubscriber
 }
@@ -49,6 +53,7 @@ func NewNotificationUsecase(
 	companyRepo _companyRepo.CompanyRepository,
 	departmentRepo _departmentRepo.DepartmentRepository,
 	projectRepo _projectRepo.ProjectRepository,
+	teamRepo _teamRepo.TeamRepository,
 	natsPublisher *_nats.NatsPublisher,
 	natsSubscriber *_nats.NatsSubscriber) NotificationUsecase {
 	return &notificationUsecase{
@@ -57,6 +62,7 @@ func NewNotificationUsecase(
 		companyRepo:      companyRepo,
 		departmentRepo:   departmentRepo,
 		projectRepo:      projectRepo,
+		teamRepo:         teamRepo,
 		natsPublisher:    natsPublisher,
 		natsSubscriber:   natsSubscriber,
 	}
@@ -115,6 +121,92 @@ func (n *notificationUsecase) CreateMention(req req.SendMentionNotificationReque
 	return response, nil
 }
 
+// TODO 팀 언급 - 보낸 사람의 활성 회사 팀만, 보낸 사람 본인은 제외
+func (n *notificationUsecase) CreateTeamMention(senderId uint, companyId uint, req req.SendTeamMentionNotificationRequest) ([]*res.CreateNotificationResponse, error) {
+	sender, err := n.userRepo.GetUserByIDInCompany(senderId, companyId)
+	if err != nil {
+		return nil, common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+	}
+	if sender.UserProfile == nil || sender.UserProfile.CompanyID == nil || *sender.UserProfile.CompanyID == 0 {
+		return nil, common.NewError(http.StatusBadRequest, "사용자가 소속된 회사가 없습니다", nil)
+	}
+	companyId = *sender.UserProfile.CompanyID
+
+	handles := _teamEntity.ParseTeamMentions(req.Content)
+	if len(handles) == 0 {
+		return nil, common.NewError(http.StatusBadRequest, "언급된 팀이 없습니다", nil)
+	}
+
+	responses := make([]*res.CreateNotificationResponse, 0)
+	notified := map[uint]bool{senderId: true}
+	for _, handle := range handles {
+		team, err := n.teamRepo.GetTeamByHandle(companyId, handle)
+		if err != nil {
+			log.Printf("팀 조회에 실패했습니다: %v", err)
+			return nil, common.NewError(http.StatusInternalServerError, "팀 조회에 실패했습니다", err)
+		}
+		if team == nil {
+			continue // 사용자 언급 등 팀이 아닌 핸들
+		}
+
+		memberIds, err := n.teamRepo.GetTeamMemberIds(team.ID)
+		if err != nil {
+			log.Printf("팀 구성원 조회에 실패했습니다: %v", err)
+			return nil, common.NewError(http.StatusInternalServerError, "팀 구성원 조회에 실패했습니다", err)
+		}
+
+		for _, memberId := range memberIds {
+			if notified[memberId] {
+				continue
+			}
+			notified[memberId] = true
+
+			docID := uuid.New().String()
+			content := fmt.Sprintf("[MENTION] %s님이 @%s 팀을 언급했습니다", *sender.Name, team.Handle)
+			natsData := map[string]interface{}{
+				"topic": "link.event.notification.mention",
+				"payload": map[string]interface{}{
+					"doc_id":      docID,
+					"sender_id":   senderId,
+					"receiver_id": memberId,
+					"title":       "MENTION",
+					"content":     content,
+					"alarm_type":  "MENTION",
+					"is_read":     false,
+					"company_id":  companyId,
+					"target_type": strings.ToUpper(req.TargetType),
+					"target_id":   req.TargetID,
+					"timestamp":   time.Now(),
+				},
+			}
+
+			jsonData, err := json.Marshal(natsData)
+			if err != nil {
+				log.Printf("NATS 데이터 직렬화 오류: %v", err)
+				return nil, common.NewError(http.StatusInternalServerError, "NATS 데이터 직렬화에 실패했습니다", err)
+			}
+
+			go n.natsPublisher.PublishEvent("link.event.notification.mention", []byte(jsonData))
+
+			responses = append(responses, &res.CreateNotificationResponse{
+				DocID:      docID,
+				SenderID:   senderId,
+				ReceiverID: memberId,
+				Content:    content,
+				AlarmType:  "MENTION",
+				Title:      "MENTION",
+				IsRead:     false,
+				CompanyId:  companyId,
+				TargetType: strings.ToUpper(req.TargetType),
+				TargetID:   req.TargetID,
+				CreatedAt:  time.Now().Format(time.DateTime),
+			})
+		}
+	}
+
+	return responses, nil
+}
+
 // TODO 알림 저장 usecase -> 초대 : 초대는 어떤 초대인지 유형에 따라 분기처리
 func (n *notificationUsecase) CreateInvite(req req.NotificationRequest) (*res.CreateNotificationResponse, error) {
 
@@ -340,9 +432,17 @@ func (n *notificationUsecase) UpdateInviteNotificationStatus(receiverId uint, ta
 
 		// 회사 초대 처리
 		if notification.InviteType == "COMPANY" {
-			if receiver.UserProfile.CompanyID == nil {
-				receiver.UserProfile.CompanyID = &notification.CompanyId
-				err := n.userRepo.UpdateUser(*receiver.ID, nil, map[string]interface{}{"company_id": notification.CompanyId})
+			//TODO 이미 다른 회사에 소속되어 있어도 회사 소속 추가 (다중 회사)
+			membership, err := n.userRepo.GetCompanyMembership(*receiver.ID, notification.CompanyId)
+			if err != nil {
+				return nil, common.NewError(http.StatusInternalServerError, "회사 소속 조회에 실패했습니다", err)
+			}
+			if membership == nil {
+				err := n.userRepo.CreateCompanyMembership(&_userEntity.CompanyMembership{
+					UserID:    *receiver.ID,
+					CompanyID: notification.CompanyId,
+					Role:      _userEntity.RoleUser,
+				})
 				if err != nil {
 					return nil, common.NewError(http.StatusInternalServerError, "회사 추가에 실패했습니다", err)
 				}
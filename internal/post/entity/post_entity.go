@@ -1,6 +1,11 @@
 package entity
 
-import "time"
+import (
+	"strings"
+	"time"
+
+	_userEntity "link/internal/user/entity"
+)
 
 //TODO 모든 usecase에서 사용
 
@@ -21,6 +26,8 @@ type Post struct {
 	Likes         *[]interface{}         `json:"likes,omitempty"`
 	Author        map[string]interface{} `json:"author,omitempty"`
 	Departments   *[]interface{}         `json:"departments,omitempty"`
+
+	TeamID *uint `json:"team_id,omitempty"`
 }
 
 type PostMeta struct {
@@ -32,3 +39,56 @@ type PostMeta struct {
 	PrevPage   int    `json:"prev_page"`             // 이전 페이지 번호 커서, 오프셋 둘다 사용
 	NextPage   int    `json:"next_page"`             // 다음 페이지 번호 커서, 오프셋 둘다 사용
 }
+
+// CanBeViewedBy postPersistence.GetPosts의 카테고리 조건과 동일한 공개 범위 규칙 (팀 게시물은 팀 구성원 여부로 따로 확인)
+// user는 게시물 회사 기준으로 조회한 사용자여야 함 (GetUserByIDInCompany)
+func (p *Post) CanBeViewedBy(user *_userEntity.User) bool {
+	if user.ID != nil && *user.ID == p.UserID {
+		return true
+	}
+
+	switch strings.ToLower(p.Visibility) {
+	case "public":
+		return p.CompanyID == nil
+	case "company":
+		return p.CompanyID != nil && user.UserProfile != nil && user.UserProfile.CompanyID != nil &&
+			*user.UserProfile.CompanyID == *p.CompanyID
+	case "department":
+		if p.CompanyID == nil || user.UserProfile == nil || user.UserProfile.CompanyID == nil ||
+			*user.UserProfile.CompanyID != *p.CompanyID {
+			return false
+		}
+		userDeptIds := make(map[uint]struct{})
+		for _, dept := range user.UserProfile.Departments {
+			if dept == nil {
+				continue
+			}
+			if deptId, ok := departmentID((*dept)["id"]); ok {
+				userDeptIds[deptId] = struct{}{}
+			}
+		}
+		for _, deptId := range p.DepartmentIds {
+			if deptId == nil {
+				continue
+			}
+			if _, ok := userDeptIds[*deptId]; ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// departmentID 캐시(float64)와 DB(uint)에서 오는 부서 ID 타입 통일
+func departmentID(value interface{}) (uint, bool) {
+	switch v := value.(type) {
+	case uint:
+		return v, true
+	case float64:
+		return uint(v), true
+	case int:
+		return uint(v), true
+	}
+	return 0, false
+}
@@ -14,4 +14,5 @@ type PostRepository interface {
 	GetPostByCommentID(commentId uint) (*entity.Post, error)
 	IncreasePostViewCount(requestUserId uint, postId uint, ip string) error
 	GetPostViewCount(postId uint) (int, error)
+	GetPostByImageURL(imageUrl string) (*entity.Post, error)
 }
@@ -3,14 +3,18 @@ package usecase
 import (
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	_companyEntity "link/internal/company/entity"
 	_companyRepository "link/internal/company/repository"
 	_departmentRepository "link/internal/department/repository"
 	"link/internal/post/entity"
 	_postRepository "link/internal/post/repository"
+	_teamRepository "link/internal/team/repository"
+	_userEntity "link/internal/user/entity"
 	_userRepository "link/internal/user/repository"
 	"link/pkg/common"
 	"link/pkg/dto/req"
@@ -20,10 +24,10 @@ import (
 )
 
 type PostUsecase interface {
-	CreatePost(requestUserId uint, post *req.CreatePostRequest) error
+	CreatePost(requestUserId uint, companyId uint, post *req.CreatePostRequest) error
 	GetPosts(requestUserId uint, queryParams req.GetPostQueryParams) (*res.GetPostsResponse, error)
 	GetPost(requestUserId uint, postId uint) (*res.GetPostResponse, error)
-	UpdatePost(requestUserId uint, postId uint, post *req.UpdatePostRequest) error
+	UpdatePost(requestUserId uint, companyId uint, postId uint, post *req.UpdatePostRequest) error
 	DeletePost(requestUserId uint, postId uint) error
 	IncreasePostViewCount(requestUserId uint, postId uint, ip string) error
 	GetPostViewCount(requestUserId uint, postId uint) (*res.GetPostViewCountResponse, error)
@@ -34,25 +38,28 @@ type postUsecase struct {
 	userRepo       _userRepository.UserRepository
 	companyRepo    _companyRepository.CompanyRepository
 	departmentRepo _departmentRepository.DepartmentRepository
+	teamRepo       _teamRepository.TeamRepository
 }
 
 func NewPostUsecase(
 	postRepo _postRepository.PostRepository,
 	userRepo _userRepository.UserRepository,
 	companyRepo _companyRepository.CompanyRepository,
-	departmentRepo _departmentRepository.DepartmentRepository) PostUsecase {
+	departmentRepo _departmentRepository.DepartmentRepository,
+	teamRepo _teamRepository.TeamRepository) PostUsecase {
 	return &postUsecase{
 		postRepo:       postRepo,
 		userRepo:       userRepo,
 		companyRepo:    companyRepo,
 		departmentRepo: departmentRepo,
+		teamRepo:       teamRepo,
 	}
 }
 
 // TODO 게시물 생성,
-func (uc *postUsecase) CreatePost(requestUserId uint, post *req.CreatePostRequest) error {
-	//TODO requestUserId가 존재하는지 조회
-	author, err := uc.userRepo.GetUserByID(requestUserId)
+func (uc *postUsecase) CreatePost(requestUserId uint, activeCompanyId uint, post *req.CreatePostRequest) error {
+	//TODO requestUserId가 존재하는지 조회 - 회사, 부서 정보는 활성 회사 기준
+	author, err := uc.userRepo.GetUserByIDInCompany(requestUserId, activeCompanyId)
 	if err != nil {
 		fmt.Printf("사용자 조회 실패: %v", err)
 		return common.NewError(http.StatusBadRequest, "사용자가 없습니다", err)
@@ -67,6 +74,7 @@ func (uc *postUsecase) CreatePost(requestUserId uint, post *req.CreatePostReques
 	}
 
 	var companyId *uint
+	var teamId *uint
 
 	if strings.ToLower(post.Visibility) == "public" {
 		companyId = nil
@@ -101,6 +109,17 @@ func (uc *postUsecase) CreatePost(requestUserId uint, post *req.CreatePostReques
 			}
 		}
 		companyId = author.UserProfile.CompanyID
+	} else if strings.ToLower(post.Visibility) == "team" {
+		//TODO 팀 게시물은 팀 구성원만 작성 가능
+		if post.TeamID == nil {
+			fmt.Printf("팀 게시물에 필요한 team ID가 없습니다")
+			return common.NewError(http.StatusBadRequest, "팀 게시물에 필요한 team ID가 없습니다", nil)
+		}
+		if err := uc.checkTeamMember(requestUserId, author.UserProfile.CompanyID, *post.TeamID); err != nil {
+			return err
+		}
+		companyId = author.UserProfile.CompanyID
+		teamId = post.TeamID
 	}
 
 	//요청 가공 엔티티
@@ -114,6 +133,7 @@ func (uc *postUsecase) CreatePost(requestUserId uint, post *req.CreatePostReques
 		DepartmentIds: post.DepartmentIds,
 		CompanyID:     companyId,
 		CreatedAt:     time.Now(),
+		TeamID:        teamId,
 	}
 
 	err = uc.postRepo.CreatePost(requestUserId, postEntity)
@@ -127,12 +147,29 @@ func (uc *postUsecase) CreatePost(requestUserId uint, post *req.CreatePostReques
 
 // TODO 게시물 리스트 조회
 func (uc *postUsecase) GetPosts(requestUserId uint, queryParams req.GetPostQueryParams) (*res.GetPostsResponse, error) {
-	_, err := uc.userRepo.GetUserByID(requestUserId)
+	user, err := uc.userRepo.GetUserByIDInCompany(requestUserId, queryParams.CompanyId)
 	if err != nil {
 		fmt.Printf("사용자 조회 실패: %v", err)
 		return nil, common.NewError(http.StatusBadRequest, "사용자가 없습니다", err)
 	}
 
+	//TODO 회사, 부서 게시물은 소속된 회사만 조회 가능 - company_id가 없으면 대표 회사
+	category := strings.ToLower(queryParams.Category)
+	if category == "company" || category == "department" || category == "team" {
+		if user.UserProfile.CompanyID == nil {
+			fmt.Printf("소속되지 않은 회사입니다: 사용자 ID %d, 회사 ID %d", requestUserId, queryParams.CompanyId)
+			return nil, common.NewError(http.StatusForbidden, "소속되지 않은 회사입니다", nil)
+		}
+		queryParams.CompanyId = *user.UserProfile.CompanyID
+	}
+
+	//TODO 팀 게시물은 팀 구성원만 조회 가능
+	if category == "team" {
+		if err := uc.checkTeamMember(requestUserId, user.UserProfile.CompanyID, queryParams.TeamId); err != nil {
+			return nil, err
+		}
+	}
+
 	queryOptions := map[string]interface{}{
 		"category":      strings.ToLower(queryParams.Category),
 		"page":          queryParams.Page,
@@ -141,10 +178,21 @@ func (uc *postUsecase) GetPosts(requestUserId uint, queryParams req.GetPostQuery
 		"order":         queryParams.Order,
 		"company_id":    queryParams.CompanyId,
 		"department_id": queryParams.DepartmentId,
+		"team_id":       queryParams.TeamId,
 		"cursor":        map[string]interface{}{},
 		"view_type":     strings.ToLower(queryParams.ViewType),
 	}
 
+	//TODO 하위 부서 게시물 포함
+	if strings.ToLower(queryParams.Category) == "department" && queryParams.IncludeSubDepartments {
+		departmentIds, err := uc.departmentRepo.GetSubDepartmentIds(queryParams.CompanyId, queryParams.DepartmentId)
+		if err != nil {
+			fmt.Printf("하위 부서 조회 실패: %v", err)
+			return nil, common.NewError(http.StatusInternalServerError, "하위 부서 조회 실패", err)
+		}
+		queryOptions["department_ids"] = departmentIds
+	}
+
 	if queryParams.Cursor != nil {
 		if queryParams.Cursor.CreatedAt != "" {
 			queryOptions["cursor"].(map[string]interface{})["created_at"] = queryParams.Cursor.CreatedAt
@@ -181,7 +229,7 @@ func (uc *postUsecase) GetPosts(requestUserId uint, queryParams req.GetPostQuery
 		images := make([]string, len(post.Images))
 		for j, image := range post.Images {
 			if image != nil {
-				images[j] = *image
+				images[j], _ = _util.SignMediaURL(*image)
 			}
 		}
 
@@ -194,7 +242,7 @@ func (uc *postUsecase) GetPosts(requestUserId uint, queryParams req.GetPostQuery
 			}
 			if image, ok := post.Author["image"]; ok && image != nil {
 				if imageStr, ok := image.(*string); ok && imageStr != nil { // nil 체크 추가
-					authorImage = *imageStr
+					authorImage = _util.SignProfileImageURL(*imageStr)
 				}
 			}
 		} else {
@@ -209,6 +257,10 @@ func (uc *postUsecase) GetPosts(requestUserId uint, queryParams req.GetPostQuery
 		if post.CompanyID != nil {
 			companyId = *post.CompanyID
 		}
+		var teamId uint
+		if post.TeamID != nil {
+			teamId = *post.TeamID
+		}
 
 		postResponses[i] = &res.GetPostResponse{
 			PostId:       post.ID,
@@ -226,6 +278,7 @@ func (uc *postUsecase) GetPosts(requestUserId uint, queryParams req.GetPostQuery
 			ViewCount:    post.ViewCount,
 			CreatedAt:    _util.ParseKst(post.CreatedAt).Format(time.DateTime),
 			UpdatedAt:    _util.ParseKst(post.UpdatedAt).Format(time.DateTime),
+			TeamId:       teamId,
 		}
 
 	}
@@ -257,11 +310,35 @@ func (uc *postUsecase) GetPost(requestUserId uint, postId uint) (*res.GetPostRes
 		return nil, common.NewError(http.StatusBadRequest, "게시물 조회 실패", err)
 	}
 
+	//TODO 팀 게시물은 팀 구성원만 조회 가능
+	var teamId uint
+	if post.TeamID != nil {
+		if err := uc.checkTeamMember(requestUserId, post.CompanyID, *post.TeamID); err != nil {
+			return nil, err
+		}
+		teamId = *post.TeamID
+	} else {
+		//TODO 공개 범위 확인 - 게시물 회사 기준 소속, 부서로 판단 (서명 URL 발급 전)
+		var postCompanyId uint
+		if post.CompanyID != nil {
+			postCompanyId = *post.CompanyID
+		}
+		requestUser, err := uc.userRepo.GetUserByIDInCompany(requestUserId, postCompanyId)
+		if err != nil {
+			fmt.Printf("사용자 조회 실패: %v", err)
+			return nil, common.NewError(http.StatusUnauthorized, "사용자가 없습니다", err)
+		}
+		if !post.CanBeViewedBy(requestUser) {
+			fmt.Printf("게시물 조회 권한이 없습니다: 사용자 ID %d, 게시물 ID %d", requestUserId, post.ID)
+			return nil, common.NewError(http.StatusForbidden, "게시물 조회 권한이 없습니다", nil)
+		}
+	}
+
 	// 이미지 변환
 	images := make([]string, len(post.Images))
 	for j, image := range post.Images {
 		if image != nil {
-			images[j] = *image
+			images[j], _ = _util.SignMediaURL(*image)
 		}
 	}
 
@@ -278,7 +355,7 @@ func (uc *postUsecase) GetPost(requestUserId uint, postId uint) (*res.GetPostRes
 		}
 		if image, ok := post.Author["image"]; ok && image != nil {
 			if imageStr, ok := image.(*string); ok && imageStr != nil {
-				authorImage = *imageStr
+				authorImage = _util.SignProfileImageURL(*imageStr)
 			}
 		}
 	} else {
@@ -309,6 +386,7 @@ func (uc *postUsecase) GetPost(requestUserId uint, postId uint) (*res.GetPostRes
 		IsAuthor:    requestUserId == post.UserID,
 		CreatedAt:   _util.ParseKst(post.CreatedAt).Format(time.DateTime),
 		UpdatedAt:   _util.ParseKst(post.UpdatedAt).Format(time.DateTime),
+		TeamId:      teamId,
 	}
 
 	return postResponse, nil
@@ -352,8 +430,8 @@ func (uc *postUsecase) GetPostViewCount(requestUserId uint, postId uint) (*res.G
 }
 
 // TODO 게시물 수정
-func (uc *postUsecase) UpdatePost(requestUserId uint, postId uint, post *req.UpdatePostRequest) error {
-	user, err := uc.userRepo.GetUserByID(requestUserId)
+func (uc *postUsecase) UpdatePost(requestUserId uint, activeCompanyId uint, postId uint, post *req.UpdatePostRequest) error {
+	user, err := uc.userRepo.GetUserByIDInCompany(requestUserId, activeCompanyId)
 	if err != nil {
 		fmt.Printf("사용자 조회 실패: %v", err)
 		return common.NewError(http.StatusBadRequest, "사용자가 없습니다", err)
@@ -371,7 +449,18 @@ func (uc *postUsecase) UpdatePost(requestUserId uint, postId uint, post *req.Upd
 	}
 
 	var companyId *uint
-	if post.Visibility != nil && *post.Visibility != existingPost.Visibility {
+	teamId := existingPost.TeamID // 기본값은 기존 값
+	if strings.ToLower(existingPost.Visibility) == "team" {
+		companyId = existingPost.CompanyID
+	}
+	if post.Visibility != nil && strings.ToLower(*post.Visibility) == "team" && post.TeamID != nil {
+		//TODO 팀 게시물은 다른 팀으로도 옮길 수 있음 - 옮길 팀의 구성원이어야 함
+		if err := uc.checkTeamMember(requestUserId, user.UserProfile.CompanyID, *post.TeamID); err != nil {
+			return err
+		}
+		companyId = user.UserProfile.CompanyID
+		teamId = post.TeamID
+	} else if post.Visibility != nil && *post.Visibility != existingPost.Visibility {
 		if strings.ToLower(*post.Visibility) == "public" {
 			companyId = nil
 		} else if strings.ToLower(*post.Visibility) == "company" {
@@ -401,6 +490,9 @@ func (uc *postUsecase) UpdatePost(requestUserId uint, postId uint, post *req.Upd
 				}
 			}
 
+		} else if strings.ToLower(*post.Visibility) == "team" {
+			fmt.Printf("팀 게시물에 필요한 team ID가 없습니다")
+			return common.NewError(http.StatusBadRequest, "팀 게시물에 필요한 team ID가 없습니다", nil)
 		}
 	}
 
@@ -444,6 +536,7 @@ func (uc *postUsecase) UpdatePost(requestUserId uint, postId uint, post *req.Upd
 		Images:        images,
 		CompanyID:     companyId,
 		DepartmentIds: departmentIds,
+		TeamID:        teamId,
 	}
 
 	if post.Visibility != nil {
@@ -479,9 +572,17 @@ func (uc *postUsecase) DeletePost(requestUserId uint, postId uint) error {
 		return common.NewError(http.StatusBadRequest, "게시물 조회 실패", err)
 	}
 
+	//TODO 작성자가 아니면 해당 회사의 관리자 또는 MODERATE_POSTS 권한이 있어야 함
 	if post.UserID != *user.ID {
-		fmt.Printf("게시물 삭제 권한이 없습니다")
-		return common.NewError(http.StatusBadRequest, "게시물 삭제 권한이 없습니다", nil)
+		allowed, err := uc.canModeratePost(requestUserId, post)
+		if err != nil {
+			fmt.Printf("회사 역할 권한 조회 실패: %v", err)
+			return common.NewError(http.StatusInternalServerError, "회사 역할 권한 조회에 실패했습니다", err)
+		}
+		if !allowed {
+			fmt.Printf("게시물 삭제 권한이 없습니다")
+			return common.NewError(http.StatusBadRequest, "게시물 삭제 권한이 없습니다", nil)
+		}
 	}
 
 	err = uc.postRepo.DeletePost(requestUserId, postId)
@@ -490,5 +591,78 @@ func (uc *postUsecase) DeletePost(requestUserId uint, postId uint) error {
 		return common.NewError(http.StatusBadRequest, "게시물 삭제 실패", err)
 	}
 
+	//TODO 게시물 이미지 파일 삭제 후 회사 업로드 용량 반영 - 게시물은 이미 지워졌으므로 실패해도 로그만 남김
+	uc.removePostImages(post)
+
+	return nil
+}
+
+func (uc *postUsecase) removePostImages(post *entity.Post) {
+	var removedBytes int64
+	for _, image := range post.Images {
+		if image == nil {
+			continue
+		}
+		size, err := _util.RemoveStaticFile(*image)
+		if err != nil {
+			fmt.Printf("게시물 이미지 삭제 실패: %s, %v", *image, err)
+			continue
+		}
+		removedBytes += size
+	}
+
+	if post.CompanyID == nil || removedBytes == 0 {
+		return
+	}
+	if err := uc.companyRepo.DecreaseCompanyStorageUsage(*post.CompanyID, removedBytes); err != nil {
+		fmt.Printf("회사 업로드 용량 갱신 실패: 회사 ID %d, %v", *post.CompanyID, err)
+	}
+}
+
+// TODO 회사 게시물 관리 권한 - 게시물이 속한 회사 기준으로 확인
+func (uc *postUsecase) canModeratePost(requestUserId uint, post *entity.Post) (bool, error) {
+	if post.CompanyID == nil {
+		return false, nil
+	}
+
+	membership, err := uc.userRepo.GetCompanyMembership(requestUserId, *post.CompanyID)
+	if err != nil {
+		return false, err
+	}
+	if membership == nil {
+		return false, nil
+	}
+	if membership.Role <= _userEntity.RoleCompanySubManager {
+		return true, nil
+	}
+
+	permissions, err := uc.userRepo.GetCompanyPermissions(requestUserId, *post.CompanyID)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(permissions, _companyEntity.PermissionModeratePosts), nil
+}
+
+// TODO 팀 게시물 권한 - 사용자 활성 회사의 팀 구성원이어야 함
+func (uc *postUsecase) checkTeamMember(requestUserId uint, companyId *uint, teamId uint) error {
+	if companyId == nil {
+		fmt.Printf("사용자의 회사 정보가 없습니다")
+		return common.NewError(http.StatusBadRequest, "사용자의 회사 정보가 없습니다", nil)
+	}
+
+	if _, err := uc.teamRepo.GetTeamByID(*companyId, teamId); err != nil {
+		fmt.Printf("팀 조회 실패: %v", err)
+		return common.NewError(http.StatusNotFound, "존재하지 않는 팀입니다", err)
+	}
+
+	member, err := uc.teamRepo.GetTeamMember(teamId, requestUserId)
+	if err != nil {
+		fmt.Printf("팀 구성원 조회 실패: %v", err)
+		return common.NewError(http.StatusInternalServerError, "팀 구성원 조회 실패", err)
+	}
+	if member == nil {
+		fmt.Printf("팀 구성원이 아닙니다: 사용자 ID %d, 팀 ID %d", requestUserId, teamId)
+		return common.NewError(http.StatusForbidden, "팀 구성원만 접근할 수 있습니다", nil)
+	}
 	return nil
 }
@@ -3,6 +3,8 @@ package usecase
 import (
 	"encoding/json"
 	"fmt"
+	_companyEntity "link/internal/company/entity"
+	_companyRepo "link/internal/company/repository"
 	"link/internal/project/entity"
 	_projectRepo "link/internal/project/repository"
 	_userRepo "link/internal/user/repository"
@@ -21,10 +23,10 @@ import (
 )
 
 type ProjectUsecase interface {
-	CreateProject(userId uint, request *req.CreateProjectRequest) error
-	GetProjects(userId uint, queryParams req.GetProjectsQueryParams) (*res.GetProjectsResponse, error)
-	GetProject(userId uint, projectID uint) (*res.GetProjectResponse, error)
-	GetProjectUsers(userId uint, projectID uint) (*res.GetProjectUsersResponse, error)
+	CreateProject(userId uint, companyId uint, request *req.CreateProjectRequest) error
+	GetProjects(userId uint, companyId uint, queryParams req.GetProjectsQueryParams) (*res.GetProjectsResponse, error)
+	GetProject(userId uint, companyId uint, projectID uint) (*res.GetProjectResponse, error)
+	GetProjectUsers(userId uint, companyId uint, projectID uint) (*res.GetProjectUsersResponse, error)
 	InviteProject(senderId uint, request *req.InviteProjectRequest) (*res.CreateNotificationResponse, error)
 	UpdateProject(userId uint, request *req.UpdateProjectRequest) error
 	DeleteProject(userId uint, projectID uint) error
@@ -35,22 +37,26 @@ type ProjectUsecase interface {
 type projectUsecase struct {
 	projectRepo   _projectRepo.ProjectRepository
 	userRepo      _userRepo.UserRepository
+	companyRepo   _companyRepo.CompanyRepository
 	natsPublisher *_nats.NatsPublisher
 }
 
 func NewProjectUsecase(
 	projectRepo _projectRepo.ProjectRepository,
 	userRepo _userRepo.UserRepository,
+	companyRepo _companyRepo.CompanyRepository,
 	natsPublisher *_nats.NatsPublisher) ProjectUsecase {
 	return &projectUsecase{
 		projectRepo:   projectRepo,
 		userRepo:      userRepo,
+		companyRepo:   companyRepo,
 		natsPublisher: natsPublisher,
 	}
 }
 
-func (u *projectUsecase) CreateProject(userId uint, request *req.CreateProjectRequest) error {
-	user, err := u.userRepo.GetUserByID(userId)
+func (u *projectUsecase) CreateProject(userId uint, activeCompanyId uint, request *req.CreateProjectRequest) error {
+	// 회사 프로젝트는 활성 회사에 생성
+	user, err := u.userRepo.GetUserByIDInCompany(userId, activeCompanyId)
 	if err != nil {
 		log.Printf("사용자 조회 실패: %v", err)
 		return common.NewError(http.StatusBadRequest, "사용자가 없습니다", err)
@@ -85,6 +91,9 @@ func (u *projectUsecase) CreateProject(userId uint, request *req.CreateProjectRe
 	if request.Category != nil && strings.ToLower(*request.Category) == "company" {
 		if user.UserProfile.CompanyID != nil {
 			project.CompanyID = *user.UserProfile.CompanyID
+			if err := u.checkProjectQuota(project.CompanyID); err != nil {
+				return err
+			}
 		} else {
 			return common.NewError(http.StatusBadRequest, "해당 사용자가 소속된 회사가 없습니다.", nil)
 		}
@@ -101,9 +110,31 @@ func (u *projectUsecase) CreateProject(userId uint, request *req.CreateProjectRe
 	return nil
 }
 
-func (u *projectUsecase) GetProjects(userId uint, queryParams req.GetProjectsQueryParams) (*res.GetProjectsResponse, error) {
-	// 사용자 조회
-	user, err := u.userRepo.GetUserByID(userId)
+// TODO 회사 요금제 최대 프로젝트 수 확인
+func (u *projectUsecase) checkProjectQuota(companyId uint) error {
+	company, err := u.companyRepo.GetCompanyByID(companyId)
+	if err != nil {
+		log.Printf("회사 조회 실패: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 조회 실패", err)
+	}
+
+	usage, err := u.companyRepo.GetCompanyUsage(companyId)
+	if err != nil {
+		log.Printf("회사 사용량 조회 실패: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 사용량 조회 실패", err)
+	}
+
+	plan := _companyEntity.GetCompanyPlan(company.Grade)
+	if _companyEntity.IsPlanLimitExceeded(plan.MaxProjects, usage.ProjectCount, 1) {
+		return common.NewError(http.StatusPaymentRequired, fmt.Sprintf("%s 요금제의 최대 프로젝트 수(%d개)를 초과했습니다. 상위 요금제로 변경해주세요", plan.Name, plan.MaxProjects), nil)
+	}
+
+	return nil
+}
+
+func (u *projectUsecase) GetProjects(userId uint, activeCompanyId uint, queryParams req.GetProjectsQueryParams) (*res.GetProjectsResponse, error) {
+	// 사용자 조회 - 회사 프로젝트는 활성 회사 기준
+	user, err := u.userRepo.GetUserByIDInCompany(userId, activeCompanyId)
 	if err != nil {
 		log.Printf("사용자 조회 실패: %v", err)
 		return nil, common.NewError(http.StatusBadRequest, "사용자가 없습니다", err)
@@ -180,8 +211,8 @@ func (u *projectUsecase) GetProjects(userId uint, queryParams req.GetProjectsQue
 	return &res.GetProjectsResponse{Projects: projects, Meta: responseMeta}, nil
 }
 
-func (u *projectUsecase) GetProject(userId uint, projectID uint) (*res.GetProjectResponse, error) {
-	user, err := u.userRepo.GetUserByID(userId)
+func (u *projectUsecase) GetProject(userId uint, activeCompanyId uint, projectID uint) (*res.GetProjectResponse, error) {
+	user, err := u.userRepo.GetUserByIDInCompany(userId, activeCompanyId)
 	if err != nil {
 		return nil, common.NewError(http.StatusBadRequest, "사용자 조회 실패", err)
 	}
@@ -205,8 +236,8 @@ func (u *projectUsecase) GetProject(userId uint, projectID uint) (*res.GetProjec
 	return &response, nil
 }
 
-func (u *projectUsecase) GetProjectUsers(userId uint, projectID uint) (*res.GetProjectUsersResponse, error) {
-	user, err := u.userRepo.GetUserByID(userId)
+func (u *projectUsecase) GetProjectUsers(userId uint, activeCompanyId uint, projectID uint) (*res.GetProjectUsersResponse, error) {
+	user, err := u.userRepo.GetUserByIDInCompany(userId, activeCompanyId)
 	if err != nil {
 		log.Printf("사용자 조회 실패: %v", err)
 		return nil, common.NewError(http.StatusBadRequest, "사용자 조회 실패", err)
@@ -241,7 +272,7 @@ func (u *projectUsecase) GetProjectUsers(userId uint, projectID uint) (*res.GetP
 	}
 
 	var positionName string
-	if user.UserProfile.PositionId != nil {
+	if user.UserProfile.PositionId != nil && user.UserProfile.Position != nil {
 		positionName = (*user.UserProfile.Position)["name"].(string)
 	}
 
@@ -254,7 +285,7 @@ func (u *projectUsecase) GetProjectUsers(userId uint, projectID uint) (*res.GetP
 			Phone:        _utils.GetValueOrDefault(user.Phone, ""),
 			Nickname:     _utils.GetValueOrDefault(user.Nickname, ""),
 			IsSubscribed: _utils.GetValueOrDefault(&user.UserProfile.IsSubscribed, false),
-			Image:        _utils.GetValueOrDefault(user.UserProfile.Image, ""),
+			Image:        _utils.SignProfileImageURL(_utils.GetValueOrDefault(user.UserProfile.Image, "")),
 			Birthday:     _utils.GetValueOrDefault(&user.UserProfile.Birthday, ""),
 			CompanyID:    _utils.GetValueOrDefault(user.UserProfile.CompanyID, 0),
 			CompanyName:  companyName,
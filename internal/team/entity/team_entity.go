@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+type Team struct {
+	ID             uint      `json:"id"`
+	CompanyID      uint      `json:"company_id"`
+	DepartmentID   *uint     `json:"department_id,omitempty"`
+	DepartmentName string    `json:"department_name,omitempty"`
+	Name           string    `json:"name"`
+	Handle         string    `json:"handle"`
+	Description    string    `json:"description,omitempty"`
+	ChatRoomID     *uint     `json:"chat_room_id,omitempty"`
+	MemberCount    int       `json:"member_count"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type TeamMember struct {
+	UserID   uint      `json:"user_id"`
+	Name     string    `json:"name"`
+	Nickname string    `json:"nickname"`
+	Email    string    `json:"email"`
+	Image    *string   `json:"image,omitempty"`
+	IsLead   bool      `json:"is_lead"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// TODO 팀 핸들 - 영문 소문자, 숫자, 하이픈만 (예: backend-team)
+var teamHandlePattern = regexp.MustCompile(`^[a-z0-9](?:[a-z0-9-]{0,48}[a-z0-9])?$`)
+
+// TODO 본문에서 @핸들 추출
+var teamMentionPattern = regexp.MustCompile(`(?:^|[^\w@])@([a-z0-9](?:[a-z0-9-]{0,48}[a-z0-9])?)`)
+
+func NormalizeTeamHandle(handle string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(handle), "@"))
+}
+
+func IsValidTeamHandle(handle string) bool {
+	return teamHandlePattern.MatchString(handle)
+}
+
+// ParseTeamMentions 본문에 있는 @핸들 목록 (중복 제거)
+func ParseTeamMentions(content string) []string {
+	handles := []string{}
+	seen := map[string]bool{}
+	for _, match := range teamMentionPattern.FindAllStringSubmatch(strings.ToLower(content), -1) {
+		if seen[match[1]] {
+			continue
+		}
+		seen[match[1]] = true
+		handles = append(handles, match[1])
+	}
+	return handles
+}
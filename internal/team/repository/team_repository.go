@@ -0,0 +1,26 @@
+package repository
+
+import "link/internal/team/entity"
+
+type TeamRepository interface {
+	//TODO 팀 생성 시 팀 채팅방도 같이 생성
+	CreateTeam(team *entity.Team, memberIds []uint, leadIds []uint) error
+	GetTeamByID(companyId uint, teamId uint) (*entity.Team, error)
+	GetTeamByHandle(companyId uint, handle string) (*entity.Team, error)
+	GetTeams(companyId uint) ([]entity.Team, error)
+	GetTeamIdsByUser(companyId uint, userId uint) ([]uint, error)
+	ExistsTeamHandle(companyId uint, handle string, excludeTeamId uint) (bool, error)
+	UpdateTeam(companyId uint, teamId uint, updates map[string]interface{}) error
+	DeleteTeam(companyId uint, teamId uint) error
+
+	//TODO 팀 구성원 - 팀 채팅방 참여자도 같이 변경
+	GetTeamMembers(teamId uint) ([]entity.TeamMember, error)
+	GetTeamMemberIds(teamId uint) ([]uint, error)
+	GetTeamMember(teamId uint, userId uint) (*entity.TeamMember, error)
+	AddTeamMembers(teamId uint, userIds []uint, isLead bool) error
+	UpdateTeamMemberLead(teamId uint, userId uint, isLead bool) error
+	RemoveTeamMember(teamId uint, userId uint) error
+
+	//TODO 회사 구성원인 사용자만 추림
+	GetCompanyMemberIds(companyId uint, userIds []uint) ([]uint, error)
+}
@@ -0,0 +1,433 @@
+package usecase
+
+import (
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	_companyEntity "link/internal/company/entity"
+	_departmentRepo "link/internal/department/repository"
+	"link/internal/team/entity"
+	_teamRepo "link/internal/team/repository"
+	_userEntity "link/internal/user/entity"
+	_userRepo "link/internal/user/repository"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+	_util "link/pkg/util"
+)
+
+type TeamUsecase interface {
+	//TODO 회사 관리자(Role 3,4) 또는 MANAGE_DEPARTMENTS 권한 - 활성 회사 기준
+	CreateTeam(requestUserId uint, companyId uint, request req.CreateTeamRequest) (*res.TeamResponse, error)
+	DeleteTeam(requestUserId uint, companyId uint, teamId uint) error
+
+	//TODO 회사 구성원 누구나 조회
+	GetTeams(requestUserId uint, companyId uint) ([]res.GetTeamsByCompanyResponse, error)
+	GetTeam(requestUserId uint, companyId uint, teamId uint) (*res.TeamResponse, error)
+
+	//TODO 회사 관리자 또는 팀장
+	UpdateTeam(requestUserId uint, companyId uint, teamId uint, request req.UpdateTeamRequest) (*res.TeamResponse, error)
+	AddTeamMembers(requestUserId uint, companyId uint, teamId uint, request req.AddTeamMembersRequest) error
+	UpdateTeamMember(requestUserId uint, companyId uint, teamId uint, targetUserId uint, request req.UpdateTeamMemberRequest) error
+	RemoveTeamMember(requestUserId uint, companyId uint, teamId uint, targetUserId uint) error // 본인은 팀 나가기
+}
+
+type teamUsecase struct {
+	teamRepository       _teamRepo.TeamRepository
+	userRepository       _userRepo.UserRepository
+	departmentRepository _departmentRepo.DepartmentRepository
+}
+
+func NewTeamUsecase(
+	teamRepository _teamRepo.TeamRepository,
+	userRepository _userRepo.UserRepository,
+	departmentRepository _departmentRepo.DepartmentRepository) TeamUsecase {
+	return &teamUsecase{
+		teamRepository:       teamRepository,
+		userRepository:       userRepository,
+		departmentRepository: departmentRepository,
+	}
+}
+
+// TODO 팀 생성 - 팀 채팅방 자동 생성
+func (u *teamUsecase) CreateTeam(requestUserId uint, companyId uint, request req.CreateTeamRequest) (*res.TeamResponse, error) {
+	requestUser, err := u.getCompanyUser(requestUserId, companyId)
+	if err != nil {
+		return nil, err
+	}
+	companyId = *requestUser.UserProfile.CompanyID
+
+	if err := u.checkTeamManager(requestUser); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		return nil, common.NewError(http.StatusBadRequest, "팀 이름을 입력해주세요", nil)
+	}
+	handle, err := u.checkTeamHandle(companyId, request.Handle, 0)
+	if err != nil {
+		return nil, err
+	}
+	if request.DepartmentID != nil {
+		if _, err := u.departmentRepository.GetDepartmentByID(companyId, *request.DepartmentID); err != nil {
+			log.Printf("부서 조회에 실패했습니다: %v", err)
+			return nil, common.NewError(http.StatusBadRequest, "존재하지 않는 부서입니다", err)
+		}
+	}
+
+	memberIds := uniqueIds(append(append([]uint{}, request.MemberIds...), request.LeadIds...))
+	if err := u.checkCompanyMembers(companyId, memberIds); err != nil {
+		return nil, err
+	}
+
+	team := &entity.Team{
+		CompanyID:    companyId,
+		DepartmentID: request.DepartmentID,
+		Name:         name,
+		Handle:       handle,
+		Description:  strings.TrimSpace(request.Description),
+	}
+	if err := u.teamRepository.CreateTeam(team, memberIds, uniqueIds(request.LeadIds)); err != nil {
+		log.Printf("팀 생성에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "팀 생성에 실패했습니다", err)
+	}
+
+	return u.getTeamResponse(requestUserId, companyId, team.ID)
+}
+
+// TODO 팀 목록 - 이름순
+func (u *teamUsecase) GetTeams(requestUserId uint, companyId uint) ([]res.GetTeamsByCompanyResponse, error) {
+	requestUser, err := u.getCompanyUser(requestUserId, companyId)
+	if err != nil {
+		return nil, err
+	}
+	companyId = *requestUser.UserProfile.CompanyID
+
+	teams, err := u.teamRepository.GetTeams(companyId)
+	if err != nil {
+		log.Printf("팀 목록 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "팀 목록 조회에 실패했습니다", err)
+	}
+
+	myTeamIds, err := u.teamRepository.GetTeamIdsByUser(companyId, requestUserId)
+	if err != nil {
+		log.Printf("사용자 팀 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "사용자 팀 조회에 실패했습니다", err)
+	}
+
+	response := make([]res.GetTeamsByCompanyResponse, len(teams))
+	for i, team := range teams {
+		response[i] = toTeamSummaryResponse(team, slices.Contains(myTeamIds, team.ID))
+	}
+	return response, nil
+}
+
+// TODO 팀 상세 - 팀장부터
+func (u *teamUsecase) GetTeam(requestUserId uint, companyId uint, teamId uint) (*res.TeamResponse, error) {
+	requestUser, err := u.getCompanyUser(requestUserId, companyId)
+	if err != nil {
+		return nil, err
+	}
+	return u.getTeamResponse(requestUserId, *requestUser.UserProfile.CompanyID, teamId)
+}
+
+// TODO 팀 수정 - 이름이 바뀌면 팀 채팅방 이름도 변경
+func (u *teamUsecase) UpdateTeam(requestUserId uint, companyId uint, teamId uint, request req.UpdateTeamRequest) (*res.TeamResponse, error) {
+	companyId, _, err := u.getTeamManager(requestUserId, companyId, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if request.Name != nil {
+		name := strings.TrimSpace(*request.Name)
+		if name == "" {
+			return nil, common.NewError(http.StatusBadRequest, "팀 이름을 입력해주세요", nil)
+		}
+		updates["name"] = name
+	}
+	if request.Handle != nil {
+		handle, err := u.checkTeamHandle(companyId, *request.Handle, teamId)
+		if err != nil {
+			return nil, err
+		}
+		updates["handle"] = handle
+	}
+	if request.Description != nil {
+		updates["description"] = strings.TrimSpace(*request.Description)
+	}
+	if request.ClearDepartment {
+		updates["department_id"] = nil
+	} else if request.DepartmentID != nil {
+		if _, err := u.departmentRepository.GetDepartmentByID(companyId, *request.DepartmentID); err != nil {
+			log.Printf("부서 조회에 실패했습니다: %v", err)
+			return nil, common.NewError(http.StatusBadRequest, "존재하지 않는 부서입니다", err)
+		}
+		updates["department_id"] = *request.DepartmentID
+	}
+
+	if len(updates) > 0 {
+		if err := u.teamRepository.UpdateTeam(companyId, teamId, updates); err != nil {
+			log.Printf("팀 수정에 실패했습니다: %v", err)
+			return nil, common.NewError(http.StatusInternalServerError, "팀 수정에 실패했습니다", err)
+		}
+	}
+
+	return u.getTeamResponse(requestUserId, companyId, teamId)
+}
+
+// TODO 팀 삭제 - 팀 게시물과 팀 채팅방도 같이 삭제
+func (u *teamUsecase) DeleteTeam(requestUserId uint, companyId uint, teamId uint) error {
+	requestUser, err := u.getCompanyUser(requestUserId, companyId)
+	if err != nil {
+		return err
+	}
+	companyId = *requestUser.UserProfile.CompanyID
+
+	if err := u.checkTeamManager(requestUser); err != nil {
+		return err
+	}
+	if _, err := u.getTeam(companyId, teamId); err != nil {
+		return err
+	}
+
+	if err := u.teamRepository.DeleteTeam(companyId, teamId); err != nil {
+		log.Printf("팀 삭제에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "팀 삭제에 실패했습니다", err)
+	}
+	return nil
+}
+
+// TODO 팀 구성원 추가 - 같은 회사 구성원만, 팀 채팅방에도 참여
+func (u *teamUsecase) AddTeamMembers(requestUserId uint, companyId uint, teamId uint, request req.AddTeamMembersRequest) error {
+	companyId, _, err := u.getTeamManager(requestUserId, companyId, teamId)
+	if err != nil {
+		return err
+	}
+
+	userIds := uniqueIds(request.UserIds)
+	if err := u.checkCompanyMembers(companyId, userIds); err != nil {
+		return err
+	}
+
+	if err := u.teamRepository.AddTeamMembers(teamId, userIds, request.IsLead); err != nil {
+		log.Printf("팀 구성원 추가에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "팀 구성원 추가에 실패했습니다", err)
+	}
+	return nil
+}
+
+// TODO 팀장 지정, 해제
+func (u *teamUsecase) UpdateTeamMember(requestUserId uint, companyId uint, teamId uint, targetUserId uint, request req.UpdateTeamMemberRequest) error {
+	if _, _, err := u.getTeamManager(requestUserId, companyId, teamId); err != nil {
+		return err
+	}
+	if _, err := u.getTeamMember(teamId, targetUserId); err != nil {
+		return err
+	}
+
+	if err := u.teamRepository.UpdateTeamMemberLead(teamId, targetUserId, request.IsLead); err != nil {
+		log.Printf("팀장 변경에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "팀장 변경에 실패했습니다", err)
+	}
+	return nil
+}
+
+// TODO 팀 구성원 제외 - 본인은 언제든 나갈 수 있음
+func (u *teamUsecase) RemoveTeamMember(requestUserId uint, companyId uint, teamId uint, targetUserId uint) error {
+	if requestUserId == targetUserId {
+		requestUser, err := u.getCompanyUser(requestUserId, companyId)
+		if err != nil {
+			return err
+		}
+		if _, err := u.getTeam(*requestUser.UserProfile.CompanyID, teamId); err != nil {
+			return err
+		}
+	} else if _, _, err := u.getTeamManager(requestUserId, companyId, teamId); err != nil {
+		return err
+	}
+
+	if _, err := u.getTeamMember(teamId, targetUserId); err != nil {
+		return err
+	}
+
+	if err := u.teamRepository.RemoveTeamMember(teamId, targetUserId); err != nil {
+		log.Printf("팀 구성원 제외에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "팀 구성원 제외에 실패했습니다", err)
+	}
+	return nil
+}
+
+func (u *teamUsecase) getCompanyUser(requestUserId uint, companyId uint) (*_userEntity.User, error) {
+	requestUser, err := u.userRepository.GetUserByIDInCompany(requestUserId, companyId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "사용자 조회에 실패했습니다", err)
+	}
+	if requestUser.UserProfile == nil || requestUser.UserProfile.CompanyID == nil || *requestUser.UserProfile.CompanyID == 0 {
+		return nil, common.NewError(http.StatusBadRequest, "사용자가 소속된 회사가 없습니다", nil)
+	}
+	return requestUser, nil
+}
+
+// 회사 관리자(Role 3,4) 또는 MANAGE_DEPARTMENTS 권한이 있는 회사 역할
+func (u *teamUsecase) checkTeamManager(requestUser *_userEntity.User) error {
+	if requestUser.Role <= _userEntity.RoleCompanySubManager {
+		return nil
+	}
+
+	permissions, err := u.userRepository.GetCompanyPermissions(*requestUser.ID, *requestUser.UserProfile.CompanyID)
+	if err != nil {
+		log.Printf("회사 역할 권한 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 역할 권한 조회에 실패했습니다", err)
+	}
+	if !slices.Contains(permissions, _companyEntity.PermissionManageDepartments) {
+		log.Printf("권한이 없는 사용자가 팀을 관리하려 했습니다: 사용자 ID %d", *requestUser.ID)
+		return common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+	}
+	return nil
+}
+
+// 회사 관리자이거나 해당 팀의 팀장
+func (u *teamUsecase) getTeamManager(requestUserId uint, companyId uint, teamId uint) (uint, *entity.Team, error) {
+	requestUser, err := u.getCompanyUser(requestUserId, companyId)
+	if err != nil {
+		return 0, nil, err
+	}
+	companyId = *requestUser.UserProfile.CompanyID
+
+	team, err := u.getTeam(companyId, teamId)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	member, err := u.teamRepository.GetTeamMember(teamId, requestUserId)
+	if err != nil {
+		log.Printf("팀 구성원 조회에 실패했습니다: %v", err)
+		return 0, nil, common.NewError(http.StatusInternalServerError, "팀 구성원 조회에 실패했습니다", err)
+	}
+	if member != nil && member.IsLead {
+		return companyId, team, nil
+	}
+
+	if err := u.checkTeamManager(requestUser); err != nil {
+		return 0, nil, err
+	}
+	return companyId, team, nil
+}
+
+func (u *teamUsecase) getTeam(companyId uint, teamId uint) (*entity.Team, error) {
+	team, err := u.teamRepository.GetTeamByID(companyId, teamId)
+	if err != nil {
+		log.Printf("팀 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 팀입니다", err)
+	}
+	return team, nil
+}
+
+func (u *teamUsecase) getTeamMember(teamId uint, userId uint) (*entity.TeamMember, error) {
+	member, err := u.teamRepository.GetTeamMember(teamId, userId)
+	if err != nil {
+		log.Printf("팀 구성원 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "팀 구성원 조회에 실패했습니다", err)
+	}
+	if member == nil {
+		return nil, common.NewError(http.StatusNotFound, "팀 구성원이 아닙니다", nil)
+	}
+	return member, nil
+}
+
+func (u *teamUsecase) checkTeamHandle(companyId uint, handle string, excludeTeamId uint) (string, error) {
+	handle = entity.NormalizeTeamHandle(handle)
+	if !entity.IsValidTeamHandle(handle) {
+		return "", common.NewError(http.StatusBadRequest, "팀 핸들은 영문 소문자, 숫자, 하이픈만 사용할 수 있습니다", nil)
+	}
+
+	exists, err := u.teamRepository.ExistsTeamHandle(companyId, handle, excludeTeamId)
+	if err != nil {
+		log.Printf("팀 핸들 조회에 실패했습니다: %v", err)
+		return "", common.NewError(http.StatusInternalServerError, "팀 핸들 조회에 실패했습니다", err)
+	}
+	if exists {
+		return "", common.NewError(http.StatusConflict, "이미 사용 중인 팀 핸들입니다", nil)
+	}
+	return handle, nil
+}
+
+func (u *teamUsecase) checkCompanyMembers(companyId uint, userIds []uint) error {
+	memberIds, err := u.teamRepository.GetCompanyMemberIds(companyId, userIds)
+	if err != nil {
+		log.Printf("회사 구성원 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "회사 구성원 조회에 실패했습니다", err)
+	}
+	for _, userId := range userIds {
+		if !slices.Contains(memberIds, userId) {
+			return common.NewError(http.StatusBadRequest, "같은 회사 구성원만 팀에 추가할 수 있습니다", nil)
+		}
+	}
+	return nil
+}
+
+func (u *teamUsecase) getTeamResponse(requestUserId uint, companyId uint, teamId uint) (*res.TeamResponse, error) {
+	team, err := u.getTeam(companyId, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := u.teamRepository.GetTeamMembers(teamId)
+	if err != nil {
+		log.Printf("팀 구성원 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "팀 구성원 조회에 실패했습니다", err)
+	}
+
+	isMember := false
+	memberResponses := make([]res.TeamMemberResponse, len(members))
+	for i, member := range members {
+		if member.UserID == requestUserId {
+			isMember = true
+		}
+		memberResponses[i] = res.TeamMemberResponse{
+			UserID:   member.UserID,
+			Name:     member.Name,
+			Nickname: member.Nickname,
+			Email:    member.Email,
+			Image:    _util.SignProfileImageURL(_util.GetValueOrDefault(member.Image, "")),
+			IsLead:   member.IsLead,
+			JoinedAt: _util.ParseKst(member.JoinedAt).Format(time.DateTime),
+		}
+	}
+
+	return &res.TeamResponse{
+		GetTeamsByCompanyResponse: toTeamSummaryResponse(*team, isMember),
+		Members:                   memberResponses,
+	}, nil
+}
+
+func toTeamSummaryResponse(team entity.Team, isMember bool) res.GetTeamsByCompanyResponse {
+	return res.GetTeamsByCompanyResponse{
+		ID:             team.ID,
+		Name:           team.Name,
+		Handle:         team.Handle,
+		Description:    team.Description,
+		DepartmentID:   team.DepartmentID,
+		DepartmentName: team.DepartmentName,
+		ChatRoomID:     team.ChatRoomID,
+		MemberCount:    team.MemberCount,
+		IsMember:       isMember,
+		CreatedAt:      _util.ParseKst(team.CreatedAt).Format(time.DateTime),
+	}
+}
+
+func uniqueIds(ids []uint) []uint {
+	result := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(result, id) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// TODO 회사별 소속 정보 - 회사마다 역할, 부서, 직책이 다름
+type CompanyMembership struct {
+	UserID       uint                      `json:"user_id"`
+	CompanyID    uint                      `json:"company_id"`
+	CompanyName  string                    `json:"company_name,omitempty"`
+	Role         UserRole                  `json:"role"`
+	PositionID   *uint                     `json:"position_id,omitempty"`
+	PositionName string                    `json:"position_name,omitempty"`
+	EntryDate    *time.Time                `json:"entry_date,omitempty"`
+	Departments  []*map[string]interface{} `json:"departments,omitempty"`
+	IsPrimary    bool                      `json:"is_primary"` // user_profiles.company_id 와 같은 회사
+	CreatedAt    time.Time                 `json:"created_at"`
+
+	//TODO 회사에서 정의한 역할
+	CustomRoleID   *uint  `json:"custom_role_id,omitempty"`
+	CustomRoleName string `json:"custom_role_name,omitempty"`
+
+	//TODO 회사 내 상급자
+	ManagerID *uint `json:"manager_id,omitempty"`
+}
+
+// ApplyCompanyMembership 사용자 정보를 해당 회사 기준으로 변경
+// membership이 nil이면 회사 소속 정보를 비움, 운영자(Role 1,2)는 Role 유지
+func (u *User) ApplyCompanyMembership(membership *CompanyMembership) {
+	if u.UserProfile == nil {
+		u.UserProfile = &UserProfile{}
+	}
+
+	if membership == nil {
+		u.UserProfile.CompanyID = nil
+		u.UserProfile.Company = nil
+		u.UserProfile.Departments = nil
+		u.UserProfile.PositionId = nil
+		u.UserProfile.Position = nil
+		if u.Role > RoleSubAdmin {
+			u.Role = RoleUser
+		}
+		return
+	}
+
+	companyID := membership.CompanyID
+	u.UserProfile.CompanyID = &companyID
+	u.UserProfile.Company = &map[string]interface{}{
+		"id":   membership.CompanyID,
+		"name": membership.CompanyName,
+	}
+	u.UserProfile.Departments = membership.Departments
+	u.UserProfile.PositionId = membership.PositionID
+	u.UserProfile.Position = &map[string]interface{}{
+		"name": membership.PositionName,
+	}
+	if membership.EntryDate != nil {
+		u.UserProfile.EntryDate = membership.EntryDate
+	}
+	if u.Role > RoleSubAdmin {
+		u.Role = membership.Role
+	}
+}
+
+// HasCompanyPermission 운영자와 회사 관리자(Role 1~4)는 모든 권한, 그 외에는 회사 역할에 부여된 권한만
+// GetUserByIDInCompany로 조회한 사용자 기준, getPermissions는 관리자가 아닐 때만 호출됨
+func (u *User) HasCompanyPermission(permission string, getPermissions func(userId uint, companyId uint) ([]string, error)) (bool, error) {
+	if u.Role <= RoleCompanySubManager {
+		return true, nil
+	}
+	if u.ID == nil || u.UserProfile == nil || u.UserProfile.CompanyID == nil {
+		return false, nil
+	}
+
+	permissions, err := getPermissions(*u.ID, *u.UserProfile.CompanyID)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(permissions, permission), nil
+}
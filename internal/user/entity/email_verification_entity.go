@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// TODO 이메일 소유 확인 토큰
+type EmailVerification struct {
+	UserID     uint       `json:"user_id"`
+	Email      string     `json:"email"`
+	Token      string     `json:"-"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+}
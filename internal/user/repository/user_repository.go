@@ -22,8 +22,20 @@ type UserRepository interface {
 	GetUsersByCompany(companyId uint, query *entity.UserQueryOptions) ([]entity.User, error)
 	GetUsersIdsByCompany(companyId uint) ([]uint, error)
 	UpdateUserDepartments(userId uint, departmentIds []uint) error
+
+	//TODO 회사 소속 관련 (다중 회사)
+	GetUserByIDInCompany(id uint, companyId uint) (*entity.User, error)
+	CreateCompanyMembership(membership *entity.CompanyMembership) error
+	GetCompanyMembership(userId uint, companyId uint) (*entity.CompanyMembership, error)
+	GetCompanyMemberships(userId uint) ([]entity.CompanyMembership, error)
+	GetCompanyPermissions(userId uint, companyId uint) ([]string, error)
 	// GetOrganizationByCompany(companyId uint) ([]entity.User, error)
 
+	//TODO 이메일 소유 확인
+	SaveEmailVerification(verification *entity.EmailVerification) error
+	ConfirmEmailVerification(token string) (*entity.EmailVerification, error)
+	IsEmailVerified(userId uint, email string) (bool, error)
+
 	//관리자 관련
 	AdminSearchUser(searchTerm string) ([]entity.User, error)
 	//TODO 부서
@@ -4,10 +4,14 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"os"
 	"strconv"
+	"strings"
 	"time"
 
+	_companyEntity "link/internal/company/entity"
 	_companyRepo "link/internal/company/repository"
+	_companyUsecase "link/internal/company/usecase"
 	"link/internal/user/entity"
 	_userRepo "link/internal/user/repository"
 	"link/pkg/common"
@@ -16,23 +20,28 @@ import (
 	_utils "link/pkg/util"
 )
 
+const emailVerificationExp = 24 * time.Hour
+
 // UserUsecase 인터페이스 정의
 type UserUsecase interface {
 	RegisterUser(request *req.RegisterUserRequest) (*res.RegisterUserResponse, error)
 	ValidateEmail(email string) error
+	VerifyEmail(token string) (*res.VerifyEmailResponse, error)
+	ResendEmailVerification(userId uint) error
 	ValidateNickname(nickname string) error
 	GetUserInfo(targetUserId, requestUserId uint, role string) (*res.GetUserByIdResponse, error)
 	GetUserMyInfo(userId uint) (*entity.User, error)
 
 	UpdateUserInfo(requestUserId, targetUserId uint, request *req.UpdateUserRequest) error
 	DeleteUser(targetUserId, requestUserId uint) error
-	SearchUser(requestUserId uint, searchTerm string) ([]res.SearchUserResponse, error)
+	SearchUser(requestUserId uint, companyId uint, searchTerm string) ([]res.SearchUserResponse, error)
 
 	UpdateUserOnlineStatus(userId uint, online bool) error
 
 	//TODO 복합 관련
-	GetUsersByCompany(requestUserId uint, query *req.UserQuery) ([]res.GetUserByIdResponse, error)
+	GetUsersByCompany(requestUserId uint, companyId uint, query *req.UserQuery) ([]res.GetUserByIdResponse, error)
 	GetUsersByDepartment(departmentId uint) ([]entity.User, error)
+	GetUserCompanies(userId uint, activeCompanyId uint) ([]res.UserCompanyResponse, error)
 	// GetOrganizationByCompany(requestUserId uint) ([]res.GetUserByIdResponse, error)
 
 	//TODO 통계 관련
@@ -40,13 +49,15 @@ type UserUsecase interface {
 }
 
 type userUsecase struct {
-	userRepo    _userRepo.UserRepository
-	companyRepo _companyRepo.CompanyRepository
+	userRepo               _userRepo.UserRepository
+	companyRepo            _companyRepo.CompanyRepository
+	companyEmailDomainRepo _companyRepo.CompanyEmailDomainRepository
+	companyPlanUsecase     _companyUsecase.CompanyPlanUsecase
 }
 
 // NewUserUsecase 생성자
-func NewUserUsecase(repo _userRepo.UserRepository, companyRepo _companyRepo.CompanyRepository) UserUsecase {
-	return &userUsecase{userRepo: repo, companyRepo: companyRepo}
+func NewUserUsecase(repo _userRepo.UserRepository, companyRepo _companyRepo.CompanyRepository, companyEmailDomainRepo _companyRepo.CompanyEmailDomainRepository, companyPlanUsecase _companyUsecase.CompanyPlanUsecase) UserUsecase {
+	return &userUsecase{userRepo: repo, companyRepo: companyRepo, companyEmailDomainRepo: companyEmailDomainRepo, companyPlanUsecase: companyPlanUsecase}
 }
 
 // TODO 사용자 생성 - 무조건 일반 사용자
@@ -83,9 +94,147 @@ func (u *userUsecase) RegisterUser(request *req.RegisterUserRequest) (*res.Regis
 		Role:     uint(_utils.GetValueOrDefault(&user.Role, entity.RoleUser)),
 	}
 
+	//TODO 이메일 도메인 가입은 이메일 확인 후에 처리 (메일 전송에 실패해도 회원가입은 유지)
+	if err := u.sendEmailVerification(user); err != nil {
+		log.Printf("이메일 확인 메일 전송에 실패했습니다: %v", err)
+	} else {
+		response.EmailVerificationSent = true
+	}
+
 	return &response, nil
 }
 
+// TODO 이메일 확인 - 확인된 이메일 도메인을 인증한 회사가 있으면 정책에 따라 자동 가입 또는 가입 제안
+func (u *userUsecase) VerifyEmail(token string) (*res.VerifyEmailResponse, error) {
+	verification, err := u.userRepo.ConfirmEmailVerification(strings.TrimSpace(token))
+	if err != nil {
+		log.Printf("이메일 확인 처리에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "이메일 확인에 실패했습니다", err)
+	}
+	if verification == nil {
+		return nil, common.NewError(http.StatusBadRequest, "유효하지 않거나 만료된 인증 토큰입니다", nil)
+	}
+
+	user, err := u.userRepo.GetUserByID(verification.UserID)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "사용자를 찾을 수 없습니다", err)
+	}
+
+	//TODO 토큰을 보낸 뒤 이메일을 바꿨으면 바뀐 주소로 다시 확인해야 함
+	if !strings.EqualFold(_utils.GetValueOrDefault(user.Email, ""), verification.Email) {
+		return nil, common.NewError(http.StatusBadRequest, "이메일이 변경되었습니다. 확인 메일을 다시 요청해주세요", nil)
+	}
+
+	response := &res.VerifyEmailResponse{Email: verification.Email}
+	u.joinCompanyByEmailDomain(user, response)
+
+	return response, nil
+}
+
+// TODO 이메일 확인 메일 재발송 - 이미 현재 주소를 확인했으면 불가
+func (u *userUsecase) ResendEmailVerification(userId uint) error {
+	user, err := u.userRepo.GetUserByID(userId)
+	if err != nil {
+		log.Printf("사용자 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusNotFound, "사용자를 찾을 수 없습니다", err)
+	}
+
+	verified, err := u.userRepo.IsEmailVerified(userId, _utils.GetValueOrDefault(user.Email, ""))
+	if err != nil {
+		log.Printf("이메일 확인 여부 조회에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "이메일 확인 여부 조회에 실패했습니다", err)
+	}
+	if verified {
+		return common.NewError(http.StatusConflict, "이미 확인된 이메일입니다", nil)
+	}
+
+	if err := u.sendEmailVerification(user); err != nil {
+		log.Printf("이메일 확인 메일 전송에 실패했습니다: %v", err)
+		return common.NewError(http.StatusInternalServerError, "이메일 확인 메일 전송에 실패했습니다", err)
+	}
+	return nil
+}
+
+// 새 토큰을 저장하고 사용자 이메일로 확인 링크 전송
+func (u *userUsecase) sendEmailVerification(user *entity.User) error {
+	token, err := _utils.GenerateVerificationToken()
+	if err != nil {
+		return err
+	}
+
+	email := _utils.GetValueOrDefault(user.Email, "")
+	err = u.userRepo.SaveEmailVerification(&entity.EmailVerification{
+		UserID:    *user.ID,
+		Email:     email,
+		Token:     token,
+		ExpiresAt: time.Now().Add(emailVerificationExp),
+	})
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s?token=%s", os.Getenv("EMAIL_VERIFICATION_URL"), token)
+	body := fmt.Sprintf("아래 링크에서 이메일을 확인해주세요. 링크는 %d시간 동안 유효합니다.\n\n%s", int(emailVerificationExp.Hours()), link)
+	return _utils.SendMail(email, "[Link] 이메일 확인", body)
+}
+
+func (u *userUsecase) joinCompanyByEmailDomain(user *entity.User, response *res.VerifyEmailResponse) {
+	domain := _companyEntity.EmailDomainOf(_utils.GetValueOrDefault(user.Email, ""))
+	if domain == "" {
+		return
+	}
+
+	emailDomain, err := u.companyEmailDomainRepo.GetVerifiedCompanyEmailDomain(domain)
+	if err != nil {
+		log.Printf("이메일 도메인 조회에 실패했습니다: %v", err)
+		return
+	}
+	if emailDomain == nil {
+		return
+	}
+
+	membership, err := u.userRepo.GetCompanyMembership(*user.ID, emailDomain.CompanyID)
+	if err != nil {
+		log.Printf("회사 소속 조회에 실패했습니다: %v", err)
+		return
+	}
+	if membership != nil {
+		return
+	}
+
+	company := &res.EmailDomainCompanyResponse{
+		CompanyID:   emailDomain.CompanyID,
+		CompanyName: emailDomain.CompanyName,
+		Domain:      emailDomain.Domain,
+		JoinPolicy:  emailDomain.JoinPolicy,
+	}
+	response.SuggestedCompany = company
+
+	if emailDomain.JoinPolicy != _companyEntity.EmailDomainJoinAuto {
+		return
+	}
+
+	// 요금제 인원이 가득 찬 경우 가입 제안으로 대신함
+	if err := u.companyPlanUsecase.CheckMemberQuota(emailDomain.CompanyID); err != nil {
+		log.Printf("요금제 최대 인원 확인으로 자동 가입하지 않습니다: 회사 ID %d, %v", emailDomain.CompanyID, err)
+		return
+	}
+
+	err = u.userRepo.CreateCompanyMembership(&entity.CompanyMembership{
+		UserID:    *user.ID,
+		CompanyID: emailDomain.CompanyID,
+		Role:      entity.RoleUser,
+	})
+	if err != nil {
+		log.Printf("이메일 도메인 자동 가입에 실패했습니다: %v", err)
+		return
+	}
+
+	response.JoinedCompany = company
+	response.SuggestedCompany = nil
+}
+
 // TODO 이메일 중복 체크
 func (u *userUsecase) ValidateEmail(email string) error {
 	user, err := u.userRepo.ValidateEmail(email)
@@ -155,7 +304,7 @@ func (u *userUsecase) GetUserInfo(requestUserId, targetUserId uint, role string)
 		Nickname:     _utils.GetValueOrDefault(targetUser.Nickname, ""),
 		Role:         uint(_utils.GetValueOrDefault(&targetUser.Role, entity.RoleUser)),
 		Status:       _utils.GetValueOrDefault(targetUser.Status, ""),
-		Image:        _utils.GetValueOrDefault(targetUser.UserProfile.Image, ""),
+		Image:        _utils.SignProfileImageURL(_utils.GetValueOrDefault(targetUser.UserProfile.Image, "")),
 		Birthday:     _utils.GetValueOrDefault(&targetUser.UserProfile.Birthday, ""),
 		IsOnline:     _utils.GetValueOrDefault(targetUser.IsOnline, false),
 		IsSubscribed: _utils.GetValueOrDefault(&targetUser.UserProfile.IsSubscribed, false),
@@ -269,6 +418,9 @@ func (u *userUsecase) UpdateUserInfo(targetUserId, requestUserId uint, request *
 	if request.Image != nil {
 		profileUpdates["image"] = *request.Image
 	}
+	if request.CelebrationOptOut != nil {
+		profileUpdates["celebration_opt_out"] = *request.CelebrationOptOut
+	}
 	//TODO db 업데이트 하고
 	err = u.userRepo.UpdateUser(targetUserId, userUpdates, profileUpdates)
 	if err != nil {
@@ -311,13 +463,17 @@ func (u *userUsecase) DeleteUser(targetUserId, requestUserId uint) error {
 }
 
 // TODO 사용자 검색
-func (u *userUsecase) SearchUser(requestUserId uint, searchTerm string) ([]res.SearchUserResponse, error) {
-	requestUser, err := u.userRepo.GetUserByID(requestUserId)
+func (u *userUsecase) SearchUser(requestUserId uint, activeCompanyId uint, searchTerm string) ([]res.SearchUserResponse, error) {
+	// 활성 회사 소속 사용자 중에서 검색
+	requestUser, err := u.userRepo.GetUserByIDInCompany(requestUserId, activeCompanyId)
 	if err != nil {
 		fmt.Printf("요청 사용자 조회에 실패했습니다: %v", err)
 		return nil, common.NewError(http.StatusInternalServerError, "요청 사용자 조회에 실패했습니다", err)
 	}
 
+	if requestUser.UserProfile.CompanyID == nil {
+		return nil, common.NewError(http.StatusBadRequest, "사용자의 회사 ID가 없습니다", nil)
+	}
 	companyId := *requestUser.UserProfile.CompanyID
 
 	users, err := u.userRepo.SearchUser(companyId, searchTerm)
@@ -342,7 +498,7 @@ func (u *userUsecase) SearchUser(requestUserId uint, searchTerm string) ([]res.S
 			Nickname:  *user.Nickname,
 			CompanyID: _utils.GetValueOrDefault(user.UserProfile.CompanyID, 0),
 			Role:      uint(user.Role),
-			Image:     user.UserProfile.Image,
+			Image:     _utils.SignProfileImageURLPtr(user.UserProfile.Image),
 			EntryDate: user.UserProfile.EntryDate,
 			CreatedAt: *user.CreatedAt,
 			UpdatedAt: *user.UpdatedAt,
@@ -360,9 +516,10 @@ func (u *userUsecase) UpdateUserOnlineStatus(userId uint, online bool) error {
 }
 
 // TODO 자기가 속한 회사에 사용자 리스트 가져오기(일반 사용자용)
-func (u *userUsecase) GetUsersByCompany(requestUserId uint, query *req.UserQuery) ([]res.GetUserByIdResponse, error) {
+func (u *userUsecase) GetUsersByCompany(requestUserId uint, activeCompanyId uint, query *req.UserQuery) ([]res.GetUserByIdResponse, error) {
 
-	user, err := u.userRepo.GetUserByID(requestUserId)
+	// 활성 회사 소속 사용자 목록
+	user, err := u.userRepo.GetUserByIDInCompany(requestUserId, activeCompanyId)
 	if err != nil {
 		fmt.Printf("사용자 조회에 실패했습니다: %v", err)
 		return nil, common.NewError(http.StatusInternalServerError, "사용자 조회에 실패했습니다", err)
@@ -427,7 +584,7 @@ func (u *userUsecase) GetUsersByCompany(requestUserId uint, query *req.UserQuery
 			Status:          _utils.GetValueOrDefault(user.Status, ""),
 			IsOnline:        isOnline,
 			IsSubscribed:    _utils.GetValueOrDefault(&user.UserProfile.IsSubscribed, false),
-			Image:           _utils.GetValueOrDefault(user.UserProfile.Image, ""),
+			Image:           _utils.SignProfileImageURL(_utils.GetValueOrDefault(user.UserProfile.Image, "")),
 			Birthday:        _utils.GetValueOrDefault(&user.UserProfile.Birthday, ""),
 			CompanyID:       _utils.GetValueOrDefault(user.UserProfile.CompanyID, 0),
 			CompanyName:     _utils.GetFirstOrEmpty(_utils.ExtractValuesFromMapSlice[string]([]*map[string]interface{}{user.UserProfile.Company}, "name"), ""),
@@ -450,5 +607,57 @@ func (u *userUsecase) GetUsersByDepartment(departmentId uint) ([]entity.User, er
 		fmt.Printf("부서 사용자 조회에 실패했습니다: %v", err)
 		return nil, common.NewError(http.StatusInternalServerError, "부서 사용자 조회에 실패했습니다", err)
 	}
+	for i := range users {
+		if users[i].UserProfile != nil {
+			users[i].UserProfile.Image = _utils.SignProfileImageURLPtr(users[i].UserProfile.Image)
+		}
+	}
 	return users, nil
 }
+
+// TODO 사용자가 소속된 회사 목록 - activeCompanyId가 0이면 대표 회사가 활성 회사
+func (u *userUsecase) GetUserCompanies(userId uint, activeCompanyId uint) ([]res.UserCompanyResponse, error) {
+	memberships, err := u.userRepo.GetCompanyMemberships(userId)
+	if err != nil {
+		log.Printf("회사 소속 목록 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 소속 목록 조회에 실패했습니다", err)
+	}
+
+	response := make([]res.UserCompanyResponse, len(memberships))
+	for i, membership := range memberships {
+		departments := make([]res.UserCompanyDepartmentResponse, 0, len(membership.Departments))
+		for _, department := range membership.Departments {
+			departments = append(departments, res.UserCompanyDepartmentResponse{
+				ID:   (*department)["id"].(uint),
+				Name: (*department)["name"].(string),
+			})
+		}
+
+		var entryDate string
+		if membership.EntryDate != nil {
+			entryDate = _utils.ParseKst(*membership.EntryDate).Format(time.DateTime)
+		}
+
+		isActive := membership.CompanyID == activeCompanyId
+		if activeCompanyId == 0 {
+			isActive = membership.IsPrimary
+		}
+
+		response[i] = res.UserCompanyResponse{
+			CompanyID:    membership.CompanyID,
+			CompanyName:  membership.CompanyName,
+			Role:         uint(membership.Role),
+			PositionID:   membership.PositionID,
+			PositionName: membership.PositionName,
+			Departments:  departments,
+			EntryDate:    entryDate,
+			IsPrimary:    membership.IsPrimary,
+			IsActive:     isActive,
+
+			CustomRoleID:   membership.CustomRoleID,
+			CustomRoleName: membership.CustomRoleName,
+		}
+	}
+
+	return response, nil
+}
@@ -4,3 +4,7 @@ type LoginRequest struct {
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required"`
 }
+
+type SwitchCompanyRequest struct {
+	CompanyID uint `json:"company_id" binding:"required"`
+}
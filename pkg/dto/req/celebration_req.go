@@ -0,0 +1,5 @@
+package req
+
+type UpdateCelebrationSettingRequest struct {
+	CelebrationPostEnabled *bool `json:"celebration_post_enabled" binding:"required"`
+}
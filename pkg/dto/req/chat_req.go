@@ -5,9 +5,39 @@ type ChatCursor struct {
 }
 
 type CreateChatRoomRequest struct {
-	UserIDs []uint `json:"user_ids"` // 채팅방에 참여할 사용자 ID 리스트
-	// Name      string `json:"name,omitempty"`
-	IsPrivate bool `json:"is_private,omitempty"`
+	UserIDs   []uint `json:"user_ids"`       // 채팅방에 참여할 사용자 ID 리스트
+	Name      string `json:"name,omitempty"` // 그룹 채팅방 이름 (없으면 "OOO 외 N명 채팅방")
+	IsPrivate bool   `json:"is_private,omitempty"`
+}
+
+// multipart form - 이미지는 file 필드 (ChatAttachmentUploadMiddleware에서 저장)
+type UpdateChatRoomRequest struct {
+	Name *string `form:"name"`
+}
+
+// 채팅방 리스트 - archived면 보관한 채팅방만
+type GetChatRoomListQueryParams struct {
+	Archived bool `query:"archived"`
+}
+
+// 사용자별 채팅방 설정 - 보내지 않은 항목은 그대로
+type UpdateChatRoomSettingsRequest struct {
+	IsMuted    *bool `json:"is_muted"`
+	IsArchived *bool `json:"is_archived"`
+	IsPinned   *bool `json:"is_pinned"`
+}
+
+type AddChatRoomMembersRequest struct {
+	UserIDs []uint `json:"user_ids" binding:"required"`
+}
+
+// admin 또는 member
+type UpdateChatRoomMemberRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+type TransferChatRoomOwnerRequest struct {
+	UserID uint `json:"user_id" binding:"required"`
 }
 
 type SendMessageRequest struct {
@@ -15,6 +45,28 @@ type SendMessageRequest struct {
 	Content  string `json:"content"`
 	RoomID   uint   `json:"chat_room_id"`
 	Type     string `json:"type"`
+
+	//TODO 스레드 답글 - show_in_room이면 채팅방에도 함께 표시
+	ParentMessageID string `json:"parent_message_id,omitempty"`
+	ShowInRoom      bool   `json:"show_in_room,omitempty"`
+
+	//TODO 안정적인 전송 - 재전송해도 한 번만 저장, type이 resume이면 last_seq 이후 메시지 재전송
+	ClientMsgID string `json:"client_msg_id,omitempty"`
+	LastSeq     int64  `json:"last_seq,omitempty"`
+}
+
+// 예약 메시지 - send_at은 RFC3339(시간대 포함) 또는 "2006-01-02 15:04:05"(KST)
+type ScheduleChatMessageRequest struct {
+	Content         string `json:"content" binding:"required"`
+	SendAt          string `json:"send_at" binding:"required"`
+	ParentMessageID string `json:"parent_message_id,omitempty"`
+	ShowInRoom      bool   `json:"show_in_room,omitempty"`
+}
+
+// 예약 메시지 수정 - 보내지 않은 항목은 그대로
+type UpdateScheduledChatMessageRequest struct {
+	Content *string `json:"content"`
+	SendAt  *string `json:"send_at"`
 }
 
 type DeleteChatMessageRequest struct {
@@ -22,6 +74,66 @@ type DeleteChatMessageRequest struct {
 	ChatMessageID string `json:"chat_message_id"`
 }
 
+// 업로드된 채팅 첨부파일 정보 (ChatAttachmentUploadMiddleware에서 설정)
+type ChatAttachmentUpload struct {
+	Name         string
+	Size         int64
+	MimeType     string
+	URL          string
+	ThumbnailURL string
+}
+
+// multipart form - files와 함께 전송
+type SendChatAttachmentRequest struct {
+	Content         string `form:"content"`
+	ParentMessageID string `form:"parent_message_id"`
+	ShowInRoom      bool   `form:"show_in_room"`
+	ClientMsgID     string `form:"client_msg_id"`
+}
+
+type UpdateChatMessageRequest struct {
+	ChatRoomID    uint   `json:"chat_room_id" binding:"required"`
+	ChatMessageID string `json:"chat_message_id" binding:"required"`
+	Content       string `json:"content" binding:"required"`
+}
+
+type ChatReactionRequest struct {
+	Unified string `json:"unified" binding:"required"`
+	Content string `json:"content" binding:"required"`
+}
+
+// chat_message_id가 없으면 채팅방 최신 메시지까지 읽음 처리
+type ReadChatRoomRequest struct {
+	ChatMessageID string `json:"chat_message_id,omitempty"`
+}
+
+type GetChatThreadQueryParams struct {
+	Limit  int         `query:"limit" default:"20"`
+	Cursor *ChatCursor `query:"cursor,omitempty"`
+}
+
+// 채팅방 파일 모아보기 - 첨부파일이 있는 메시지 단위로 페이지네이션
+type GetChatFilesQueryParams struct {
+	Limit  int         `query:"limit" default:"20"`
+	Cursor *ChatCursor `query:"cursor,omitempty"`
+}
+
+// 채팅 검색 - 날짜는 YYYY-MM-DD (KST), to는 해당 날짜까지 포함
+type SearchChatMessagesQueryParams struct {
+	Query      string `query:"q"`
+	ChatRoomID uint   `query:"room_id"`
+	SenderID   uint   `query:"sender_id"`
+	From       string `query:"from"`
+	To         string `query:"to"`
+	Page       int    `query:"page" default:"1"`
+	Limit      int    `query:"limit" default:"20"`
+}
+
+type GetChatBookmarksQueryParams struct {
+	Page  int `query:"page" default:"1"`
+	Limit int `query:"limit" default:"20"`
+}
+
 type GetChatMessagesQueryParams struct {
 	Page   int         `query:"page" default:"1"`
 	Limit  int         `query:"limit" default:"10"`
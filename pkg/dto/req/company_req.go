@@ -6,8 +6,66 @@ type SearchCompanyRequest struct {
 
 type CompanyPositionRequest struct {
 	Name string `json:"name"`
+	Rank int    `json:"rank" binding:"min=0"` // 숫자가 클수록 높은 직급
 }
 
 type UpdateCompanyPositionRequest struct {
 	Name string `json:"name"`
+	Rank *int   `json:"rank,omitempty" binding:"omitempty,min=0"`
+}
+
+// TODO 회사 인증 요청 - 서류는 multipart files로 업로드
+type SubmitCompanyVerificationRequest struct {
+	CpNumber      string   `form:"cp_number" json:"cp_number" binding:"required"` // 사업자등록번호
+	DocumentUrls  []string `form:"-" json:"-"`                                    // 업로드 미들웨어에서 설정
+	DocumentNames []string `form:"-" json:"-"`
+}
+
+// TODO 회사 인증 요청 처리 (운영자)
+type ReviewCompanyVerificationRequest struct {
+	Status string `json:"status" binding:"required,oneof=APPROVED REJECTED"`
+	Reason string `json:"reason,omitempty"` // 반려 시 필수
+}
+
+// TODO 공유용 초대 링크 생성 (회사 관리자)
+type CreateCompanyInviteLinkRequest struct {
+	TargetType     string `json:"target_type" binding:"required,oneof=COMPANY DEPARTMENT PROJECT"`
+	DepartmentID   *uint  `json:"department_id,omitempty"` // DEPARTMENT는 필수, 나머지는 선택
+	ProjectID      *uint  `json:"project_id,omitempty"`    // PROJECT는 필수
+	Role           int    `json:"role,omitempty" binding:"omitempty,oneof=3 4 5"`
+	EmailDomain    string `json:"email_domain,omitempty"`                             // 예: link.com
+	MaxUses        int    `json:"max_uses,omitempty" binding:"min=0"`                 // 0이면 무제한
+	ExpiresInHours int    `json:"expires_in_hours,omitempty" binding:"min=0,max=720"` // 0이면 7일
+}
+
+// TODO 회사 이메일 도메인 등록 (인증된 회사만)
+type ClaimCompanyEmailDomainRequest struct {
+	Domain     string `json:"domain" binding:"required"`
+	JoinPolicy string `json:"join_policy,omitempty" binding:"omitempty,oneof=SUGGEST AUTO_JOIN"` // 기본 SUGGEST
+}
+
+type UpdateCompanyEmailDomainRequest struct {
+	JoinPolicy string `json:"join_policy" binding:"required,oneof=SUGGEST AUTO_JOIN"`
+}
+
+// TODO 이메일 도메인으로 제안받은 회사에 직접 가입
+type JoinCompanyByEmailDomainRequest struct {
+	CompanyID uint `json:"company_id" binding:"required"`
+}
+
+// TODO 회사 역할 생성, 수정 - permissions는 MANAGE_POSITIONS, MANAGE_DEPARTMENTS, MODERATE_POSTS, VIEW_STATS, INVITE_MEMBERS
+type CompanyRoleRequest struct {
+	Name        string   `json:"name" binding:"required,max=50"`
+	Description string   `json:"description,omitempty" binding:"max=255"`
+	Permissions []string `json:"permissions" binding:"required"`
+}
+
+// TODO 회사 역할 부여 - role_id가 없으면 역할 해제
+type AssignCompanyRoleRequest struct {
+	RoleID *uint `json:"role_id"`
+}
+
+// TODO 상급자 지정 - manager_id가 null이면 해제
+type UpdateManagerRequest struct {
+	ManagerID *uint `json:"manager_id"`
 }
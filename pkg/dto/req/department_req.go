@@ -3,9 +3,30 @@ package req
 type CreateDepartmentRequest struct {
 	Name               string `json:"name" binding:"required"`
 	DepartmentLeaderID uint   `json:"department_leader_id,omitempty"`
+	ParentID           *uint  `json:"parent_id,omitempty"` // 상위 부서, 없으면 최상위 부서
 }
 
 type UpdateDepartmentRequest struct {
 	Name               *string `json:"name" binding:"required"`
 	DepartmentLeaderID *int    `json:"department_leader_id,omitempty"`
+	AutoLeader         bool    `json:"auto_leader,omitempty"` // true면 구성원 중 가장 높은 직급을 부서장으로
+}
+
+// TODO 부서 이동 - parent_id가 null이면 최상위 부서로 이동
+type MoveDepartmentRequest struct {
+	ParentID *uint `json:"parent_id"`
+}
+
+// TODO 부서 통합 - source 부서를 target 부서로 통합
+type MergeDepartmentRequest struct {
+	TargetDepartmentID uint `json:"target_department_id" binding:"required"`
+}
+
+// TODO 부서 분리 - 일부 구성원과 게시물을 새 부서로
+type SplitDepartmentRequest struct {
+	Name               string `json:"name" binding:"required"`
+	UserIds            []uint `json:"user_ids" binding:"required,min=1"`
+	PostIds            []uint `json:"post_ids,omitempty"`
+	ParentID           *uint  `json:"parent_id,omitempty"`            // 없으면 기존 부서와 같은 상위 부서
+	DepartmentLeaderID *uint  `json:"department_leader_id,omitempty"` // 분리되는 구성원 중에서만, 없으면 가장 높은 직급
 }
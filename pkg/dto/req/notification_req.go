@@ -33,6 +33,13 @@ type SendMentionNotificationRequest struct {
 	TargetID   uint   `json:"target_id" binding:"required"`
 }
 
+// TODO 팀 언급 - 본문의 @팀핸들을 찾아 팀 구성원 전체에게 알림
+type SendTeamMentionNotificationRequest struct {
+	Content    string `json:"content" binding:"required"`
+	TargetType string `json:"target_type" binding:"required"`
+	TargetID   uint   `json:"target_id" binding:"required"`
+}
+
 type NotificationRequest struct {
 	SenderId     uint        `json:"sender_id" binding:"required"`
 	ReceiverId   uint        `json:"receiver_id" binding:"required"`
@@ -14,6 +14,9 @@ type CreatePostRequest struct {
 	IsAnonymous   bool      `form:"is_anonymous" json:"is_anonymous"`
 	Visibility    string    `form:"visibility" json:"visibility"`
 	DepartmentIds []*uint   `form:"department_ids,omitempty" json:"department_ids,omitempty"`
+
+	//TODO 팀 공개 게시물일 때 필요
+	TeamID *uint `form:"team_id,omitempty" json:"team_id,omitempty"`
 }
 
 type GetPostQueryParams struct {
@@ -26,6 +29,9 @@ type GetPostQueryParams struct {
 	ViewType     string  `query:"view_type" default:"INFINITE"`        // 무한스크롤 타입 페이지네이션, 기본값: pagination
 	Sort         string  `query:"sort" default:"created_at"`           // 정렬 기준, 기본값: created_at
 	Cursor       *Cursor `query:"cursor,omitempty"`                    // 커서, 기본값: ""
+
+	IncludeSubDepartments bool `query:"include_sub_departments" default:"false"` // 부서 게시물 조회 시 하위 부서 게시물 포함 여부
+	TeamId                uint `query:"team_id,omitempty" default:"0"`           // 팀 게시물 조회 시 팀 ID
 }
 
 type UpdatePostRequest struct {
@@ -35,4 +41,7 @@ type UpdatePostRequest struct {
 	IsAnonymous   *bool    `form:"is_anonymous" json:"is_anonymous"`
 	Visibility    *string  `form:"visibility" json:"visibility"`
 	DepartmentIds []uint   `form:"department_ids,omitempty" json:"department_ids,omitempty"`
+
+	//TODO 팀 공개로 변경할 때 필요
+	TeamID *uint `form:"team_id,omitempty" json:"team_id,omitempty"`
 }
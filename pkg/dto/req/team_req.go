@@ -0,0 +1,29 @@
+package req
+
+// TODO 팀 생성 - lead_ids는 member_ids에 없어도 구성원으로 추가됨
+type CreateTeamRequest struct {
+	Name         string `json:"name" binding:"required,max=100"`
+	Handle       string `json:"handle" binding:"required,max=50"` // @멘션용 (예: backend-team)
+	Description  string `json:"description,omitempty"`
+	DepartmentID *uint  `json:"department_id,omitempty"`
+	MemberIds    []uint `json:"member_ids,omitempty"`
+	LeadIds      []uint `json:"lead_ids,omitempty"`
+}
+
+// TODO 팀 수정 - clear_department가 true면 부서 연결 해제
+type UpdateTeamRequest struct {
+	Name            *string `json:"name,omitempty" binding:"omitempty,max=100"`
+	Handle          *string `json:"handle,omitempty" binding:"omitempty,max=50"`
+	Description     *string `json:"description,omitempty"`
+	DepartmentID    *uint   `json:"department_id,omitempty"`
+	ClearDepartment bool    `json:"clear_department,omitempty"`
+}
+
+type AddTeamMembersRequest struct {
+	UserIds []uint `json:"user_ids" binding:"required,min=1"`
+	IsLead  bool   `json:"is_lead,omitempty"`
+}
+
+type UpdateTeamMemberRequest struct {
+	IsLead bool `json:"is_lead"`
+}
@@ -17,6 +17,10 @@ type RegisterUserRequest struct {
 	Phone    string `json:"phone" binding:"required"`
 }
 
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
 type UpdateUserRequest struct {
 	Name         *string `form:"name,omitempty" json:"name,omitempty"`
 	Email        *string `form:"email,omitempty" json:"email,omitempty"`
@@ -32,6 +36,8 @@ type UpdateUserRequest struct {
 	EntryDate    *string `form:"entry_date,omitempty" json:"entry_date,omitempty"`
 	Image        *string `form:"image,omitempty" json:"image,omitempty"`
 	Status       *string `form:"status,omitempty" json:"status,omitempty"`
+
+	CelebrationOptOut *bool `form:"celebration_opt_out,omitempty" json:"celebration_opt_out,omitempty"`
 }
 
 type SearchUserRequest struct {
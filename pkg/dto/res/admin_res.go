@@ -27,6 +27,12 @@ type AdminRegisterCompanyResponse struct {
 	UpdatedAt                 time.Time `json:"updated_at,omitempty"`
 }
 
+type AdminDeleteCompanyResponse struct {
+	CompanyID           uint   `json:"company_id"`
+	DeletionRequestedAt string `json:"deletion_requested_at"`
+	PurgeScheduledAt    string `json:"purge_scheduled_at"` // 이 시각 이후 영구 삭제, 그 전까지 복구 가능
+}
+
 type GetAllUsersResponse struct {
 	ID              uint      `json:"id,omitempty"`
 	Name            string    `json:"name,omitempty"`
@@ -0,0 +1,18 @@
+package res
+
+type CelebrationResponse struct {
+	UserID   uint   `json:"user_id"`
+	Name     string `json:"name"`
+	Image    string `json:"image,omitempty"`
+	Type     string `json:"type"`            // BIRTHDAY, ANNIVERSARY
+	Date     string `json:"date"`            // 기념일 (KST, YYYY-MM-DD)
+	Years    int    `json:"years,omitempty"` // 입사 N주년
+	DaysLeft int    `json:"days_left"`       // 오늘 기준 남은 일수
+}
+
+type GetCelebrationsResponse struct {
+	Range        string                `json:"range"`
+	From         string                `json:"from"`
+	To           string                `json:"to"`
+	Celebrations []CelebrationResponse `json:"celebrations"`
+}
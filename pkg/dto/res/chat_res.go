@@ -11,6 +11,7 @@ type UserInfoResponse struct {
 	Image     *string    `json:"image,omitempty"`
 	JoinedAt  *time.Time `json:"joined_at,omitempty"`
 	LeftAt    *time.Time `json:"left_at,omitempty"`
+	Role      *string    `json:"role,omitempty"` // 그룹 채팅방 권한 (owner, admin, member)
 }
 
 type CreateChatRoomResponse struct {
@@ -23,7 +24,36 @@ type ChatRoomInfoResponse struct {
 	ID        uint               `json:"id,omitempty"`
 	Name      string             `json:"name,omitempty"`
 	IsPrivate *bool              `json:"is_private,omitempty"`
+	Image     *string            `json:"image,omitempty"`
 	Users     []UserInfoResponse `json:"users,omitempty"`
+
+	//TODO 읽음 커서 기준 안 읽은 메시지 수 (채팅방 리스트)
+	UnreadCount *int `json:"unread_count,omitempty"`
+
+	//TODO 사용자별 채팅방 설정과 마지막 메시지 (채팅방 리스트)
+	IsMuted        bool                     `json:"is_muted,omitempty"`
+	IsArchived     bool                     `json:"is_archived,omitempty"`
+	IsPinned       bool                     `json:"is_pinned,omitempty"`
+	LastMessage    *ChatLastMessageResponse `json:"last_message,omitempty"`
+	LastActivityAt string                   `json:"last_activity_at,omitempty"`
+}
+
+// 채팅방 리스트 마지막 메시지 미리보기
+type ChatLastMessageResponse struct {
+	ChatMessageID   string `json:"chat_message_id"`
+	SenderID        uint   `json:"sender_id"`
+	SenderName      string `json:"sender_name,omitempty"`
+	Content         string `json:"content"`
+	MessageType     string `json:"message_type,omitempty"`
+	AttachmentCount int    `json:"attachment_count,omitempty"`
+	CreatedAt       string `json:"created_at"`
+}
+
+type ChatRoomSettingsResponse struct {
+	ChatRoomID uint `json:"chat_room_id"`
+	IsMuted    bool `json:"is_muted"`
+	IsArchived bool `json:"is_archived"`
+	IsPinned   bool `json:"is_pinned"`
 }
 
 type ChatPayload struct {
@@ -34,6 +64,100 @@ type ChatPayload struct {
 	SenderImage string `json:"sender_image,omitempty"`
 	Content     string `json:"content,omitempty"`
 	CreatedAt   string `json:"created_at,omitempty"`
+	MessageType string `json:"message_type,omitempty"` // system이면 시스템 메시지
+
+	//TODO 스레드 답글
+	ParentMessageID string `json:"parent_message_id,omitempty"`
+	ShowInRoom      bool   `json:"show_in_room,omitempty"`
+
+	//TODO 첨부파일
+	Attachments []*ChatAttachmentResponse `json:"attachments,omitempty"`
+
+	//TODO 안정적인 전송 - 클라이언트는 seq로 누락 여부 확인
+	ChatMessageID string `json:"chat_message_id,omitempty"`
+	ClientMsgID   string `json:"client_msg_id,omitempty"`
+	Seq           int64  `json:"seq,omitempty"`
+}
+
+// 메시지 저장 확인 -> 웹소켓 chat.ack (보낸 사람에게만)
+type ChatAckPayload struct {
+	ChatRoomID    uint   `json:"chat_room_id"`
+	ClientMsgID   string `json:"client_msg_id,omitempty"`
+	ChatMessageID string `json:"chat_message_id,omitempty"`
+	Seq           int64  `json:"seq,omitempty"`
+	CreatedAt     string `json:"created_at,omitempty"`
+	Duplicated    bool   `json:"duplicated"` // 이미 저장된 메시지를 재전송한 경우
+}
+
+// 재연결 시 누락 메시지 -> 웹소켓 chat.replay (has_more면 last_seq로 다시 요청)
+type ChatReplayResponse struct {
+	ChatRoomID uint           `json:"chat_room_id"`
+	Messages   []*ChatPayload `json:"messages"`
+	LastSeq    int64          `json:"last_seq"`
+	HasMore    bool           `json:"has_more"`
+}
+
+type ScheduledChatMessageResponse struct {
+	ID              uint   `json:"id"`
+	ChatRoomID      uint   `json:"chat_room_id"`
+	Content         string `json:"content"`
+	ParentMessageID string `json:"parent_message_id,omitempty"`
+	ShowInRoom      bool   `json:"show_in_room,omitempty"`
+	SendAt          string `json:"send_at"`
+	Status          string `json:"status"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
+}
+
+type ChatRoomMemberResponse struct {
+	UserID   uint    `json:"user_id"`
+	Name     string  `json:"name"`
+	Email    string  `json:"email"`
+	Image    *string `json:"image,omitempty"`
+	Role     string  `json:"role"`
+	JoinedAt string  `json:"joined_at"`
+}
+
+// 채팅방 정보 변경 이벤트 (이름, 이미지, 멤버, 권한) -> 웹소켓 chat.room.updated
+type ChatRoomUpdatedPayload struct {
+	ChatRoomID uint                      `json:"chat_room_id"`
+	Name       string                    `json:"name"`
+	Image      *string                   `json:"image,omitempty"`
+	Members    []*ChatRoomMemberResponse `json:"members"`
+}
+
+type ChatAttachmentResponse struct {
+	Name         string `json:"name"`
+	Size         int64  `json:"size"`
+	MimeType     string `json:"mime_type"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+}
+
+type ChatFileResponse struct {
+	ChatMessageID string `json:"chat_message_id"`
+	SenderID      uint   `json:"sender_id"`
+	SenderName    string `json:"sender_name"`
+	CreatedAt     string `json:"created_at"`
+	*ChatAttachmentResponse
+}
+
+type GetChatFilesResponse struct {
+	Files []*ChatFileResponse `json:"files"`
+	Meta  *ChatMeta           `json:"meta"`
+}
+
+type ChatThreadReplyPayload struct {
+	ParentMessageID string       `json:"parent_message_id"`
+	ReplyCount      int          `json:"reply_count"`
+	Reply           *ChatPayload `json:"reply"`
+}
+
+type ChatLastReplyResponse struct {
+	SenderID   uint   `json:"sender_id"`
+	SenderName string `json:"sender_name"`
+	Content    string `json:"content"`
+	CreatedAt  string `json:"created_at"`
 }
 
 type ChatMessagesResponse struct {
@@ -45,6 +169,72 @@ type ChatMessagesResponse struct {
 	ChatRoomID    uint   `json:"chat_room_id"`
 	CreatedAt     string `json:"created_at"`
 	UpdatedAt     string `json:"updated_at,omitempty"`
+	MessageType   string `json:"message_type,omitempty"` // system이면 시스템 메시지
+
+	//TODO 수정된 메시지 표시 - (수정됨)
+	IsEdited bool   `json:"is_edited"`
+	EditedAt string `json:"edited_at,omitempty"`
+
+	//TODO 스레드 - 답글이면 원본 메시지 ID, 원본이면 답글 수와 마지막 답글
+	ParentMessageID string                 `json:"parent_message_id,omitempty"`
+	ReplyCount      int                    `json:"reply_count,omitempty"`
+	LastReply       *ChatLastReplyResponse `json:"last_reply,omitempty"`
+
+	//TODO 이모지별 반응 수와 본인 반응 여부
+	Reactions []*ChatReactionResponse `json:"reactions"`
+
+	//TODO 읽음 확인 - 보낸 사람을 제외하고 읽은 참여자 수
+	ReadCount int `json:"read_count"`
+
+	//TODO 채팅방 고정 여부, 본인 북마크 여부
+	IsPinned     bool `json:"is_pinned"`
+	IsBookmarked bool `json:"is_bookmarked"`
+
+	//TODO 채팅방별 메시지 순번 - 재연결 시 마지막 순번으로 누락 메시지 요청
+	Seq int64 `json:"seq,omitempty"`
+
+	Attachments []*ChatAttachmentResponse `json:"attachments,omitempty"`
+}
+
+type ChatReadResponse struct {
+	ChatRoomID        uint   `json:"chat_room_id"`
+	UserID            uint   `json:"user_id"`
+	LastReadMessageID string `json:"last_read_message_id"`
+	LastReadAt        string `json:"last_read_at"`
+}
+
+type ChatReactionResponse struct {
+	EmojiID   uint   `json:"emoji_id"`
+	Unified   string `json:"unified"`
+	Content   string `json:"content"`
+	Count     int    `json:"count"`
+	IsClicked bool   `json:"is_clicked,omitempty"` // 본인이 해당 이모지를 눌렀는지
+}
+
+type ChatReactionEventPayload struct {
+	ChatRoomID    uint                    `json:"chat_room_id"`
+	ChatMessageID string                  `json:"chat_message_id"`
+	Action        string                  `json:"action"` // add, remove
+	UserID        uint                    `json:"user_id"`
+	EmojiID       uint                    `json:"emoji_id"`
+	Unified       string                  `json:"unified"`
+	Content       string                  `json:"content"`
+	Reactions     []*ChatReactionResponse `json:"reactions"`
+}
+
+type ChatEditHistoryResponse struct {
+	Content  string `json:"content"`
+	EditedAt string `json:"edited_at"`
+}
+
+type ChatMessageHistoryResponse struct {
+	ChatMessageID string                     `json:"chat_message_id"`
+	ChatRoomID    uint                       `json:"chat_room_id"`
+	SenderID      uint                       `json:"sender_id"`
+	Content       string                     `json:"content"`
+	CreatedAt     string                     `json:"created_at"`
+	EditedAt      string                     `json:"edited_at,omitempty"`
+	History       []*ChatEditHistoryResponse `json:"history"`
 }
 
 type ChatMeta struct {
@@ -57,6 +247,66 @@ type ChatMeta struct {
 	NextPage   int    `json:"next_page"`
 }
 
+type ChatSearchResultResponse struct {
+	ChatMessageID   string `json:"chat_message_id"`
+	ChatRoomID      uint   `json:"chat_room_id"`
+	ChatRoomName    string `json:"chat_room_name"`
+	SenderID        uint   `json:"sender_id"`
+	SenderName      string `json:"sender_name"`
+	SenderImage     string `json:"sender_image,omitempty"`
+	Content         string `json:"content"`
+	Snippet         string `json:"snippet"` // HTML 이스케이프 후 검색어를 <mark>로 감싼 일부 내용
+	CreatedAt       string `json:"created_at"`
+	ParentMessageID string `json:"parent_message_id,omitempty"`
+}
+
+type SearchChatMessagesResponse struct {
+	Results []*ChatSearchResultResponse `json:"results"`
+	Meta    *ChatMeta                   `json:"meta"`
+}
+
+// 검색 결과로 이동 - 대상 메시지와 앞뒤 메시지 (오래된 순)
+type ChatMessageContextResponse struct {
+	TargetMessageID string                  `json:"target_message_id"`
+	AnchorMessageID string                  `json:"anchor_message_id"` // 스레드에만 보낸 답글이면 원본 메시지
+	Messages        []*ChatMessagesResponse `json:"messages"`
+	HasBefore       bool                    `json:"has_before"`
+	HasAfter        bool                    `json:"has_after"`
+}
+
+// 메시지 고정/해제 이벤트 -> 웹소켓 chat.message.pinned, chat.message.unpinned
+type ChatPinPayload struct {
+	ChatRoomID    uint                  `json:"chat_room_id"`
+	ChatMessageID string                `json:"chat_message_id"`
+	UserID        uint                  `json:"user_id"`
+	UserName      string                `json:"user_name"`
+	PinnedAt      string                `json:"pinned_at,omitempty"`
+	Message       *ChatMessagesResponse `json:"message,omitempty"`
+}
+
+// 북마크 - 삭제된 메시지면 message가 null
+type ChatBookmarkResponse struct {
+	ID            uint                  `json:"id"`
+	ChatRoomID    uint                  `json:"chat_room_id"`
+	ChatRoomName  string                `json:"chat_room_name"`
+	ChatRoomImage *string               `json:"chat_room_image,omitempty"`
+	IsPrivate     bool                  `json:"is_private"`
+	ChatMessageID string                `json:"chat_message_id"`
+	BookmarkedAt  string                `json:"bookmarked_at"`
+	Message       *ChatMessagesResponse `json:"message"`
+}
+
+type GetChatBookmarksResponse struct {
+	Bookmarks []*ChatBookmarkResponse `json:"bookmarks"`
+	Meta      *ChatMeta               `json:"meta"`
+}
+
+type GetChatThreadResponse struct {
+	ParentMessage *ChatMessagesResponse   `json:"parent_message"`
+	Replies       []*ChatMessagesResponse `json:"replies"`
+	Meta          *ChatMeta               `json:"meta"`
+}
+
 type GetChatMessagesResponse struct {
 	ChatMessages []*ChatMessagesResponse `json:"chat_messages"`
 	Meta         *ChatMeta               `json:"meta"`
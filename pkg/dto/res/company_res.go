@@ -12,6 +12,8 @@ type GetCompanyInfoResponse struct {
 	Grade                 int    `json:"grade,omitempty"`
 	CreatedAt             string `json:"created_at"`
 	UpdatedAt             string `json:"updated_at"`
+
+	PurgeScheduledAt string `json:"purge_scheduled_at,omitempty"` // 삭제 예정(읽기 전용)인 회사만
 }
 
 type OrganizationResponse struct {
@@ -27,10 +29,230 @@ type OrganizationDepartmentInfoResponse struct {
 	Users          []GetOrganizationUserInfoResponse `json:"users"`
 }
 
+// TODO 계층형 조직도
+type OrganizationTreeResponse struct {
+	CompanyId       uint                              `json:"company_id"`
+	CompanyName     string                            `json:"company_name"`
+	Departments     []*OrganizationTreeNodeResponse   `json:"departments"`
+	UnassignedUsers []GetOrganizationUserInfoResponse `json:"unassigned_users"`
+}
+
+type OrganizationTreeNodeResponse struct {
+	DepartmentId     uint                              `json:"department_id"`
+	DepartmentName   string                            `json:"department_name"`
+	ParentId         *uint                             `json:"parent_id"`
+	Leader           *GetOrganizationUserInfoResponse  `json:"leader,omitempty"`
+	MemberCount      int                               `json:"member_count"`       // 해당 부서 직속 인원
+	TotalMemberCount int                               `json:"total_member_count"` // 하위 부서 포함 인원 (중복 제외)
+	Members          []GetOrganizationUserInfoResponse `json:"members"`
+	Children         []*OrganizationTreeNodeResponse   `json:"children"`
+}
+
 type GetCompanyPositionResponse struct {
 	ID        uint   `json:"id"`
 	Name      string `json:"name"`
 	CompanyID uint   `json:"company_id"`
+	Rank      int    `json:"rank"`
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
 }
+
+// TODO 회사 인증 요청
+type CompanyVerificationResponse struct {
+	ID            uint                                  `json:"id"`
+	CompanyID     uint                                  `json:"company_id"`
+	CompanyName   string                                `json:"company_name,omitempty"`
+	RequesterID   uint                                  `json:"requester_id"`
+	RequesterName string                                `json:"requester_name,omitempty"`
+	CpNumber      string                                `json:"cp_number"`
+	Status        string                                `json:"status"`
+	Reason        string                                `json:"reason,omitempty"`
+	ReviewedAt    string                                `json:"reviewed_at,omitempty"`
+	Documents     []CompanyVerificationDocumentResponse `json:"documents"`
+	CreatedAt     string                                `json:"created_at"`
+}
+
+type CompanyVerificationDocumentResponse struct {
+	ID          uint   `json:"id"`
+	FileName    string `json:"file_name"`
+	DownloadURL string `json:"download_url"`
+}
+
+type GetCompanyVerificationsResponse struct {
+	Verifications []CompanyVerificationResponse `json:"verifications"`
+	Meta          *PaginationMeta               `json:"meta"`
+}
+
+// TODO 회사 요금제 사용량 - limit 0은 무제한
+type GetCompanyUsageResponse struct {
+	CompanyID         uint                     `json:"company_id"`
+	Grade             int                      `json:"grade"`
+	PlanName          string                   `json:"plan_name"`
+	Members           CompanyUsageItemResponse `json:"members"`
+	Projects          CompanyUsageItemResponse `json:"projects"`
+	BoardsPerProject  CompanyUsageItemResponse `json:"boards_per_project"` // 보드가 가장 많은 프로젝트 기준
+	StorageBytes      CompanyUsageItemResponse `json:"storage_bytes"`
+	ChatRetentionDays int                      `json:"chat_retention_days"`
+}
+
+type CompanyUsageItemResponse struct {
+	Used      int64   `json:"used"`
+	Limit     int64   `json:"limit"`
+	Remaining int64   `json:"remaining"`
+	UsageRate float64 `json:"usage_rate"` // 한도 대비 사용률 (%)
+}
+
+// TODO 공유용 초대 링크
+type CompanyInviteLinkResponse struct {
+	ID             uint   `json:"id"`
+	Code           string `json:"code"`
+	InviteURL      string `json:"invite_url"`
+	CompanyID      uint   `json:"company_id"`
+	CompanyName    string `json:"company_name,omitempty"`
+	TargetType     string `json:"target_type"`
+	DepartmentID   *uint  `json:"department_id,omitempty"`
+	DepartmentName string `json:"department_name,omitempty"`
+	ProjectID      *uint  `json:"project_id,omitempty"`
+	ProjectName    string `json:"project_name,omitempty"`
+	Role           int    `json:"role"`
+	EmailDomain    string `json:"email_domain,omitempty"`
+	MaxUses        int    `json:"max_uses"`
+	UseCount       int    `json:"use_count"`
+	Status         string `json:"status"` // ACTIVE, EXPIRED, EXHAUSTED, REVOKED
+	CreatorID      uint   `json:"creator_id"`
+	CreatorName    string `json:"creator_name,omitempty"`
+	ExpiresAt      string `json:"expires_at,omitempty"`
+	RevokedAt      string `json:"revoked_at,omitempty"`
+	CreatedAt      string `json:"created_at"`
+}
+
+// TODO 초대 링크 사용 전 미리보기 - 관리용 정보는 제외
+type CompanyInviteLinkPreviewResponse struct {
+	CompanyID      uint   `json:"company_id"`
+	CompanyName    string `json:"company_name"`
+	TargetType     string `json:"target_type"`
+	DepartmentName string `json:"department_name,omitempty"`
+	ProjectName    string `json:"project_name,omitempty"`
+	Role           int    `json:"role"`
+	Status         string `json:"status"`
+	ExpiresAt      string `json:"expires_at,omitempty"`
+}
+
+type RedeemCompanyInviteLinkResponse struct {
+	CompanyID    uint   `json:"company_id"`
+	CompanyName  string `json:"company_name"`
+	TargetType   string `json:"target_type"`
+	DepartmentID *uint  `json:"department_id,omitempty"`
+	ProjectID    *uint  `json:"project_id,omitempty"`
+	Role         int    `json:"role"` // 이미 소속된 회사였다면 기존 역할
+}
+
+// TODO 회사 이메일 도메인 - txt_record를 도메인 DNS에 등록 후 인증 요청
+type CompanyEmailDomainResponse struct {
+	ID         uint   `json:"id"`
+	Domain     string `json:"domain"`
+	JoinPolicy string `json:"join_policy"`
+	IsVerified bool   `json:"is_verified"`
+	TxtRecord  string `json:"txt_record"`
+	VerifiedAt string `json:"verified_at,omitempty"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// TODO 이메일 도메인으로 연결된 회사 (회원가입 응답, 가입 제안 목록)
+type EmailDomainCompanyResponse struct {
+	CompanyID   uint   `json:"company_id"`
+	CompanyName string `json:"company_name"`
+	Domain      string `json:"domain"`
+	JoinPolicy  string `json:"join_policy"`
+}
+
+// TODO 회사에서 정의한 역할
+type CompanyRoleResponse struct {
+	ID          uint     `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+	MemberCount int64    `json:"member_count"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+// TODO 활성 회사에서 내가 가진 권한
+type CompanyPermissionsResponse struct {
+	CompanyID      uint     `json:"company_id"`
+	Role           uint     `json:"role"`
+	CustomRoleID   *uint    `json:"custom_role_id,omitempty"`
+	CustomRoleName string   `json:"custom_role_name,omitempty"`
+	Permissions    []string `json:"permissions"`
+}
+
+// TODO 보고 라인
+type ReportingMemberResponse struct {
+	ID           uint   `json:"id"`
+	Name         string `json:"name"`
+	Nickname     string `json:"nickname"`
+	Email        string `json:"email"`
+	Image        string `json:"image,omitempty"`
+	PositionID   *uint  `json:"position_id,omitempty"`
+	PositionName string `json:"position_name,omitempty"`
+	PositionRank int    `json:"position_rank"`
+	ManagerID    *uint  `json:"manager_id,omitempty"`
+}
+
+type ManagementChainResponse struct {
+	User     ReportingMemberResponse   `json:"user"`
+	Managers []ReportingMemberResponse `json:"managers"` // 직속 상급자부터 최상위까지
+}
+
+type ReportingChartResponse struct {
+	CompanyID uint                          `json:"company_id"`
+	Roots     []*ReportingChartNodeResponse `json:"roots"` // 상급자가 없는 구성원, 높은 직급부터
+}
+
+type ReportingChartNodeResponse struct {
+	ReportingMemberResponse
+	DirectReports []*ReportingChartNodeResponse `json:"direct_reports"`
+}
+
+// TODO 회사 전체 데이터 내보내기 - 테이블 행을 그대로 담음
+type CompanyExportResponse struct {
+	FileName     string                            `json:"-"`
+	ExportedAt   string                            `json:"exported_at"`
+	Company      map[string]interface{}            `json:"company"`
+	Users        []map[string]interface{}          `json:"users"`
+	Organization CompanyExportOrganizationResponse `json:"organization"`
+	Posts        CompanyExportPostsResponse        `json:"posts"`
+	Boards       CompanyExportBoardsResponse       `json:"boards"`
+	Chats        CompanyExportChatsResponse        `json:"chats"`
+}
+
+type CompanyExportOrganizationResponse struct {
+	Departments       []map[string]interface{} `json:"departments"`
+	DepartmentMembers []map[string]interface{} `json:"department_members"`
+	Positions         []map[string]interface{} `json:"positions"`
+	Roles             []map[string]interface{} `json:"roles"`
+	Teams             []map[string]interface{} `json:"teams"`
+	TeamMembers       []map[string]interface{} `json:"team_members"`
+}
+
+type CompanyExportPostsResponse struct {
+	Posts           []map[string]interface{} `json:"posts"`
+	PostImages      []map[string]interface{} `json:"post_images"`
+	PostDepartments []map[string]interface{} `json:"post_departments"`
+	Comments        []map[string]interface{} `json:"comments"`
+}
+
+type CompanyExportBoardsResponse struct {
+	Projects      []map[string]interface{} `json:"projects"`
+	ProjectUsers  []map[string]interface{} `json:"project_users"`
+	Boards        []map[string]interface{} `json:"boards"`
+	BoardColumns  []map[string]interface{} `json:"board_columns"`
+	BoardCards    []map[string]interface{} `json:"board_cards"`
+	CardAssignees []map[string]interface{} `json:"card_assignees"`
+}
+
+type CompanyExportChatsResponse struct {
+	ChatRooms     []map[string]interface{} `json:"chat_rooms"`
+	ChatRoomUsers []map[string]interface{} `json:"chat_room_users"`
+	Messages      []map[string]interface{} `json:"messages"`
+}
@@ -8,6 +8,7 @@ type DepartmentListResponse struct {
 	CompanyID          uint      `json:"company_id"`
 	DepartmentLeaderID *uint     `json:"department_leader_id"`
 	DepartmentLeader   *string   `json:"department_leader_name"`
+	ParentID           *uint     `json:"parent_id"`
 	CreatedAt          time.Time `json:"created_at"`
 	UpdatedAt          time.Time `json:"updated_at"`
 }
@@ -17,3 +18,27 @@ type UpdateDepartmentResponse struct {
 	Name      string `json:"name"`
 	ManagerID uint   `json:"manager_id"` //TODO 추후 매니저 *(사용자 테이블과 조인하여 결과 )
 }
+
+// TODO 부서 통합, 분리 미리보기 (실행 결과도 같은 형태)
+type DepartmentReorganizeResponse struct {
+	Type                    string                            `json:"type"` // MERGE, SPLIT
+	SourceDepartment        DepartmentSummaryResponse         `json:"source_department"`
+	TargetDepartment        DepartmentSummaryResponse         `json:"target_department"`
+	MovedUsers              []DepartmentMemberSummaryResponse `json:"moved_users"`
+	AlreadyMemberUserIds    []uint                            `json:"already_member_user_ids,omitempty"` // 통합 시 이미 target 소속인 사용자
+	RemainingUserCount      int                               `json:"remaining_user_count"`              // 작업 후 source 부서에 남는 인원
+	MovedPostIds            []uint                            `json:"moved_post_ids"`
+	MovedChildDepartmentIds []uint                            `json:"moved_child_department_ids,omitempty"`
+	SourceDeleted           bool                              `json:"source_deleted"`
+}
+
+type DepartmentSummaryResponse struct {
+	ID       uint   `json:"id,omitempty"` // 분리 미리보기에서는 아직 없음
+	Name     string `json:"name"`
+	ParentID *uint  `json:"parent_id"`
+}
+
+type DepartmentMemberSummaryResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
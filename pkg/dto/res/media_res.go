@@ -0,0 +1,6 @@
+package res
+
+type GetSignedMediaURLResponse struct {
+	URL       string `json:"url"`
+	ExpiresAt string `json:"expires_at"`
+}
@@ -16,6 +16,8 @@ type GetPostResponse struct {
 	CreatedAt    string   `json:"created_at"`
 	UpdatedAt    string   `json:"updated_at,omitempty"`
 	ViewCount    int      `json:"view_count"`
+
+	TeamId uint `json:"team_id,omitempty"`
 }
 
 type GetPostsResponse struct {
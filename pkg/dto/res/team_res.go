@@ -3,4 +3,29 @@ package res
 type GetTeamsByCompanyResponse struct {
 	ID   uint   `json:"id"`
 	Name string `json:"name"`
+
+	//TODO 팀 목록
+	Handle         string `json:"handle"`
+	Description    string `json:"description,omitempty"`
+	DepartmentID   *uint  `json:"department_id,omitempty"`
+	DepartmentName string `json:"department_name,omitempty"`
+	ChatRoomID     *uint  `json:"chat_room_id,omitempty"`
+	MemberCount    int    `json:"member_count"`
+	IsMember       bool   `json:"is_member"`
+	CreatedAt      string `json:"created_at"`
+}
+
+type TeamResponse struct {
+	GetTeamsByCompanyResponse
+	Members []TeamMemberResponse `json:"members"`
+}
+
+type TeamMemberResponse struct {
+	UserID   uint   `json:"user_id"`
+	Name     string `json:"name"`
+	Nickname string `json:"nickname"`
+	Email    string `json:"email"`
+	Image    string `json:"image,omitempty"`
+	IsLead   bool   `json:"is_lead"`
+	JoinedAt string `json:"joined_at"`
 }
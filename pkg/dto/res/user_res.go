@@ -9,6 +9,16 @@ type RegisterUserResponse struct {
 	Phone    string `json:"phone,omitempty"`
 	Nickname string `json:"nickname,omitempty"`
 	Role     uint   `json:"role,omitempty"`
+
+	//TODO 이메일 확인 메일 전송 여부 - 실패하면 재발송 요청
+	EmailVerificationSent bool `json:"email_verification_sent"`
+}
+
+// TODO 이메일 확인 결과 - 이메일 도메인을 등록한 회사에 자동 가입되었거나 가입 제안
+type VerifyEmailResponse struct {
+	Email            string                      `json:"email"`
+	JoinedCompany    *EmailDomainCompanyResponse `json:"joined_company,omitempty"`
+	SuggestedCompany *EmailDomainCompanyResponse `json:"suggested_company,omitempty"`
 }
 
 type GetUsersByCompanyResponse struct {
@@ -86,3 +96,25 @@ type GetOrganizationUserInfoResponse struct {
 	PositionName    string     `json:"position_name,omitempty"`
 	EntryDate       *time.Time `json:"entry_date,omitempty"`
 }
+
+// TODO 사용자가 소속된 회사 목록 (다중 회사)
+type UserCompanyResponse struct {
+	CompanyID    uint                            `json:"company_id"`
+	CompanyName  string                          `json:"company_name"`
+	Role         uint                            `json:"role"`
+	PositionID   *uint                           `json:"position_id,omitempty"`
+	PositionName string                          `json:"position_name,omitempty"`
+	Departments  []UserCompanyDepartmentResponse `json:"departments"`
+	EntryDate    string                          `json:"entry_date,omitempty"`
+	IsPrimary    bool                            `json:"is_primary"` // 로그인 시 기본 회사
+	IsActive     bool                            `json:"is_active"`  // 현재 세션에서 선택한 회사
+
+	//TODO 회사에서 정의한 역할
+	CustomRoleID   *uint  `json:"custom_role_id,omitempty"`
+	CustomRoleName string `json:"custom_role_name,omitempty"`
+}
+
+type UserCompanyDepartmentResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
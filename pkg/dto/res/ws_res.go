@@ -7,6 +7,26 @@ type JsonResponse struct {
 	Payload interface{} `json:"payload,omitempty"`
 }
 
+// 채팅방을 보고 있는 사용자
+type ChatViewerResponse struct {
+	UserID uint   `json:"user_id"`
+	Name   string `json:"name"`
+	Since  string `json:"since"`
+}
+
+type ChatPresencePayload struct {
+	ChatRoomID uint                  `json:"chat_room_id"`
+	Viewers    []*ChatViewerResponse `json:"viewers,omitempty"`
+	Viewer     *ChatViewerResponse   `json:"viewer,omitempty"`
+}
+
+type ChatTypingPayload struct {
+	ChatRoomID uint   `json:"chat_room_id"`
+	UserID     uint   `json:"user_id"`
+	Name       string `json:"name,omitempty"`
+	ExpiresIn  int    `json:"expires_in,omitempty"` // 초 단위, 다시 typing.start를 보내지 않으면 자동 종료
+}
+
 type Ws_UserResponse struct {
 	UserID   uint `json:"user_id"`
 	IsOnline bool `json:"is_online"`
@@ -103,7 +103,7 @@ func (h *AdminHandler) AdminGetAllUsers(c *gin.Context) {
 			Phone:           *user.Phone,
 			Role:            uint(user.Role),
 			Status:          *user.Status,
-			Image:           user.UserProfile.Image,
+			Image:           util.SignProfileImageURLPtr(user.UserProfile.Image),
 			Birthday:        user.UserProfile.Birthday,
 			CompanyID:       util.GetValueOrDefault(user.UserProfile.CompanyID, 0),
 			CompanyName:     util.GetFirstOrEmpty(util.ExtractValuesFromMapSlice[string]([]*map[string]interface{}{user.UserProfile.Company}, "name"), ""),
@@ -214,7 +214,7 @@ func (h *AdminHandler) AdminDeleteCompany(c *gin.Context) {
 		return
 	}
 
-	err = h.adminUsecase.AdminDeleteCompany(requestUserID, uint(companyID))
+	response, err := h.adminUsecase.AdminDeleteCompany(requestUserID, uint(companyID))
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -224,7 +224,40 @@ func (h *AdminHandler) AdminDeleteCompany(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "회사 삭제에 성공하였습니다.", nil))
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "회사 삭제가 예약되었습니다.", response))
+}
+
+// TODO 회사 복구 - ADMIN, 삭제 유예 기간 안에만 가능
+func (h *AdminHandler) AdminRestoreCompany(c *gin.Context) {
+	companyID, err := strconv.Atoi(c.Param("companyid"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다.", err))
+		return
+	}
+
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	requestUserID, ok := userId.(uint)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "사용자 ID 형식이 잘못되었습니다", nil))
+		return
+	}
+
+	err = h.adminUsecase.AdminRestoreCompany(requestUserID, uint(companyID))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "회사 복구에 성공하였습니다.", nil))
 }
 
 // TODO 회사 업데이트 - ADMIN
@@ -115,7 +115,8 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 		return
 	}
 
-	newAccessToken, err := util.GenerateAccessToken(claims.Name, claims.Email, claims.UserId)
+	//TODO 활성 회사는 요청한 세션의 리프레시 토큰 기준 (세션마다 다를 수 있음)
+	newAccessToken, err := util.GenerateAccessToken(claims.Name, claims.Email, claims.UserId, getActiveCompanyId(c))
 	if err != nil {
 		log.Printf("액세스 토큰 재발급 중 오류가 발생했습니다: %v", err)
 		if appError, ok := err.(*common.AppError); ok {
@@ -133,3 +134,43 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 	// c.SetCookie("accessToken", newAccessToken, 1200, "/", "", false, true)
 	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "액세스 토큰 재발급 성공", nil))
 }
+
+// TODO 활성 회사 변경 - 선택한 회사 기준으로 토큰 재발급
+func (h *AuthHandler) SwitchCompany(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.SwitchCompanyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, token, err := h.authUsecase.SwitchCompany(userId.(uint), request.CompanyID)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	authorization := fmt.Sprintf("Bearer %s", token.AccessToken)
+	c.Header("Authorization", authorization)
+	c.SetCookie("refreshToken", token.RefreshToken, 259200, "/", "", false, true) // 3일
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "활성 회사 변경 성공", response))
+}
+
+// getActiveCompanyId 토큰에 담긴 활성 회사 ID (0이면 대표 회사)
+func getActiveCompanyId(c *gin.Context) uint {
+	companyId, exists := c.Get("companyId")
+	if !exists {
+		return 0
+	}
+	activeCompanyId, _ := companyId.(uint)
+	return activeCompanyId
+}
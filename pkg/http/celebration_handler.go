@@ -0,0 +1,68 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	_celebrationUsecase "link/internal/celebration/usecase"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+)
+
+type CelebrationHandler struct {
+	celebrationUsecase _celebrationUsecase.CelebrationUsecase
+}
+
+func NewCelebrationHandler(celebrationUsecase _celebrationUsecase.CelebrationUsecase) *CelebrationHandler {
+	return &CelebrationHandler{celebrationUsecase: celebrationUsecase}
+}
+
+// TODO 회사 생일, 입사기념일 목록 조회 (range=today|week|month)
+func (h *CelebrationHandler) GetCelebrations(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 사용자입니다.", nil))
+		return
+	}
+
+	rangeType := c.DefaultQuery("range", "week")
+
+	response, err := h.celebrationUsecase.GetCelebrations(userId.(uint), getActiveCompanyId(c), rangeType)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "기념일 조회 성공", response))
+}
+
+// TODO 축하 게시물 자동 작성 설정 (회사 관리자만)
+func (h *CelebrationHandler) UpdateCelebrationSetting(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 사용자입니다.", nil))
+		return
+	}
+
+	var request req.UpdateCelebrationSettingRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	if err := h.celebrationUsecase.UpdateCelebrationSetting(userId.(uint), getActiveCompanyId(c), request); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "기념일 설정 변경 성공", nil))
+}
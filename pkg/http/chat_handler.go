@@ -11,6 +11,7 @@ import (
 	"link/internal/chat/usecase"
 	"link/pkg/common"
 	"link/pkg/dto/req"
+	"link/pkg/dto/res"
 	"link/pkg/ws"
 )
 
@@ -112,7 +113,11 @@ func (h *ChatHandler) GetChatRoomList(c *gin.Context) {
 		return
 	}
 
-	chatRooms, err := h.chatUsecase.GetChatRoomList(requestUserId)
+	queryParams := req.GetChatRoomListQueryParams{
+		Archived: c.Query("archived") == "true",
+	}
+
+	chatRooms, err := h.chatUsecase.GetChatRoomList(requestUserId, &queryParams)
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -243,3 +248,959 @@ func (h *ChatHandler) DeleteChatMessage(c *gin.Context) {
 
 	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 메시지 삭제 성공", nil))
 }
+
+// TODO 채팅 메시지 수정
+func (h *ChatHandler) UpdateChatMessage(c *gin.Context) {
+	senderId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", fmt.Errorf("userId가 없습니다")))
+		return
+	}
+
+	var request req.UpdateChatMessageRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.UpdateChatMessage(senderId.(uint), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 메시지 수정 성공", response))
+}
+
+// TODO 스레드 조회 - 원본 메시지와 답글 (오래된 순, 커서 페이지네이션)
+func (h *ChatHandler) GetChatThread(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	parentMessageId := c.Param("messageid")
+	if parentMessageId == "" {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅 메시지 ID입니다", nil))
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	var cursor *req.ChatCursor
+	if cursorParam := c.Query("cursor"); cursorParam != "" {
+		if err := json.Unmarshal([]byte(cursorParam), &cursor); err != nil {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 커서 값입니다.", err))
+			return
+		}
+	}
+
+	queryParams := req.GetChatThreadQueryParams{
+		Limit:  limit,
+		Cursor: cursor,
+	}
+
+	response, err := h.chatUsecase.GetChatThread(userId.(uint), uint(chatRoomId), parentMessageId, &queryParams)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "스레드 조회 성공", response))
+}
+
+// TODO 채팅방 참여자와 권한 조회
+func (h *ChatHandler) GetChatRoomMembers(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.GetChatRoomMembers(userId.(uint), uint(chatRoomId))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅방 참여자 조회 성공", response))
+}
+
+// TODO 그룹 채팅방 이름, 이미지 변경
+func (h *ChatHandler) UpdateChatRoom(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	var request req.UpdateChatRoomRequest
+	if err := c.ShouldBind(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	var imageUrl *string
+	if chatRoomImageUrl, exists := c.Get("chat_room_image_url"); exists {
+		url, ok := chatRoomImageUrl.(string)
+		if !ok {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "이미지 처리 실패", nil))
+			return
+		}
+		imageUrl = &url
+	}
+
+	response, err := h.chatUsecase.UpdateChatRoom(userId.(uint), uint(chatRoomId), &request, imageUrl)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅방 수정 성공", response))
+}
+
+// TODO 그룹 채팅방 멤버 추가
+func (h *ChatHandler) AddChatRoomMembers(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	var request req.AddChatRoomMembersRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.AddChatRoomMembers(userId.(uint), uint(chatRoomId), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅방 멤버 추가 성공", response))
+}
+
+// TODO 그룹 채팅방 멤버 내보내기
+func (h *ChatHandler) RemoveChatRoomMember(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	targetUserId, err := strconv.ParseUint(c.Param("userid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 사용자 ID입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.RemoveChatRoomMember(userId.(uint), uint(chatRoomId), uint(targetUserId))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅방 멤버 내보내기 성공", response))
+}
+
+// TODO 그룹 채팅방 관리자 지정/해제
+func (h *ChatHandler) UpdateChatRoomMemberRole(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	targetUserId, err := strconv.ParseUint(c.Param("userid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 사용자 ID입니다", err))
+		return
+	}
+
+	var request req.UpdateChatRoomMemberRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.UpdateChatRoomMemberRole(userId.(uint), uint(chatRoomId), uint(targetUserId), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅방 멤버 권한 변경 성공", response))
+}
+
+// TODO 방장 위임
+func (h *ChatHandler) TransferChatRoomOwnership(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	var request req.TransferChatRoomOwnerRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.TransferChatRoomOwnership(userId.(uint), uint(chatRoomId), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "방장 위임 성공", response))
+}
+
+// TODO 채팅 첨부파일 전송 - 파일은 ChatAttachmentUploadMiddleware에서 저장
+func (h *ChatHandler) UploadChatAttachments(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	var request req.SendChatAttachmentRequest
+	if err := c.ShouldBind(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	chatAttachments, exists := c.Get("chat_attachments")
+	if !exists {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "첨부파일이 없습니다", nil))
+		return
+	}
+	uploads, ok := chatAttachments.([]*req.ChatAttachmentUpload)
+	if !ok {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "첨부파일 처리 실패", nil))
+		return
+	}
+
+	response, err := h.chatUsecase.SendAttachmentMessage(userId.(uint), uint(chatRoomId), &request, uploads)
+	if err != nil {
+		//TODO 같은 client_msg_id로 재전송 - 이미 저장된 메시지를 돌려주고 브로드캐스트하지 않음
+		if appError, ok := err.(*common.AppError); ok && appError.StatusCode == http.StatusConflict && response != nil {
+			c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "이미 전송된 첨부파일입니다", response))
+			return
+		}
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	//TODO 스레드에만 보낸 답글은 chat.thread.reply 이벤트로 전달
+	if response.ParentMessageID == "" || response.ShowInRoom {
+		h.hub.SendMessageToChatRoom(uint(chatRoomId), res.JsonResponse{
+			Success: true,
+			Type:    "chat",
+			Payload: response,
+		})
+	}
+
+	c.JSON(http.StatusCreated, common.NewResponse(http.StatusCreated, "첨부파일 전송 성공", response))
+}
+
+// TODO 채팅방 파일 모아보기
+func (h *ChatHandler) GetChatFiles(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	var cursor *req.ChatCursor
+	if cursorParam := c.Query("cursor"); cursorParam != "" {
+		if err := json.Unmarshal([]byte(cursorParam), &cursor); err != nil {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 커서 값입니다.", err))
+			return
+		}
+	}
+
+	queryParams := req.GetChatFilesQueryParams{
+		Limit:  limit,
+		Cursor: cursor,
+	}
+
+	response, err := h.chatUsecase.GetChatFiles(userId.(uint), uint(chatRoomId), &queryParams)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅방 파일 조회 성공", response))
+}
+
+// TODO 채팅 검색
+func (h *ChatHandler) SearchChatMessages(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	queryParams := req.SearchChatMessagesQueryParams{
+		Query: c.Query("q"),
+		From:  c.Query("from"),
+		To:    c.Query("to"),
+		Page:  page,
+		Limit: limit,
+	}
+
+	if roomIdParam := c.Query("room_id"); roomIdParam != "" {
+		roomId, err := strconv.ParseUint(roomIdParam, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+			return
+		}
+		queryParams.ChatRoomID = uint(roomId)
+	}
+
+	if senderIdParam := c.Query("sender_id"); senderIdParam != "" {
+		senderId, err := strconv.ParseUint(senderIdParam, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 사용자 ID입니다", err))
+			return
+		}
+		queryParams.SenderID = uint(senderId)
+	}
+
+	response, err := h.chatUsecase.SearchChatMessages(userId.(uint), &queryParams)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 검색 성공", response))
+}
+
+// TODO 검색 결과로 이동 - 메시지 앞뒤 채팅 조회
+func (h *ChatHandler) GetChatMessageContext(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	chatMessageId := c.Param("messageid")
+	if chatMessageId == "" {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅 메시지 ID입니다", nil))
+		return
+	}
+
+	before, err := strconv.Atoi(c.DefaultQuery("before", "10"))
+	if err != nil || before < 0 || before > 50 {
+		before = 10
+	}
+
+	after, err := strconv.Atoi(c.DefaultQuery("after", "10"))
+	if err != nil || after < 0 || after > 50 {
+		after = 10
+	}
+
+	response, err := h.chatUsecase.GetChatMessageContext(userId.(uint), uint(chatRoomId), chatMessageId, before, after)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "메시지 조회 성공", response))
+}
+
+// TODO 채팅 메시지 이모지 반응
+func (h *ChatHandler) AddChatMessageReaction(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	var request req.ChatReactionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.AddChatMessageReaction(userId.(uint), uint(chatRoomId), c.Param("messageid"), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 메시지 반응 성공", response))
+}
+
+// TODO 채팅 메시지 이모지 반응 취소
+func (h *ChatHandler) RemoveChatMessageReaction(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	emojiId, err := strconv.ParseUint(c.Param("emojiid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "이모지 ID 조회 실패", err))
+		return
+	}
+
+	response, err := h.chatUsecase.RemoveChatMessageReaction(userId.(uint), uint(chatRoomId), c.Param("messageid"), uint(emojiId))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 메시지 반응 취소 성공", response))
+}
+
+// TODO 채팅방 읽음 처리 - 읽음 커서 이동
+func (h *ChatHandler) ReadChatRoom(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	var request req.ReadChatRoomRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+			return
+		}
+	}
+
+	response, err := h.chatUsecase.ReadChatRoom(userId.(uint), uint(chatRoomId), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅방 읽음 처리 성공", response))
+}
+
+// TODO 채팅 메시지 수정 이력 조회
+func (h *ChatHandler) GetChatMessageHistory(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	chatMessageId := c.Param("messageid")
+	if chatMessageId == "" {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅 메시지 ID입니다", nil))
+		return
+	}
+
+	response, err := h.chatUsecase.GetChatMessageHistory(userId.(uint), uint(chatRoomId), chatMessageId)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 메시지 수정 이력 조회 성공", response))
+}
+
+// TODO 채팅방 고정 메시지 목록
+func (h *ChatHandler) GetPinnedChatMessages(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.GetPinnedChatMessages(userId.(uint), uint(chatRoomId))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "고정 메시지 조회 성공", response))
+}
+
+// TODO 채팅 메시지 고정
+func (h *ChatHandler) PinChatMessage(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.PinChatMessage(userId.(uint), uint(chatRoomId), c.Param("messageid"))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 메시지 고정 성공", response))
+}
+
+// TODO 채팅 메시지 고정 해제
+func (h *ChatHandler) UnpinChatMessage(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.UnpinChatMessage(userId.(uint), uint(chatRoomId), c.Param("messageid"))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 메시지 고정 해제 성공", response))
+}
+
+// TODO 채팅 메시지 북마크
+func (h *ChatHandler) BookmarkChatMessage(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	if err := h.chatUsecase.BookmarkChatMessage(userId.(uint), uint(chatRoomId), c.Param("messageid")); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 메시지 북마크 성공", nil))
+}
+
+// TODO 채팅 메시지 북마크 해제
+func (h *ChatHandler) RemoveChatMessageBookmark(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatMessageId := c.Param("messageid")
+	if chatMessageId == "" {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅 메시지 ID입니다", nil))
+		return
+	}
+
+	if err := h.chatUsecase.RemoveChatMessageBookmark(userId.(uint), chatMessageId); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 메시지 북마크 해제 성공", nil))
+}
+
+// TODO 저장 항목 - 북마크한 메시지 목록
+func (h *ChatHandler) GetChatBookmarks(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	queryParams := req.GetChatBookmarksQueryParams{
+		Page:  page,
+		Limit: limit,
+	}
+
+	response, err := h.chatUsecase.GetChatBookmarks(userId.(uint), &queryParams)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "저장 항목 조회 성공", response))
+}
+
+// TODO 사용자별 채팅방 설정 - 알림 끄기, 보관, 상단 고정
+func (h *ChatHandler) UpdateChatRoomSettings(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	var request req.UpdateChatRoomSettingsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.UpdateChatRoomSettings(userId.(uint), uint(chatRoomId), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅방 설정 변경 성공", response))
+}
+
+// TODO 예약 메시지 등록
+func (h *ChatHandler) ScheduleChatMessage(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	var request req.ScheduleChatMessageRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.ScheduleChatMessage(userId.(uint), uint(chatRoomId), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, common.NewResponse(http.StatusCreated, "예약 메시지 등록 성공", response))
+}
+
+// TODO 예약 메시지 목록
+func (h *ChatHandler) GetScheduledChatMessages(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.GetScheduledChatMessages(userId.(uint), uint(chatRoomId))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "예약 메시지 조회 성공", response))
+}
+
+// TODO 예약 메시지 수정
+func (h *ChatHandler) UpdateScheduledChatMessage(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	scheduledId, err := strconv.ParseUint(c.Param("scheduledid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 예약 메시지 ID입니다", err))
+		return
+	}
+
+	var request req.UpdateScheduledChatMessageRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.UpdateScheduledChatMessage(userId.(uint), uint(chatRoomId), uint(scheduledId), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "예약 메시지 수정 성공", response))
+}
+
+// TODO 예약 메시지 취소
+func (h *ChatHandler) CancelScheduledChatMessage(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	scheduledId, err := strconv.ParseUint(c.Param("scheduledid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 예약 메시지 ID입니다", err))
+		return
+	}
+
+	if err := h.chatUsecase.CancelScheduledChatMessage(userId.(uint), uint(chatRoomId), uint(scheduledId)); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "예약 메시지 취소 성공", nil))
+}
@@ -0,0 +1,174 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	_companyUsecase "link/internal/company/usecase"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+)
+
+type CompanyEmailDomainHandler struct {
+	companyEmailDomainUsecase _companyUsecase.CompanyEmailDomainUsecase
+}
+
+func NewCompanyEmailDomainHandler(companyEmailDomainUsecase _companyUsecase.CompanyEmailDomainUsecase) *CompanyEmailDomainHandler {
+	return &CompanyEmailDomainHandler{companyEmailDomainUsecase: companyEmailDomainUsecase}
+}
+
+// TODO 이메일 도메인 등록 (Role 3,4)
+func (h *CompanyEmailDomainHandler) ClaimCompanyEmailDomain(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.ClaimCompanyEmailDomainRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.companyEmailDomainUsecase.ClaimCompanyEmailDomain(userId.(uint), getActiveCompanyId(c), request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, common.NewResponse(http.StatusCreated, "이메일 도메인 등록 성공", response))
+}
+
+// TODO 이메일 도메인 목록 (Role 3,4)
+func (h *CompanyEmailDomainHandler) GetCompanyEmailDomains(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	response, err := h.companyEmailDomainUsecase.GetCompanyEmailDomains(userId.(uint), getActiveCompanyId(c))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "이메일 도메인 목록 조회 성공", response))
+}
+
+// TODO 이메일 도메인 소유 확인 (Role 3,4)
+func (h *CompanyEmailDomainHandler) VerifyCompanyEmailDomain(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	domainId, err := strconv.ParseUint(c.Param("domainid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 도메인 ID입니다", err))
+		return
+	}
+
+	response, err := h.companyEmailDomainUsecase.VerifyCompanyEmailDomain(userId.(uint), getActiveCompanyId(c), uint(domainId))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "이메일 도메인 인증 성공", response))
+}
+
+// TODO 이메일 도메인 가입 정책 변경 (Role 3,4)
+func (h *CompanyEmailDomainHandler) UpdateCompanyEmailDomainPolicy(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	domainId, err := strconv.ParseUint(c.Param("domainid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 도메인 ID입니다", err))
+		return
+	}
+
+	var request req.UpdateCompanyEmailDomainRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	if err := h.companyEmailDomainUsecase.UpdateCompanyEmailDomainPolicy(userId.(uint), getActiveCompanyId(c), uint(domainId), request); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "이메일 도메인 가입 정책 변경 성공", nil))
+}
+
+// TODO 이메일 도메인 삭제 (Role 3,4)
+func (h *CompanyEmailDomainHandler) DeleteCompanyEmailDomain(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	domainId, err := strconv.ParseUint(c.Param("domainid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 도메인 ID입니다", err))
+		return
+	}
+
+	if err := h.companyEmailDomainUsecase.DeleteCompanyEmailDomain(userId.(uint), getActiveCompanyId(c), uint(domainId)); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "이메일 도메인 삭제 성공", nil))
+}
+
+// TODO 이메일 도메인으로 가입 가능한 회사 목록
+func (h *CompanyEmailDomainHandler) GetSuggestedCompanies(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	response, err := h.companyEmailDomainUsecase.GetSuggestedCompanies(userId.(uint))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "가입 가능한 회사 조회 성공", response))
+}
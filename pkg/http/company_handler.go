@@ -29,6 +29,32 @@ func NewCompanyHandler(companyUsecase _companyUsecase.CompanyUsecase,
 	}
 }
 
+// TODO 이메일 도메인으로 제안받은 회사에 본인 가입
+func (h *CompanyHandler) JoinCompanyByEmailDomain(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.JoinCompanyByEmailDomainRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	if err := h.companyUsecase.AddUserToCompany(userId.(uint), userId.(uint), request.CompanyID); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "회사 가입 성공", nil))
+}
+
 // TODO 회사 전체 목록 불러오기 - 모든 사용자 사용 가능
 func (h *CompanyHandler) GetAllCompanies(c *gin.Context) {
 	companies, err := h.companyUsecase.GetAllCompanies()
@@ -147,7 +173,7 @@ func (h *CompanyHandler) GetOrganizationByCompany(c *gin.Context) {
 		return
 	}
 
-	response, err := h.companyUsecase.GetOrganizationByCompany(userId.(uint))
+	response, err := h.companyUsecase.GetOrganizationByCompany(userId.(uint), getActiveCompanyId(c))
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -160,6 +186,27 @@ func (h *CompanyHandler) GetOrganizationByCompany(c *gin.Context) {
 	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "회사 조직도 조회 성공", response))
 }
 
+// TODO 계층형 회사 조직도 조회 - 부서장, 인원수, 구성원 포함
+func (h *CompanyHandler) GetOrganizationTree(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	response, err := h.companyUsecase.GetOrganizationTree(userId.(uint), getActiveCompanyId(c))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "계층형 조직도 조회 성공", response))
+}
+
 func (h *CompanyHandler) CreateCompanyPosition(c *gin.Context) {
 	userId, exists := c.Get("userId")
 	if !exists {
@@ -200,7 +247,7 @@ func (h *CompanyHandler) GetCompanyPositionList(c *gin.Context) {
 		return
 	}
 
-	positions, err := h.companyUsecase.GetCompanyPositionList(userId.(uint))
+	positions, err := h.companyUsecase.GetCompanyPositionList(userId.(uint), getActiveCompanyId(c))
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -227,7 +274,7 @@ func (h *CompanyHandler) GetCompanyPositionDetail(c *gin.Context) {
 		return
 	}
 
-	position, err := h.companyUsecase.GetCompanyPositionDetail(userId.(uint), uint(positionId))
+	position, err := h.companyUsecase.GetCompanyPositionDetail(userId.(uint), getActiveCompanyId(c), uint(positionId))
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -254,7 +301,7 @@ func (h *CompanyHandler) DeleteCompanyPosition(c *gin.Context) {
 		return
 	}
 
-	err = h.companyUsecase.DeleteCompanyPosition(userId.(uint), uint(positionId))
+	err = h.companyUsecase.DeleteCompanyPosition(userId.(uint), getActiveCompanyId(c), uint(positionId))
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -287,7 +334,7 @@ func (h *CompanyHandler) UpdateCompanyPosition(c *gin.Context) {
 		return
 	}
 
-	err = h.companyUsecase.UpdateCompanyPosition(userId.(uint), uint(positionId), request)
+	err = h.companyUsecase.UpdateCompanyPosition(userId.(uint), getActiveCompanyId(c), uint(positionId), request)
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
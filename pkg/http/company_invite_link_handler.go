@@ -0,0 +1,142 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	_companyUsecase "link/internal/company/usecase"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+)
+
+type CompanyInviteLinkHandler struct {
+	companyInviteLinkUsecase _companyUsecase.CompanyInviteLinkUsecase
+}
+
+func NewCompanyInviteLinkHandler(companyInviteLinkUsecase _companyUsecase.CompanyInviteLinkUsecase) *CompanyInviteLinkHandler {
+	return &CompanyInviteLinkHandler{companyInviteLinkUsecase: companyInviteLinkUsecase}
+}
+
+// TODO 초대 링크 생성 (Role 3,4)
+func (h *CompanyInviteLinkHandler) CreateCompanyInviteLink(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.CreateCompanyInviteLinkRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.companyInviteLinkUsecase.CreateCompanyInviteLink(userId.(uint), getActiveCompanyId(c), request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, common.NewResponse(http.StatusCreated, "초대 링크 생성 성공", response))
+}
+
+// TODO 초대 링크 목록 (Role 3,4)
+func (h *CompanyInviteLinkHandler) GetCompanyInviteLinks(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	response, err := h.companyInviteLinkUsecase.GetCompanyInviteLinks(userId.(uint), getActiveCompanyId(c))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "초대 링크 목록 조회 성공", response))
+}
+
+// TODO 초대 링크 폐기 (Role 3,4)
+func (h *CompanyInviteLinkHandler) RevokeCompanyInviteLink(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	linkId, err := strconv.ParseUint(c.Param("linkid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 초대 링크 ID입니다", err))
+		return
+	}
+
+	if err := h.companyInviteLinkUsecase.RevokeCompanyInviteLink(userId.(uint), getActiveCompanyId(c), uint(linkId)); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "초대 링크 폐기 성공", nil))
+}
+
+// TODO 초대 링크 미리보기
+func (h *CompanyInviteLinkHandler) GetCompanyInviteLinkPreview(c *gin.Context) {
+	code := c.Param("code")
+	if code == "" {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "초대 코드가 필요합니다", nil))
+		return
+	}
+
+	response, err := h.companyInviteLinkUsecase.GetCompanyInviteLinkPreview(code)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "초대 링크 조회 성공", response))
+}
+
+// TODO 초대 링크 사용 - 가입 후 회사 전환은 /auth/company/switch
+func (h *CompanyInviteLinkHandler) RedeemCompanyInviteLink(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	code := c.Param("code")
+	if code == "" {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "초대 코드가 필요합니다", nil))
+		return
+	}
+
+	response, err := h.companyInviteLinkUsecase.RedeemCompanyInviteLink(userId.(uint), code)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "초대 링크 사용 성공", response))
+}
@@ -0,0 +1,64 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	_companyUsecase "link/internal/company/usecase"
+	"link/pkg/common"
+)
+
+type CompanyOffboardingHandler struct {
+	companyOffboardingUsecase _companyUsecase.CompanyOffboardingUsecase
+}
+
+func NewCompanyOffboardingHandler(companyOffboardingUsecase _companyUsecase.CompanyOffboardingUsecase) *CompanyOffboardingHandler {
+	return &CompanyOffboardingHandler{companyOffboardingUsecase: companyOffboardingUsecase}
+}
+
+// TODO 회사 전체 데이터 내보내기 (회사 관리자 - 활성 회사)
+func (h *CompanyOffboardingHandler) ExportCompanyData(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	h.exportCompanyData(c, userId.(uint), getActiveCompanyId(c))
+}
+
+// TODO 회사 전체 데이터 내보내기 - ADMIN
+func (h *CompanyOffboardingHandler) AdminExportCompanyData(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	companyId, err := strconv.ParseUint(c.Param("companyid"), 10, 64)
+	if err != nil || companyId == 0 {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 회사 ID입니다", err))
+		return
+	}
+
+	h.exportCompanyData(c, userId.(uint), uint(companyId))
+}
+
+// exportCompanyData 내보내기 결과를 JSON 파일로 다운로드
+func (h *CompanyOffboardingHandler) exportCompanyData(c *gin.Context, userId uint, companyId uint) {
+	response, err := h.companyOffboardingUsecase.ExportCompanyData(userId, companyId)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", response.FileName))
+	c.JSON(http.StatusOK, response)
+}
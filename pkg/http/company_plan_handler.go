@@ -0,0 +1,39 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	_companyUsecase "link/internal/company/usecase"
+	"link/pkg/common"
+)
+
+type CompanyPlanHandler struct {
+	companyPlanUsecase _companyUsecase.CompanyPlanUsecase
+}
+
+func NewCompanyPlanHandler(companyPlanUsecase _companyUsecase.CompanyPlanUsecase) *CompanyPlanHandler {
+	return &CompanyPlanHandler{companyPlanUsecase: companyPlanUsecase}
+}
+
+// TODO 회사 요금제 사용량 조회 (Role 3,4)
+func (h *CompanyPlanHandler) GetCompanyUsage(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	response, err := h.companyPlanUsecase.GetCompanyUsage(userId.(uint), getActiveCompanyId(c))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "회사 요금제 사용량 조회 성공", response))
+}
@@ -0,0 +1,174 @@
+package http
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	_companyUsecase "link/internal/company/usecase"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+)
+
+type CompanyVerificationHandler struct {
+	companyVerificationUsecase _companyUsecase.CompanyVerificationUsecase
+}
+
+func NewCompanyVerificationHandler(companyVerificationUsecase _companyUsecase.CompanyVerificationUsecase) *CompanyVerificationHandler {
+	return &CompanyVerificationHandler{companyVerificationUsecase: companyVerificationUsecase}
+}
+
+// TODO 회사 인증 요청 (Role 3,4) - multipart: cp_number, files
+func (h *CompanyVerificationHandler) SubmitCompanyVerification(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.SubmitCompanyVerificationRequest
+	if err := c.ShouldBind(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	if documentUrls, exists := c.Get("company_document_urls"); exists {
+		request.DocumentUrls = documentUrls.([]string)
+	}
+	if documentNames, exists := c.Get("company_document_names"); exists {
+		request.DocumentNames = documentNames.([]string)
+	}
+
+	response, err := h.companyVerificationUsecase.SubmitCompanyVerification(userId.(uint), getActiveCompanyId(c), request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, common.NewResponse(http.StatusCreated, "회사 인증 요청 성공", response))
+}
+
+// TODO 우리 회사 인증 요청 이력 (Role 3,4)
+func (h *CompanyVerificationHandler) GetCompanyVerifications(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	response, err := h.companyVerificationUsecase.GetCompanyVerifications(userId.(uint), getActiveCompanyId(c))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "회사 인증 요청 조회 성공", response))
+}
+
+// TODO 인증 서류 다운로드 - 운영자 또는 해당 회사 관리자만
+func (h *CompanyVerificationHandler) DownloadCompanyVerificationDocument(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	documentId, err := strconv.ParseUint(c.Param("documentid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 서류 ID입니다", err))
+		return
+	}
+
+	filePath, fileName, err := h.companyVerificationUsecase.GetCompanyVerificationDocument(userId.(uint), uint(documentId))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	if info, err := os.Stat(filePath); err != nil || info.IsDir() {
+		c.JSON(http.StatusNotFound, common.NewError(http.StatusNotFound, "존재하지 않는 파일입니다", err))
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	c.FileAttachment(filePath, fileName)
+}
+
+// TODO 운영자 회사 인증 요청 목록 (Role 1,2) - status=PENDING|APPROVED|REJECTED
+func (h *CompanyVerificationHandler) GetCompanyVerificationQueue(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	status := c.DefaultQuery("status", "PENDING")
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	response, err := h.companyVerificationUsecase.GetCompanyVerificationQueue(userId.(uint), status, page, limit)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "회사 인증 요청 목록 조회 성공", response))
+}
+
+// TODO 운영자 회사 인증 승인/반려 (Role 1,2)
+func (h *CompanyVerificationHandler) ReviewCompanyVerification(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	verificationId, err := strconv.ParseUint(c.Param("verificationid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 인증 요청 ID입니다", err))
+		return
+	}
+
+	var request req.ReviewCompanyVerificationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	if err := h.companyVerificationUsecase.ReviewCompanyVerification(userId.(uint), uint(verificationId), request); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "회사 인증 요청 처리 성공", nil))
+}
@@ -56,9 +56,10 @@ func (h *DepartmentHandler) CreateDepartment(c *gin.Context) {
 	department := &entity.Department{
 		Name:               request.Name,
 		DepartmentLeaderID: departmentLeaderID,
+		ParentID:           request.ParentID,
 	}
 
-	createdDepartment, err := h.departmentUsecase.CreateDepartment(department, requestUserId)
+	createdDepartment, err := h.departmentUsecase.CreateDepartment(department, requestUserId, getActiveCompanyId(c))
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -79,7 +80,7 @@ func (h *DepartmentHandler) GetDepartments(c *gin.Context) {
 		return
 	}
 
-	departments, err := h.departmentUsecase.GetDepartments(requestUserId.(uint))
+	departments, err := h.departmentUsecase.GetDepartments(requestUserId.(uint), getActiveCompanyId(c))
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -107,7 +108,7 @@ func (h *DepartmentHandler) GetDepartment(c *gin.Context) {
 		return
 	}
 
-	department, err := h.departmentUsecase.GetDepartment(requestUserId.(uint), uint(targetDepartmentID))
+	department, err := h.departmentUsecase.GetDepartment(requestUserId.(uint), getActiveCompanyId(c), uint(targetDepartmentID))
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -141,7 +142,7 @@ func (h *DepartmentHandler) UpdateDepartment(c *gin.Context) {
 		return
 	}
 
-	updatedDepartment, err := h.departmentUsecase.UpdateDepartment(requestUserId.(uint), uint(targetDepartmentID), request)
+	updatedDepartment, err := h.departmentUsecase.UpdateDepartment(requestUserId.(uint), getActiveCompanyId(c), uint(targetDepartmentID), request)
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -169,7 +170,7 @@ func (h *DepartmentHandler) DeleteDepartment(c *gin.Context) {
 		return
 	}
 
-	err = h.departmentUsecase.DeleteDepartment(requestUserId.(uint), uint(targetDepartmentID))
+	err = h.departmentUsecase.DeleteDepartment(requestUserId.(uint), getActiveCompanyId(c), uint(targetDepartmentID))
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -182,6 +183,176 @@ func (h *DepartmentHandler) DeleteDepartment(c *gin.Context) {
 	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "부서 삭제 성공", nil))
 }
 
+// TODO 부서 이동 ( 관리자만 ) - 상위 부서 변경
+func (h *DepartmentHandler) MoveDepartment(c *gin.Context) {
+	departmentID := c.Param("id")
+	targetDepartmentID, err := strconv.ParseUint(departmentID, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 부서 ID입니다", err))
+		return
+	}
+
+	requestUserId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다.", nil))
+		return
+	}
+
+	var request req.MoveDepartmentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	err = h.departmentUsecase.MoveDepartment(requestUserId.(uint), getActiveCompanyId(c), uint(targetDepartmentID), request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "부서 이동 성공", nil))
+}
+
+// TODO 부서 통합 미리보기 ( 관리자만 ) - 변경 없이 옮겨질 구성원, 게시물만 반환
+func (h *DepartmentHandler) PreviewMergeDepartment(c *gin.Context) {
+	departmentID := c.Param("id")
+	targetDepartmentID, err := strconv.ParseUint(departmentID, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 부서 ID입니다", err))
+		return
+	}
+
+	requestUserId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다.", nil))
+		return
+	}
+
+	var request req.MergeDepartmentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.departmentUsecase.PreviewMergeDepartment(requestUserId.(uint), getActiveCompanyId(c), uint(targetDepartmentID), request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "부서 통합 미리보기 성공", response))
+}
+
+// TODO 부서 통합 ( 관리자만 )
+func (h *DepartmentHandler) MergeDepartment(c *gin.Context) {
+	departmentID := c.Param("id")
+	targetDepartmentID, err := strconv.ParseUint(departmentID, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 부서 ID입니다", err))
+		return
+	}
+
+	requestUserId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다.", nil))
+		return
+	}
+
+	var request req.MergeDepartmentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.departmentUsecase.MergeDepartment(requestUserId.(uint), getActiveCompanyId(c), uint(targetDepartmentID), request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "부서 통합 성공", response))
+}
+
+// TODO 부서 분리 미리보기 ( 관리자만 ) - 변경 없이 옮겨질 구성원, 게시물만 반환
+func (h *DepartmentHandler) PreviewSplitDepartment(c *gin.Context) {
+	departmentID := c.Param("id")
+	targetDepartmentID, err := strconv.ParseUint(departmentID, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 부서 ID입니다", err))
+		return
+	}
+
+	requestUserId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다.", nil))
+		return
+	}
+
+	var request req.SplitDepartmentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.departmentUsecase.PreviewSplitDepartment(requestUserId.(uint), getActiveCompanyId(c), uint(targetDepartmentID), request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "부서 분리 미리보기 성공", response))
+}
+
+// TODO 부서 분리 ( 관리자만 )
+func (h *DepartmentHandler) SplitDepartment(c *gin.Context) {
+	departmentID := c.Param("id")
+	targetDepartmentID, err := strconv.ParseUint(departmentID, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 부서 ID입니다", err))
+		return
+	}
+
+	requestUserId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다.", nil))
+		return
+	}
+
+	var request req.SplitDepartmentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.departmentUsecase.SplitDepartment(requestUserId.(uint), getActiveCompanyId(c), uint(targetDepartmentID), request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "부서 분리 성공", response))
+}
+
 // TODO 부서 초대 (Role 4 이하만)
 func (h *DepartmentHandler) InviteUserToDepartment(c *gin.Context) {
 	_, exists := c.Get("userId")
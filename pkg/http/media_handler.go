@@ -0,0 +1,120 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	_mediaUsecase "link/internal/media/usecase"
+	"link/pkg/common"
+	_util "link/pkg/util"
+)
+
+const mediaCacheMaxAge = 5 * time.Minute
+
+type MediaHandler struct {
+	mediaUsecase _mediaUsecase.MediaUsecase
+}
+
+func NewMediaHandler(mediaUsecase _mediaUsecase.MediaUsecase) *MediaHandler {
+	return &MediaHandler{mediaUsecase: mediaUsecase}
+}
+
+// TODO 게시물 이미지 제공 - 서명 URL 또는 게시물 공개 범위 확인 후 제공
+func (h *MediaHandler) ServePostMedia(c *gin.Context) {
+	h.serveMedia(c, "./static/posts", _mediaUsecase.PostMediaPrefix, h.mediaUsecase.CheckPostMediaAccess)
+}
+
+// TODO 프로필 이미지 제공 - 서명 URL 또는 인증된 사용자만
+func (h *MediaHandler) ServeProfileMedia(c *gin.Context) {
+	h.serveMedia(c, "./static/profiles", _mediaUsecase.ProfileMediaPrefix, h.mediaUsecase.CheckProfileMediaAccess)
+}
+
+// TODO 채팅 첨부파일 제공 - 서명 URL 또는 채팅방 참여자만
+func (h *MediaHandler) ServeChatMedia(c *gin.Context) {
+	h.serveMedia(c, "./static/chats", _mediaUsecase.ChatMediaPrefix, h.mediaUsecase.CheckChatMediaAccess)
+}
+
+// TODO 서명 URL 발급 - img 태그처럼 헤더를 보낼 수 없는 곳에서 사용
+func (h *MediaHandler) GetSignedMediaURL(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 사용자입니다.", nil))
+		return
+	}
+
+	mediaUrl := c.Query("url")
+	if mediaUrl == "" {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "url이 없습니다.", nil))
+		return
+	}
+
+	response, err := h.mediaUsecase.GetSignedMediaURL(userId.(uint), path.Clean(mediaUrl))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "서명 URL 발급 완료", response))
+}
+
+func (h *MediaHandler) serveMedia(c *gin.Context, directory string, prefix string, checkAccess func(uint, string) error) {
+	// 경로 조작 방지 - ../ 제거
+	relativePath := strings.TrimPrefix(path.Clean("/"+c.Param("filepath")), "/")
+	if relativePath == "" {
+		c.JSON(http.StatusNotFound, common.NewError(http.StatusNotFound, "존재하지 않는 파일입니다", nil))
+		return
+	}
+	mediaPath := prefix + relativePath
+
+	cacheControl := fmt.Sprintf("private, max-age=%d", int(mediaCacheMaxAge.Seconds()))
+
+	if signature := c.Query("signature"); signature != "" {
+		expiresAt, err := _util.ValidateMediaSignature(mediaPath, c.Query("expires"), signature)
+		if err != nil {
+			c.JSON(http.StatusForbidden, common.NewError(http.StatusForbidden, err.Error(), err))
+			return
+		}
+		// 서명 만료 이후까지 캐시되지 않도록
+		remaining := time.Until(expiresAt)
+		if remaining < mediaCacheMaxAge {
+			cacheControl = fmt.Sprintf("private, max-age=%d", int(remaining.Seconds()))
+		}
+	} else {
+		userId, exists := c.Get("userId")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 사용자입니다.", nil))
+			return
+		}
+
+		if err := checkAccess(userId.(uint), mediaPath); err != nil {
+			if appError, ok := err.(*common.AppError); ok {
+				c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+			} else {
+				c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+			}
+			return
+		}
+	}
+
+	filePath := filepath.Join(directory, filepath.FromSlash(relativePath))
+	if info, err := os.Stat(filePath); err != nil || info.IsDir() {
+		c.JSON(http.StatusNotFound, common.NewError(http.StatusNotFound, "존재하지 않는 파일입니다", err))
+		return
+	}
+
+	// c.File은 http.ServeFile을 사용하므로 Range, If-Modified-Since 요청을 처리함
+	c.Header("Cache-Control", cacheControl)
+	c.Header("Vary", "Authorization")
+	c.File(filePath)
+}
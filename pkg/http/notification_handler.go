@@ -72,6 +72,54 @@ func (h *NotificationHandler) SendMentionNotification(c *gin.Context) {
 	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "언급에 성공 했습니다", nil))
 }
 
+// TODO 팀 언급 처리 - 팀 구성원 전체에게 알림
+func (h *NotificationHandler) SendTeamMentionNotification(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다.", nil))
+		return
+	}
+
+	var request req.SendTeamMentionNotificationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	responses, err := h.notificationUsecase.CreateTeamMention(userId.(uint), getActiveCompanyId(c), request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	//TODO 웹소켓 통신
+	for _, response := range responses {
+		h.hub.SendMessageToUser(response.ReceiverID, res.JsonResponse{
+			Success: true,
+			Type:    "notification",
+			Payload: &res.NotificationPayload{
+				DocID:      response.DocID,
+				SenderID:   response.SenderID,
+				ReceiverID: response.ReceiverID,
+				Content:    response.Content,
+				AlarmType:  string(response.AlarmType),
+				Title:      response.Title,
+				IsRead:     response.IsRead,
+				Status:     response.Status,
+				TargetType: response.TargetType,
+				TargetID:   response.TargetID,
+				CreatedAt:  response.CreatedAt,
+			},
+		})
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "팀 언급에 성공 했습니다", nil))
+}
+
 // TODO 알림 조회 핸들러
 func (h *NotificationHandler) GetNotifications(c *gin.Context) {
 	userId, exists := c.Get("userId")
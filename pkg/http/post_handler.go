@@ -60,7 +60,7 @@ func (h *PostHandler) CreatePost(c *gin.Context) {
 		}
 	}
 
-	err := h.postUsecase.CreatePost(userId.(uint), &request)
+	err := h.postUsecase.CreatePost(userId.(uint), getActiveCompanyId(c), &request)
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -84,7 +84,7 @@ func (h *PostHandler) GetPosts(c *gin.Context) {
 
 	// 게시물 조회 파라미터 처리
 	category := strings.ToLower(c.DefaultQuery("category", "public"))
-	if category != "public" && category != "company" && category != "department" {
+	if category != "public" && category != "company" && category != "department" && category != "team" {
 		category = "public"
 	}
 
@@ -154,17 +154,29 @@ func (h *PostHandler) GetPosts(c *gin.Context) {
 	companyId = uint(companyIdValue)
 	departmentIdValue, _ := strconv.ParseUint(c.DefaultQuery("department_id", "0"), 10, 32)
 	departmentId = uint(departmentIdValue)
+	teamIdValue, _ := strconv.ParseUint(c.DefaultQuery("team_id", "0"), 10, 32)
+	teamId := uint(teamIdValue)
 	if strings.ToLower(category) == "company" {
+		//TODO company_id가 없으면 활성 회사 게시물 조회
 		if companyId == 0 {
-			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "회사 게시물 조회 시 company_id가 필요합니다.", nil))
-			return
+			companyId = getActiveCompanyId(c)
 		}
 	} else if strings.ToLower(category) == "department" {
-
-		if departmentId == 0 || companyId == 0 {
-			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "부서 게시물 조회 시 department_id와 company_id가 필요합니다.", nil))
+		if departmentId == 0 {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "부서 게시물 조회 시 department_id가 필요합니다.", nil))
 			return
 		}
+		if companyId == 0 {
+			companyId = getActiveCompanyId(c)
+		}
+	} else if strings.ToLower(category) == "team" {
+		if teamId == 0 {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "팀 게시물 조회 시 team_id가 필요합니다.", nil))
+			return
+		}
+		if companyId == 0 {
+			companyId = getActiveCompanyId(c)
+		}
 	} else if strings.ToLower(category) == "public" {
 		if companyId != 0 || departmentId != 0 {
 			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "PUBLIC 게시물은 company_id와 department_id가 없어야 합니다.", nil))
@@ -182,6 +194,9 @@ func (h *PostHandler) GetPosts(c *gin.Context) {
 		Cursor:       cursor,
 		CompanyId:    companyId,
 		DepartmentId: departmentId,
+
+		IncludeSubDepartments: c.DefaultQuery("include_sub_departments", "false") == "true",
+		TeamId:                teamId,
 	}
 
 	// 게시물 조회
@@ -315,7 +330,7 @@ func (h *PostHandler) UpdatePost(c *gin.Context) {
 		}
 	}
 
-	err = h.postUsecase.UpdatePost(userId.(uint), uint(postId), &request)
+	err = h.postUsecase.UpdatePost(userId.(uint), getActiveCompanyId(c), uint(postId), &request)
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
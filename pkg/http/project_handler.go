@@ -51,7 +51,7 @@ func (h *ProjectHandler) CreateProject(c *gin.Context) {
 	}
 
 	// projectUsecase.CreateProject(c)
-	err := h.projectUsecase.CreateProject(userId.(uint), &request)
+	err := h.projectUsecase.CreateProject(userId.(uint), getActiveCompanyId(c), &request)
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -130,7 +130,7 @@ func (h *ProjectHandler) GetProjects(c *gin.Context) {
 		Sort:     sort,
 		Cursor:   cursor,
 	}
-	projects, err := h.projectUsecase.GetProjects(userId.(uint), queryParams)
+	projects, err := h.projectUsecase.GetProjects(userId.(uint), getActiveCompanyId(c), queryParams)
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -159,7 +159,7 @@ func (h *ProjectHandler) GetProject(c *gin.Context) {
 		return
 	}
 
-	project, err := h.projectUsecase.GetProject(userId.(uint), uint(parsedID))
+	project, err := h.projectUsecase.GetProject(userId.(uint), getActiveCompanyId(c), uint(parsedID))
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -231,7 +231,7 @@ func (h *ProjectHandler) GetProjectUsers(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "projectID 파싱 실패", err))
 		return
 	}
-	users, err := h.projectUsecase.GetProjectUsers(userId.(uint), uint(parsedID))
+	users, err := h.projectUsecase.GetProjectUsers(userId.(uint), getActiveCompanyId(c), uint(parsedID))
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -72,6 +72,47 @@ func (h *UserHandler) ValidateEmail(c *gin.Context) {
 	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "이메일 사용 가능", nil))
 }
 
+// TODO 이메일 확인 - 메일로 받은 토큰 확인 후 이메일 도메인 회사 가입 처리
+func (h *UserHandler) VerifyEmail(c *gin.Context) {
+	var request req.VerifyEmailRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다.", err))
+		return
+	}
+
+	response, err := h.userUsecase.VerifyEmail(request.Token)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "이메일 확인 완료", response))
+}
+
+// TODO 이메일 확인 메일 재발송
+func (h *UserHandler) ResendEmailVerification(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 사용자입니다.", nil))
+		return
+	}
+
+	if err := h.userUsecase.ResendEmailVerification(userId.(uint)); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "이메일 확인 메일 전송 완료", nil))
+}
+
 // TODO 닉네임 중복확인
 func (h *UserHandler) ValidateNickname(c *gin.Context) {
 	nickname := c.Query("nickname")
@@ -213,7 +254,7 @@ func (h *UserHandler) SearchUser(c *gin.Context) {
 
 	// 사용자 검색
 	//TODO 검색 조건 나중에 회사사람만 검색가능하도록 해야함
-	users, err := h.userUsecase.SearchUser(requestUserId.(uint), decodedSearchTerm)
+	users, err := h.userUsecase.SearchUser(requestUserId.(uint), getActiveCompanyId(c), decodedSearchTerm)
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -239,7 +280,7 @@ func (h *UserHandler) GetUserByCompany(c *gin.Context) {
 		Order:  req.UserSortOrder(c.Query("order")),
 	}
 
-	response, err := h.userUsecase.GetUsersByCompany(requestUserId.(uint), &queryOptions)
+	response, err := h.userUsecase.GetUsersByCompany(requestUserId.(uint), getActiveCompanyId(c), &queryOptions)
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -254,6 +295,27 @@ func (h *UserHandler) GetUserByCompany(c *gin.Context) {
 	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "회사 사용자 조회 성공", response))
 }
 
+// TODO 내가 소속된 회사 목록 (다중 회사)
+func (h *UserHandler) GetUserCompanies(c *gin.Context) {
+	requestUserId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	response, err := h.userUsecase.GetUserCompanies(requestUserId.(uint), getActiveCompanyId(c))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "소속 회사 목록 조회 성공", response))
+}
+
 // TODO 해당 부서에 속한 사용자 리스트 가져오기 (이후 디테일 잡을때)
 func (h *UserHandler) GetUsersByDepartment(c *gin.Context) {
 	departmentId := c.Param("departmentid")
@@ -34,6 +34,8 @@ func (i *TokenInterceptor) AccessTokenInterceptor() gin.HandlerFunc {
 				// Access Token이 유효한 경우 email과 userId를 Context에 설정
 				c.Set("email", claims.Email)
 				c.Set("userId", claims.UserId)
+				//TODO 활성 회사 (0이면 대표 회사)
+				c.Set("companyId", claims.CompanyId)
 				c.Next() // Access Token이 유효하면 다음 핸들러로 진행
 				return
 			}
@@ -65,6 +67,7 @@ func (i *TokenInterceptor) RefreshTokenInterceptor() gin.HandlerFunc {
 
 		c.Set("email", claims.Email)
 		c.Set("userId", claims.UserId)
+		c.Set("companyId", claims.CompanyId)
 		c.Next()
 	}
 }
@@ -0,0 +1,227 @@
+package middleware
+
+import (
+	"fmt"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"link/pkg/util"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	chatAttachmentMaxFiles = 10
+	chatAttachmentMaxSize  = 20 << 20 // 파일당 20MB
+	chatThumbnailMaxSize   = 320
+)
+
+// 채팅 첨부파일 허용 확장자
+var chatAttachmentExts = map[string]bool{
+	".jpg": true, ".jpeg": true, ".png": true, ".gif": true, ".webp": true,
+	".pdf": true, ".txt": true, ".csv": true, ".zip": true, ".hwp": true,
+	".doc": true, ".docx": true, ".xls": true, ".xlsx": true, ".ppt": true, ".pptx": true,
+}
+
+// ChatRoomMemberChecker는 업로드 전에 채팅방 참여 여부를 확인합니다.
+type ChatRoomMemberChecker interface {
+	IsUserInChatRoom(userId uint, chatRoomId uint) bool
+}
+
+// ChatAttachmentUploadMiddleware는 채팅방 단위로 첨부파일을 저장합니다. (static/chats/{채팅방 ID}/{날짜})
+type ChatAttachmentUploadMiddleware struct {
+	*ImageUploadMiddleware
+	chatRooms ChatRoomMemberChecker
+}
+
+func NewChatAttachmentUploadMiddleware(directory, staticPrefix string, storageQuota StorageQuota, chatRooms ChatRoomMemberChecker) *ChatAttachmentUploadMiddleware {
+	return &ChatAttachmentUploadMiddleware{
+		ImageUploadMiddleware: NewImageUploadMiddleware(directory, staticPrefix, storageQuota),
+		chatRooms:             chatRooms,
+	}
+}
+
+// chatRoomID 요청 사용자가 참여 중인 채팅방 ID - 아니면 응답 후 false
+func (i *ChatAttachmentUploadMiddleware) chatRoomID(c *gin.Context) (uint64, bool) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		c.Abort()
+		return 0, false
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		c.Abort()
+		return 0, false
+	}
+
+	if !i.chatRooms.IsUserInChatRoom(userId.(uint), uint(chatRoomId)) {
+		c.JSON(http.StatusNotFound, common.NewError(http.StatusNotFound, "해당 채팅방에 사용자가 없습니다", nil))
+		c.Abort()
+		return 0, false
+	}
+
+	return chatRoomId, true
+}
+
+// TODO 채팅 첨부파일 업로드 - 채팅방 참여자만, 이미지는 썸네일 생성
+func (i *ChatAttachmentUploadMiddleware) ChatAttachmentUpload() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		chatRoomId, ok := i.chatRoomID(c)
+		if !ok {
+			return
+		}
+
+		files, err := c.MultipartForm()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "첨부파일이 없습니다", err))
+			c.Abort()
+			return
+		}
+
+		formFiles := files.File["files"]
+		if len(formFiles) == 0 {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "첨부파일이 없습니다", nil))
+			c.Abort()
+			return
+		}
+		if len(formFiles) > chatAttachmentMaxFiles {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, fmt.Sprintf("첨부파일은 최대 %d개까지 보낼 수 있습니다", chatAttachmentMaxFiles), nil))
+			c.Abort()
+			return
+		}
+
+		for _, file := range formFiles {
+			if !chatAttachmentExts[strings.ToLower(filepath.Ext(file.Filename))] {
+				fmt.Printf("허용되지 않는 파일 형식입니다: %s", file.Filename)
+				c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "허용되지 않는 파일 형식입니다", nil))
+				c.Abort()
+				return
+			}
+			if file.Size > chatAttachmentMaxSize {
+				c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "첨부파일은 20MB까지 보낼 수 있습니다", nil))
+				c.Abort()
+				return
+			}
+		}
+
+		if !i.checkStorageQuota(c, totalFileSize(formFiles)) {
+			return
+		}
+
+		now := time.Now().Format("2006-01-02")
+		roomFolder := fmt.Sprintf("%d/%s", chatRoomId, now)
+		folderPath := filepath.Join(i.directory, filepath.FromSlash(roomFolder))
+		if err := os.MkdirAll(folderPath, os.ModePerm); err != nil {
+			fmt.Printf("폴더 생성 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "폴더 생성 실패", err))
+			c.Abort()
+			return
+		}
+
+		attachments := make([]*req.ChatAttachmentUpload, 0, len(formFiles))
+		for _, file := range formFiles {
+			ext := strings.ToLower(filepath.Ext(file.Filename))
+			uniqueFileName := uuid.New().String()
+			fileName := uniqueFileName + ext
+			filePath := filepath.Join(folderPath, fileName)
+
+			if err := c.SaveUploadedFile(file, filePath); err != nil {
+				fmt.Printf("파일 저장 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "파일 저장 실패", err))
+				c.Abort()
+				return
+			}
+
+			mimeType := file.Header.Get("Content-Type")
+			if mimeType == "" || mimeType == "application/octet-stream" {
+				if byExt := mime.TypeByExtension(ext); byExt != "" {
+					mimeType = byExt
+				}
+			}
+
+			attachment := &req.ChatAttachmentUpload{
+				Name:     filepath.Base(file.Filename),
+				Size:     file.Size,
+				MimeType: mimeType,
+				URL:      fmt.Sprintf("%s/%s/%s", i.staticPrefix, roomFolder, fileName),
+			}
+
+			//TODO 썸네일은 jpg, png, gif만 생성 - 실패해도 원본으로 표시
+			if ext == ".jpg" || ext == ".jpeg" || ext == ".png" || ext == ".gif" {
+				thumbName := uniqueFileName + "_thumb.jpg"
+				if err := util.CreateThumbnail(filePath, filepath.Join(folderPath, thumbName), chatThumbnailMaxSize); err != nil {
+					fmt.Printf("썸네일 생성 실패: %v", err)
+				} else {
+					attachment.ThumbnailURL = fmt.Sprintf("%s/%s/%s", i.staticPrefix, roomFolder, thumbName)
+				}
+			}
+
+			attachments = append(attachments, attachment)
+		}
+
+		i.addStorageUsage(c, totalFileSize(formFiles))
+
+		c.Set("chat_attachments", attachments)
+		c.Next()
+	}
+}
+
+// TODO 그룹 채팅방 대표 이미지 업로드 - 이미지가 없으면 다음 핸들러로 (static/chats/{채팅방 ID}/room)
+func (i *ChatAttachmentUploadMiddleware) ChatRoomImageUpload() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		chatRoomId, ok := i.chatRoomID(c)
+		if !ok {
+			return
+		}
+
+		file, err := c.FormFile("file")
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		ext := strings.ToLower(filepath.Ext(file.Filename))
+		if ext != ".jpg" && ext != ".jpeg" && ext != ".png" && ext != ".webp" {
+			fmt.Printf("허용되지 않는 파일 형식입니다: %s", ext)
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "허용되지 않는 파일 형식입니다", nil))
+			c.Abort()
+			return
+		}
+
+		if !i.checkStorageQuota(c, file.Size) {
+			return
+		}
+
+		roomFolder := fmt.Sprintf("%d/room", chatRoomId)
+		folderPath := filepath.Join(i.directory, filepath.FromSlash(roomFolder))
+		if err := os.MkdirAll(folderPath, os.ModePerm); err != nil {
+			fmt.Printf("폴더 생성 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "폴더 생성 실패", err))
+			c.Abort()
+			return
+		}
+
+		fileName := uuid.New().String() + ext
+		if err := c.SaveUploadedFile(file, filepath.Join(folderPath, fileName)); err != nil {
+			fmt.Printf("파일 저장 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "파일 저장 실패", err))
+			c.Abort()
+			return
+		}
+
+		i.addStorageUsage(c, file.Size)
+
+		c.Set("chat_room_image_url", fmt.Sprintf("%s/%s/%s", i.staticPrefix, roomFolder, fileName))
+		c.Next()
+	}
+}
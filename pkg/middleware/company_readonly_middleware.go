@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"link/pkg/common"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CompanyWriteGuard는 활성 회사와 요청에서 지정한 회사에 쓰기 요청을 보낼 수 있는지 확인합니다. (삭제 예정 회사는 읽기 전용)
+type CompanyWriteGuard interface {
+	CheckCompanyWritable(userId uint, companyId uint) error
+	CheckTargetCompanyWritable(userId uint, companyId uint) error
+}
+
+// CompanyReadOnlyMiddleware는 활성 회사나 요청 대상 회사(경로 :companyid, company_id 쿼리, JSON 본문)가 읽기 전용이면 조회 외 요청을 막습니다.
+// skipPrefixes로 시작하는 경로(로그아웃, 회사 전환, 운영자 기능 등)는 확인하지 않습니다.
+func CompanyReadOnlyMiddleware(guard CompanyWriteGuard, skipPrefixes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+
+		for _, prefix := range skipPrefixes {
+			if strings.HasPrefix(c.Request.URL.Path, prefix) {
+				c.Next()
+				return
+			}
+		}
+
+		userId, exists := c.Get("userId")
+		if !exists {
+			c.Next()
+			return
+		}
+		var activeCompanyId uint
+		if companyId, exists := c.Get("companyId"); exists {
+			activeCompanyId, _ = companyId.(uint)
+		}
+
+		err := guard.CheckCompanyWritable(userId.(uint), activeCompanyId)
+		if err == nil {
+			if targetCompanyId := requestCompanyId(c); targetCompanyId != 0 && targetCompanyId != activeCompanyId {
+				err = guard.CheckTargetCompanyWritable(userId.(uint), targetCompanyId)
+			}
+		}
+		if err != nil {
+			if appError, ok := err.(*common.AppError); ok {
+				c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+			} else {
+				c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+			}
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// requestCompanyId 경로 :companyid, company_id 쿼리, JSON 본문 company_id 순으로 요청 대상 회사 ID 조회 (없으면 0)
+// 본문은 읽은 뒤 핸들러에서 다시 바인딩할 수 있도록 되돌려 놓음
+func requestCompanyId(c *gin.Context) uint {
+	for _, value := range []string{c.Param("companyid"), c.Query("company_id")} {
+		if companyId, err := strconv.ParseUint(value, 10, 64); err == nil && companyId != 0 {
+			return uint(companyId)
+		}
+	}
+
+	if c.Request.Body == nil || !strings.HasPrefix(c.ContentType(), "application/json") {
+		return 0
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return 0
+	}
+
+	var payload struct {
+		CompanyID uint `json:"company_id"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return 0
+	}
+	return payload.CompanyID
+}
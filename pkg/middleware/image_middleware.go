@@ -3,6 +3,7 @@ package middleware
 import (
 	"fmt"
 	"link/pkg/common"
+	"mime/multipart"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -16,16 +17,72 @@ import (
 type ImageUploadMiddleware struct {
 	directory    string
 	staticPrefix string // URL 경로의 Prefix
+	storageQuota StorageQuota
+}
+
+// StorageQuota는 활성 회사 요금제의 저장 용량을 확인하고 사용량을 반영합니다.
+type StorageQuota interface {
+	CheckStorageQuota(userId uint, activeCompanyId uint, bytes int64) error
+	AddStorageUsage(userId uint, activeCompanyId uint, bytes int64) error
 }
 
 // NewImageUploadMiddleware는 ImageUploadMiddleware를 생성하는 함수입니다.
-func NewImageUploadMiddleware(directory, staticPrefix string) *ImageUploadMiddleware {
+func NewImageUploadMiddleware(directory, staticPrefix string, storageQuota StorageQuota) *ImageUploadMiddleware {
 	return &ImageUploadMiddleware{
 		directory:    directory,
 		staticPrefix: staticPrefix,
+		storageQuota: storageQuota,
 	}
 }
 
+// checkStorageQuota 업로드 전에 용량 초과 여부 확인 - 초과하면 응답 후 false
+func (i *ImageUploadMiddleware) checkStorageQuota(c *gin.Context, bytes int64) bool {
+	userId, exists := c.Get("userId")
+	if !exists || i.storageQuota == nil {
+		return true
+	}
+
+	if err := i.storageQuota.CheckStorageQuota(userId.(uint), activeCompanyId(c), bytes); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		c.Abort()
+		return false
+	}
+	return true
+}
+
+// addStorageUsage 저장이 끝난 파일 용량 반영 - 실패해도 업로드는 진행
+func (i *ImageUploadMiddleware) addStorageUsage(c *gin.Context, bytes int64) {
+	userId, exists := c.Get("userId")
+	if !exists || i.storageQuota == nil {
+		return
+	}
+
+	if err := i.storageQuota.AddStorageUsage(userId.(uint), activeCompanyId(c), bytes); err != nil {
+		fmt.Printf("업로드 용량 반영 실패: %v", err)
+	}
+}
+
+// activeCompanyId 토큰의 활성 회사 (0이면 대표 회사)
+func activeCompanyId(c *gin.Context) uint {
+	var activeCompanyId uint
+	if companyId, exists := c.Get("companyId"); exists {
+		activeCompanyId, _ = companyId.(uint)
+	}
+	return activeCompanyId
+}
+
+func totalFileSize(files []*multipart.FileHeader) int64 {
+	var total int64
+	for _, file := range files {
+		total += file.Size
+	}
+	return total
+}
+
 func (i *ImageUploadMiddleware) ProfileImageUploadMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		file, err := c.FormFile("file")
@@ -42,6 +99,10 @@ func (i *ImageUploadMiddleware) ProfileImageUploadMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		if !i.checkStorageQuota(c, file.Size) {
+			return
+		}
+
 		// 현재 날짜를 기반으로 폴더 경로 생성
 		now := time.Now().Format("2006-01-02") // 날짜 포맷 수정
 		folderPath := filepath.Join(i.directory, now)
@@ -69,6 +130,8 @@ func (i *ImageUploadMiddleware) ProfileImageUploadMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		i.addStorageUsage(c, file.Size)
+
 		// 이미지 URL 설정
 		imageUrl := fmt.Sprintf("%s/%s/%s", i.staticPrefix, now, fileName)
 		c.Set("profile_image_url", imageUrl)
@@ -91,6 +154,10 @@ func (i *ImageUploadMiddleware) PostImageUploadMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		if !i.checkStorageQuota(c, totalFileSize(formFiles)) {
+			return
+		}
+
 		imageUrls := make([]string, 0)
 
 		for _, file := range formFiles {
@@ -119,6 +186,8 @@ func (i *ImageUploadMiddleware) PostImageUploadMiddleware() gin.HandlerFunc {
 			imageUrls = append(imageUrls, fmt.Sprintf("%s/%s/%s", i.staticPrefix, now, fileName))
 		}
 
+		i.addStorageUsage(c, totalFileSize(formFiles))
+
 		//TODO next로 넘길때 배열 형태로 넘겨주기
 		c.Set("post_image_urls", imageUrls)
 		c.Next()
@@ -141,6 +210,10 @@ func (i *ImageUploadMiddleware) CompanyImageUploadMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		if !i.checkStorageQuota(c, file.Size) {
+			return
+		}
+
 		now := time.Now().Format("2006-01-02")
 		folderPath := filepath.Join(i.directory, now)
 
@@ -155,8 +228,72 @@ func (i *ImageUploadMiddleware) CompanyImageUploadMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		i.addStorageUsage(c, file.Size)
+
 		imageUrl := fmt.Sprintf("%s/%s/%s", i.staticPrefix, now, fileName)
 		c.Set("company_image_url", imageUrl)
 		c.Next()
 	}
 }
+
+// TODO 회사 인증 서류 업로드 미들웨어 - 이미지 외에 pdf 허용, static 경로로 공개하지 않음
+func (i *ImageUploadMiddleware) CompanyDocumentUploadMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		files, err := c.MultipartForm()
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		formFiles := files.File["files"]
+		if len(formFiles) == 0 {
+			c.Next()
+			return
+		}
+
+		if !i.checkStorageQuota(c, totalFileSize(formFiles)) {
+			return
+		}
+
+		documentUrls := make([]string, 0, len(formFiles))
+		documentNames := make([]string, 0, len(formFiles))
+
+		for _, file := range formFiles {
+			ext := strings.ToLower(filepath.Ext(file.Filename))
+			if ext != ".pdf" && ext != ".jpg" && ext != ".jpeg" && ext != ".png" {
+				fmt.Printf("허용되지 않는 파일 형식입니다: %s", ext)
+				c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "허용되지 않는 파일 형식입니다", nil))
+				c.Abort()
+				return
+			}
+
+			now := time.Now().Format("2006-01-02")
+			folderPath := filepath.Join(i.directory, now)
+			if err := os.MkdirAll(folderPath, os.ModePerm); err != nil {
+				fmt.Printf("폴더 생성 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "폴더 생성 실패", err))
+				c.Abort()
+				return
+			}
+
+			fileName := uuid.New().String() + ext
+			filePath := filepath.Join(folderPath, fileName)
+
+			if err := c.SaveUploadedFile(file, filePath); err != nil {
+				fmt.Printf("파일 저장 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "파일 저장 실패", err))
+				c.Abort()
+				return
+			}
+
+			documentUrls = append(documentUrls, fmt.Sprintf("%s/%s/%s", i.staticPrefix, now, fileName))
+			documentNames = append(documentNames, filepath.Base(file.Filename))
+		}
+
+		i.addStorageUsage(c, totalFileSize(formFiles))
+
+		c.Set("company_document_urls", documentUrls)
+		c.Set("company_document_names", documentNames)
+		c.Next()
+	}
+}
@@ -0,0 +1,56 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"link/pkg/logger"
+	"link/pkg/util"
+)
+
+// RunDailyAt 매일 KST 기준 hour:minute에 job 실행 (블로킹 - 고루틴으로 실행)
+func RunDailyAt(hour int, minute int, name string, job func() error) {
+	for {
+		now := util.ParseKst(time.Now())
+		next := time.Date(now.Year(), now.Month(), now.Day(), hour, minute, 0, 0, now.Location())
+		if !next.After(now) {
+			next = next.AddDate(0, 0, 1)
+		}
+
+		time.Sleep(time.Until(next))
+
+		run(name, job)
+	}
+}
+
+// RunEvery interval마다 job 실행 (블로킹 - 고루틴으로 실행), 자주 돌기 때문에 실패할 때만 로그
+func RunEvery(interval time.Duration, name string, job func() error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		execute(name, job)
+	}
+}
+
+func run(name string, job func() error) {
+	start := time.Now()
+	if execute(name, job) {
+		logger.LogSuccess(fmt.Sprintf("[스케줄러: %s] 실행 완료 (%s)", name, time.Since(start)))
+	}
+}
+
+// execute job 실행 - panic, 에러는 로그만 남기고 false
+func execute(name string, job func() error) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.LogError(fmt.Sprintf("[스케줄러: %s] panic 발생: %v", name, r))
+		}
+	}()
+
+	if err := job(); err != nil {
+		logger.LogError(fmt.Sprintf("[스케줄러: %s] 실행 실패: %v", name, err))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,36 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+var businessNumberWeights = []int{1, 3, 7, 1, 3, 7, 1, 3, 5}
+
+// NormalizeBusinessNumber 사업자등록번호 검증 후 000-00-00000 형식으로 반환
+func NormalizeBusinessNumber(cpNumber string) (string, error) {
+	digits := strings.NewReplacer("-", "", " ", "").Replace(cpNumber)
+	if len(digits) != 10 {
+		return "", fmt.Errorf("사업자등록번호는 10자리여야 합니다")
+	}
+
+	numbers := make([]int, 10)
+	for i, r := range digits {
+		if r < '0' || r > '9' {
+			return "", fmt.Errorf("사업자등록번호는 숫자만 입력해야 합니다")
+		}
+		numbers[i] = int(r - '0')
+	}
+
+	// 국세청 사업자등록번호 검증 공식
+	sum := 0
+	for i, weight := range businessNumberWeights {
+		sum += numbers[i] * weight
+	}
+	sum += numbers[8] * 5 / 10
+	if (10-sum%10)%10 != numbers[9] {
+		return "", fmt.Errorf("유효하지 않은 사업자등록번호입니다")
+	}
+
+	return fmt.Sprintf("%s-%s-%s", digits[:3], digits[3:5], digits[5:]), nil
+}
@@ -17,27 +17,29 @@ var refreshTokenSecret = []byte(os.Getenv("REFRESH_TOKEN_SECRET"))
 
 // Claims 구조체 - 사용자 정보를 토큰에 담음
 type Claims struct {
-	Name   string `json:"name"`
-	Email  string `json:"email"`
-	UserId uint   `json:"userId"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	UserId    uint   `json:"userId"`
+	CompanyId uint   `json:"companyId,omitempty"` // 현재 세션에서 선택한 회사
 	jwt.RegisteredClaims
 }
 
-func GenerateAccessToken(name string, email string, userId uint) (string, error) {
-	return generateToken(name, email, userId, accessTokenExp, accessTokenSecret)
+func GenerateAccessToken(name string, email string, userId uint, companyId uint) (string, error) {
+	return generateToken(name, email, userId, companyId, accessTokenExp, accessTokenSecret)
 }
 
-func GenerateRefreshToken(name string, email string, userId uint) (string, error) {
-	return generateToken(name, email, userId, refreshTokenExp, refreshTokenSecret)
+func GenerateRefreshToken(name string, email string, userId uint, companyId uint) (string, error) {
+	return generateToken(name, email, userId, companyId, refreshTokenExp, refreshTokenSecret)
 }
 
-func generateToken(name string, email string, userId uint, expiration time.Duration, secret []byte) (string, error) {
+func generateToken(name string, email string, userId uint, companyId uint, expiration time.Duration, secret []byte) (string, error) {
 	expirationTime := time.Now().Add(expiration) // 토큰 생성 시 유효 기간을 계산
 
 	claims := &Claims{
-		Name:   name,
-		Email:  email,
-		UserId: userId,
+		Name:      name,
+		Email:     email,
+		UserId:    userId,
+		CompanyId: companyId,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime), // JWT 표준 형식으로 변환
 		},
@@ -0,0 +1,25 @@
+package util
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+)
+
+// GenerateInviteCode URL에 그대로 쓸 수 있는 추측 불가능한 초대 코드 생성
+func GenerateInviteCode() (string, error) {
+	return randomURLToken(12)
+}
+
+// GenerateVerificationToken 도메인 소유 확인 등 외부에 등록할 인증 토큰 생성
+func GenerateVerificationToken() (string, error) {
+	return randomURLToken(24)
+}
+
+func randomURLToken(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("랜덤 토큰 생성 실패: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
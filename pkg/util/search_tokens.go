@@ -0,0 +1,83 @@
+package util
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SearchTokens 메시지 저장 시 검색용 토큰 생성
+// 한글은 띄어쓰기 없이 붙여 쓰는 경우가 많아 글자 단위(1글자)와 2글자 묶음(bigram)으로, 그 외 영문, 숫자는 단어 단위로 나눔
+func SearchTokens(text string) []string {
+	tokens := make([]string, 0)
+	seen := make(map[string]struct{})
+	add := func(token string) {
+		if _, ok := seen[token]; ok {
+			return
+		}
+		seen[token] = struct{}{}
+		tokens = append(tokens, token)
+	}
+
+	for _, run := range searchRuns(text) {
+		if !run.hangul {
+			add(string(run.runes))
+			continue
+		}
+		for i := range run.runes {
+			add(string(run.runes[i]))
+			if i+1 < len(run.runes) {
+				add(string(run.runes[i : i+2]))
+			}
+		}
+	}
+	return tokens
+}
+
+// SearchQueryTokens 검색어를 SearchTokens와 같은 규칙으로 나눔 - 모든 토큰을 포함한 메시지가 검색 결과
+// 한글은 2글자 이상이면 bigram만, 1글자면 글자 그대로 사용
+func SearchQueryTokens(query string) []string {
+	tokens := make([]string, 0)
+	seen := make(map[string]struct{})
+	add := func(token string) {
+		if _, ok := seen[token]; ok {
+			return
+		}
+		seen[token] = struct{}{}
+		tokens = append(tokens, token)
+	}
+
+	for _, run := range searchRuns(query) {
+		if !run.hangul || len(run.runes) == 1 {
+			add(string(run.runes))
+			continue
+		}
+		for i := 0; i+1 < len(run.runes); i++ {
+			add(string(run.runes[i : i+2]))
+		}
+	}
+	return tokens
+}
+
+type searchRun struct {
+	runes  []rune
+	hangul bool
+}
+
+// searchRuns 공백, 문장부호를 기준으로 나누고 한글과 그 외 글자가 붙어 있으면 따로 나눔 (소문자로 변환)
+func searchRuns(text string) []searchRun {
+	runs := make([]searchRun, 0)
+	var current *searchRun
+	for _, r := range strings.ToLower(text) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			current = nil
+			continue
+		}
+		hangul := unicode.Is(unicode.Hangul, r)
+		if current == nil || current.hangul != hangul {
+			runs = append(runs, searchRun{hangul: hangul})
+			current = &runs[len(runs)-1]
+		}
+		current.runes = append(current.runes, r)
+	}
+	return runs
+}
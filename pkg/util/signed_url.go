@@ -0,0 +1,94 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const mediaUrlExp = time.Minute * 10
+
+// mediaUrlSecret .env 로드 이후 값을 읽도록 호출 시점에 조회
+func mediaUrlSecret() []byte {
+	return []byte(os.Getenv("MEDIA_URL_SECRET"))
+}
+
+// CheckMediaURLSecret 서명 키가 비어 있으면 누구나 서명을 만들 수 있으므로 서버 시작 시 확인
+func CheckMediaURLSecret() error {
+	if len(mediaUrlSecret()) == 0 {
+		return fmt.Errorf("MEDIA_URL_SECRET이 설정되지 않았습니다")
+	}
+	return nil
+}
+
+// SignMediaURL 정적 미디어 경로에 만료 시간과 HMAC 서명을 붙인 URL 반환 (서명 키가 없으면 서명하지 않음)
+func SignMediaURL(path string) (string, time.Time) {
+	expiresAt := time.Now().Add(mediaUrlExp)
+	if path == "" || len(mediaUrlSecret()) == 0 {
+		return path, expiresAt
+	}
+
+	expires := expiresAt.Unix()
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", mediaSignature(path, expires))
+
+	return fmt.Sprintf("%s?%s", path, query.Encode()), expiresAt
+}
+
+// ValidateMediaSignature 서명 URL 검증 후 만료 시각 반환
+func ValidateMediaSignature(path string, expiresParam string, signature string) (time.Time, error) {
+	if err := CheckMediaURLSecret(); err != nil {
+		return time.Time{}, err
+	}
+
+	expires, err := strconv.ParseInt(expiresParam, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("유효하지 않은 만료 시간입니다")
+	}
+
+	expiresAt := time.Unix(expires, 0)
+	if time.Now().After(expiresAt) {
+		return time.Time{}, fmt.Errorf("만료된 URL입니다")
+	}
+
+	expected := mediaSignature(path, expires)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return time.Time{}, fmt.Errorf("유효하지 않은 서명입니다")
+	}
+
+	return expiresAt, nil
+}
+
+func mediaSignature(path string, expires int64) string {
+	mac := hmac.New(sha256.New, mediaUrlSecret())
+	mac.Write([]byte(fmt.Sprintf("%s:%d", path, expires)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// 프로필 이미지 경로 - 업로드 미들웨어의 staticPrefix와 같음
+const profileMediaPrefix = "/static/profiles/"
+
+// SignProfileImageURL 프로필 이미지 경로면 서명 URL 반환, 외부 URL이나 빈 값은 그대로
+func SignProfileImageURL(image string) string {
+	if !strings.HasPrefix(image, profileMediaPrefix) || strings.Contains(image, "?") {
+		return image
+	}
+	signedUrl, _ := SignMediaURL(image)
+	return signedUrl
+}
+
+// SignProfileImageURLPtr 포인터 필드용 SignProfileImageURL
+func SignProfileImageURLPtr(image *string) *string {
+	if image == nil {
+		return nil
+	}
+	signedUrl := SignProfileImageURL(*image)
+	return &signedUrl
+}
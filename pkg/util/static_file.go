@@ -0,0 +1,39 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// StaticFilePath /static/... URL을 서버 디스크 경로로 변환 - static 폴더 밖은 거부
+func StaticFilePath(fileURL string) (string, bool) {
+	if !strings.HasPrefix(fileURL, "/static/") {
+		return "", false
+	}
+	path := filepath.Clean("." + fileURL)
+	if !strings.HasPrefix(path, "static"+string(filepath.Separator)) {
+		return "", false
+	}
+	return path, true
+}
+
+// RemoveStaticFile 저장된 파일을 지우고 줄어든 용량 반환 - 이미 없는 파일은 0
+func RemoveStaticFile(fileURL string) (int64, error) {
+	path, ok := StaticFilePath(fileURL)
+	if !ok {
+		return 0, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return 0, err
+	}
+	return info.Size(), nil
+}
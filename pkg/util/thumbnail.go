@@ -0,0 +1,58 @@
+package util
+
+import (
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"os"
+)
+
+// CreateThumbnail jpeg, png, gif 이미지를 긴 변이 maxSize가 되도록 줄여 jpeg로 저장
+func CreateThumbnail(srcPath string, dstPath string, maxSize int) error {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return fmt.Errorf("원본 이미지 열기 실패: %w", err)
+	}
+	defer src.Close()
+
+	img, _, err := image.Decode(src)
+	if err != nil {
+		return fmt.Errorf("이미지 디코딩 실패: %w", err)
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return fmt.Errorf("이미지 크기가 올바르지 않습니다")
+	}
+
+	thumbWidth, thumbHeight := width, height
+	if width > maxSize || height > maxSize {
+		if width >= height {
+			thumbWidth, thumbHeight = maxSize, max(1, height*maxSize/width)
+		} else {
+			thumbWidth, thumbHeight = max(1, width*maxSize/height), maxSize
+		}
+	}
+
+	// 최근접 이웃 방식으로 축소
+	thumb := image.NewRGBA(image.Rect(0, 0, thumbWidth, thumbHeight))
+	for y := 0; y < thumbHeight; y++ {
+		for x := 0; x < thumbWidth; x++ {
+			thumb.Set(x, y, img.At(bounds.Min.X+x*width/thumbWidth, bounds.Min.Y+y*height/thumbHeight))
+		}
+	}
+
+	dst, err := os.Create(dstPath)
+	if err != nil {
+		return fmt.Errorf("썸네일 파일 생성 실패: %w", err)
+	}
+	defer dst.Close()
+
+	if err := jpeg.Encode(dst, thumb, &jpeg.Options{Quality: 80}); err != nil {
+		return fmt.Errorf("썸네일 인코딩 실패: %w", err)
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	_companyUsecase "link/internal/company/usecase"
 	_notificationUsecase "link/internal/notification/usecase"
 	_userUsecase "link/internal/user/usecase"
+	"link/pkg/common"
 	"link/pkg/dto/req"
 	"link/pkg/dto/res"
 	"link/pkg/logger"
@@ -84,6 +85,153 @@ func (h *WsHandler) subscribeToChat() {
 		})
 	})
 
+	// 채팅 메시지 수정
+	h.natsSubscriber.SubscribeEvent("chat.message.edited", func(msg *nats.Msg) {
+		var message map[string]interface{}
+		if err := json.Unmarshal(msg.Data, &message); err != nil {
+			log.Printf("메시지 파싱 오류: %v", err)
+			return
+		}
+		roomId, ok := message["roomId"].(float64)
+		if !ok {
+			log.Printf("채팅방 ID 추출 실패: %v", message)
+			return
+		}
+		h.hub.SendMessageToChatRoom(uint(roomId), res.JsonResponse{
+			Success: true,
+			Type:    "chat.message.edited",
+			Message: "채팅 메시지 수정 이벤트 수신",
+			Payload: message["message"],
+		})
+	})
+
+	// 스레드 답글
+	h.natsSubscriber.SubscribeEvent("chat.thread.reply", func(msg *nats.Msg) {
+		var message map[string]interface{}
+		if err := json.Unmarshal(msg.Data, &message); err != nil {
+			log.Printf("메시지 파싱 오류: %v", err)
+			return
+		}
+		roomId, ok := message["roomId"].(float64)
+		if !ok {
+			log.Printf("채팅방 ID 추출 실패: %v", message)
+			return
+		}
+		h.hub.SendMessageToChatRoom(uint(roomId), res.JsonResponse{
+			Success: true,
+			Type:    "chat.thread.reply",
+			Message: "스레드 답글 이벤트 수신",
+			Payload: message["thread"],
+		})
+	})
+
+	// 채팅 메시지 이모지 반응
+	h.natsSubscriber.SubscribeEvent("chat.message.reaction", func(msg *nats.Msg) {
+		var message map[string]interface{}
+		if err := json.Unmarshal(msg.Data, &message); err != nil {
+			log.Printf("메시지 파싱 오류: %v", err)
+			return
+		}
+		roomId, ok := message["roomId"].(float64)
+		if !ok {
+			log.Printf("채팅방 ID 추출 실패: %v", message)
+			return
+		}
+		h.hub.SendMessageToChatRoom(uint(roomId), res.JsonResponse{
+			Success: true,
+			Type:    "chat.message.reaction",
+			Message: "채팅 메시지 반응 이벤트 수신",
+			Payload: message["reaction"],
+		})
+	})
+
+	// 채팅방 읽음 처리
+	h.natsSubscriber.SubscribeEvent("chat.room.read", func(msg *nats.Msg) {
+		var message map[string]interface{}
+		if err := json.Unmarshal(msg.Data, &message); err != nil {
+			log.Printf("메시지 파싱 오류: %v", err)
+			return
+		}
+		roomId, ok := message["roomId"].(float64)
+		if !ok {
+			log.Printf("채팅방 ID 추출 실패: %v", message)
+			return
+		}
+		h.hub.SendMessageToChatRoom(uint(roomId), res.JsonResponse{
+			Success: true,
+			Type:    "chat.read",
+			Message: "채팅방 읽음 이벤트 수신",
+			Payload: message["read"],
+		})
+	})
+
+	// 시스템 메시지 (멤버 추가/내보내기, 채팅방 이름 변경 등)
+	h.natsSubscriber.SubscribeEvent("chat.room.system", func(msg *nats.Msg) {
+		var message map[string]interface{}
+		if err := json.Unmarshal(msg.Data, &message); err != nil {
+			log.Printf("메시지 파싱 오류: %v", err)
+			return
+		}
+		roomId, ok := message["roomId"].(float64)
+		if !ok {
+			log.Printf("채팅방 ID 추출 실패: %v", message)
+			return
+		}
+		h.hub.SendMessageToChatRoom(uint(roomId), res.JsonResponse{
+			Success: true,
+			Type:    "chat",
+			Message: "시스템 메시지 수신",
+			Payload: message["message"],
+		})
+		// 내보낸 사용자는 메시지를 받은 뒤 채팅방에서 제외
+		if removedUserId, ok := message["removedUserId"].(float64); ok {
+			h.hub.RemoveFromChatRoom(uint(roomId), uint(removedUserId))
+		}
+	})
+
+	// 채팅방 정보 변경 (이름, 이미지, 멤버, 권한)
+	h.natsSubscriber.SubscribeEvent("chat.room.updated", func(msg *nats.Msg) {
+		var message map[string]interface{}
+		if err := json.Unmarshal(msg.Data, &message); err != nil {
+			log.Printf("메시지 파싱 오류: %v", err)
+			return
+		}
+		roomId, ok := message["roomId"].(float64)
+		if !ok {
+			log.Printf("채팅방 ID 추출 실패: %v", message)
+			return
+		}
+		h.hub.SendMessageToChatRoom(uint(roomId), res.JsonResponse{
+			Success: true,
+			Type:    "chat.room.updated",
+			Message: "채팅방 정보 변경 이벤트 수신",
+			Payload: message["room"],
+		})
+	})
+
+	// 메시지 고정 / 고정 해제
+	for _, subject := range []string{"chat.message.pinned", "chat.message.unpinned"} {
+		eventType := subject
+		h.natsSubscriber.SubscribeEvent(eventType, func(msg *nats.Msg) {
+			var message map[string]interface{}
+			if err := json.Unmarshal(msg.Data, &message); err != nil {
+				log.Printf("메시지 파싱 오류: %v", err)
+				return
+			}
+			roomId, ok := message["roomId"].(float64)
+			if !ok {
+				log.Printf("채팅방 ID 추출 실패: %v", message)
+				return
+			}
+			h.hub.SendMessageToChatRoom(uint(roomId), res.JsonResponse{
+				Success: true,
+				Type:    eventType,
+				Message: "메시지 고정 이벤트 수신",
+				Payload: message["pin"],
+			})
+		})
+	}
+
 	// 채팅방 나가기
 	h.natsSubscriber.SubscribeEvent("chat.room.leave", func(msg *nats.Msg) {
 		var message map[string]interface{}
@@ -222,7 +370,7 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 	claims, err := util.ValidateAccessToken(token)
 	if err != nil {
 		log.Printf("토큰 검증 실패: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.WriteJSON(conn, res.JsonResponse{
 			Success: false,
 			Message: "유효하지 않은 토큰입니다.",
 			Type:    "error",
@@ -234,7 +382,7 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 	roomIdUint, err := strconv.ParseUint(roomId, 10, 64)
 	if err != nil {
 		log.Printf("room_id 변환 실패: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.WriteJSON(conn, res.JsonResponse{
 			Success: false,
 			Message: "room_id 형식이 올바르지 않습니다",
 			Type:    "error",
@@ -245,7 +393,7 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 	userIdUint, err := strconv.ParseUint(senderId, 10, 64)
 	if err != nil {
 		log.Printf("sender_id 변환 실패: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.WriteJSON(conn, res.JsonResponse{
 			Success: false,
 			Message: "sender_id 형식이 올바르지 않습니다",
 			Type:    "error",
@@ -253,11 +401,23 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 		return
 	}
 
+	//TODO sender_id는 토큰의 사용자와 같아야 함 - 이후 사용자 식별은 토큰 기준
+	if uint(userIdUint) != claims.UserId {
+		log.Printf("sender_id 불일치: sender_id %d, 토큰 사용자 %d", userIdUint, claims.UserId)
+		h.hub.WriteJSON(conn, res.JsonResponse{
+			Success: false,
+			Message: "sender_id가 토큰의 사용자와 일치하지 않습니다",
+			Type:    "error",
+		})
+		h.hub.CloseConnection(conn)
+		return
+	}
+
 	// 연결 종료 시 클라이언트와 채팅방에서 제거
 	defer func() {
 		h.hub.RemoveFromChatRoom(uint(roomIdUint), uint(userIdUint))
 		h.hub.UnregisterClient(conn, uint(userIdUint), uint(roomIdUint))
-		conn.Close()
+		h.hub.CloseConnection(conn)
 	}()
 
 	// 메모리에서 채팅방 확인, 없으면 DB에서 가져오기
@@ -271,7 +431,7 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 			chatRoomResponse, err := h.chatUsecase.GetChatRoomById(uint(roomIdUint))
 			if err != nil || chatRoomResponse == nil {
 				log.Printf("DB 채팅방 조회 실패: %v", err)
-				conn.WriteJSON(res.JsonResponse{
+				h.hub.WriteJSON(conn, res.JsonResponse{
 					Success: false,
 					Message: "채팅방이 없습니다",
 					Type:    "error",
@@ -313,12 +473,24 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 	h.hub.RegisterClient(conn, uint(userIdUint), uint(roomIdUint))
 
 	// 연결 성공 메시지 전송
-	conn.WriteJSON(res.JsonResponse{
+	h.hub.WriteJSON(conn, res.JsonResponse{
 		Success: true,
 		Message: "연결 성공",
 		Type:    "connection",
 	})
 
+	//TODO 채팅방 접속자 목록 전송 및 입장 알림 - 참여자만, 사용자는 토큰 기준
+	if h.chatUsecase.IsChatRoomMember(claims.UserId, uint(roomIdUint)) {
+		h.hub.JoinChatRoomViewer(uint(roomIdUint), claims.UserId, claims.Name, conn)
+	}
+
+	//TODO 재연결 - lastSeq가 있으면 이후 메시지 재전송
+	if lastSeq := c.Query("lastSeq"); lastSeq != "" {
+		if lastSeqInt, err := strconv.ParseInt(lastSeq, 10, 64); err == nil {
+			h.replayChatMessages(conn, uint(roomIdUint), claims.UserId, lastSeqInt)
+		}
+	}
+
 	// 채팅 메시지 처리 루프
 	for {
 		// 메시지 수신
@@ -328,7 +500,7 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 				log.Printf("예기치 않은 WebSocket 종료: %v", err)
 			}
 			log.Printf("메시지 수신 실패: %v", err)
-			conn.WriteJSON(res.JsonResponse{
+			h.hub.WriteJSON(conn, res.JsonResponse{
 				Success: false,
 				Message: "메시지 형식이 올바르지 않습니다",
 				Type:    "error",
@@ -340,7 +512,7 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 		var message req.SendMessageRequest
 		if err := json.Unmarshal(messageBytes, &message); err != nil {
 			log.Printf("메시지 디코딩 실패: %v", err)
-			conn.WriteJSON(res.JsonResponse{
+			h.hub.WriteJSON(conn, res.JsonResponse{
 				Success: false,
 				Message: "메시지 디코딩 실패",
 				Type:    "error",
@@ -348,6 +520,37 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 			continue
 		}
 
+		//TODO 입력 중 표시 - 저장하지 않고 채팅방에만 전달
+		switch message.Type {
+		case "typing.start":
+			//TODO 채팅방 참여자만 입력 중 표시 (나간 뒤 남은 연결 포함)
+			if h.chatUsecase.IsChatRoomMember(claims.UserId, uint(roomIdUint)) {
+				h.hub.StartTyping(uint(roomIdUint), claims.UserId, claims.Name)
+			}
+			continue
+		case "typing.stop":
+			h.hub.StopTyping(uint(roomIdUint), claims.UserId)
+			continue
+		case "resume":
+			h.replayChatMessages(conn, uint(roomIdUint), claims.UserId, message.LastSeq)
+			continue
+		}
+
+		//TODO 다른 사용자 이름으로 보낸 메시지는 저장하지 않음
+		if message.SenderID != claims.UserId {
+			log.Printf("메시지 sender_id 불일치: sender_id %d, 토큰 사용자 %d", message.SenderID, claims.UserId)
+			h.hub.WriteJSON(conn, res.JsonResponse{
+				Success: false,
+				Message: "sender_id가 토큰의 사용자와 일치하지 않습니다",
+				Type:    "error",
+				Payload: &res.ChatAckPayload{
+					ChatRoomID:  message.RoomID,
+					ClientMsgID: message.ClientMsgID,
+				},
+			})
+			continue
+		}
+
 		chatRoomFromRedis, err := h.chatUsecase.GetChatRoomByIdFromRedis(message.RoomID)
 		if err != nil || chatRoomFromRedis == nil {
 			log.Printf("레디스 채팅방 조회 실패: %v", err)
@@ -388,16 +591,46 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 		}
 
 		// 메시지 저장 -> nats pub으로 발행 저장 로직 처리
-		if _, err := h.chatUsecase.SaveMessage(message.SenderID, message.RoomID, message.Content); err != nil {
+		chat, err := h.chatUsecase.SaveMessage(message.SenderID, message.RoomID, message.Content, message.ParentMessageID, message.ShowInRoom, message.ClientMsgID, nil)
+		if err != nil {
+			//TODO 재전송된 메시지 - 다시 브로드캐스트하지 않고 저장된 메시지로 ack
+			if appError, ok := err.(*common.AppError); ok && appError.StatusCode == http.StatusConflict && chat != nil {
+				h.hub.SendChatAck(conn, &res.ChatAckPayload{
+					ChatRoomID:    chat.ChatRoomID,
+					ClientMsgID:   chat.ClientMsgID,
+					ChatMessageID: chat.ID,
+					Seq:           chat.Seq,
+					CreatedAt:     chat.CreatedAt.Format(time.RFC3339),
+					Duplicated:    true,
+				})
+				continue
+			}
+
 			log.Printf("채팅 메시지 저장 실패: %v", err)
-			conn.WriteJSON(res.JsonResponse{
+			h.hub.WriteJSON(conn, res.JsonResponse{
 				Success: false,
 				Message: "채팅 메시지 저장 실패",
 				Type:    "error",
+				Payload: &res.ChatAckPayload{
+					ChatRoomID:  message.RoomID,
+					ClientMsgID: message.ClientMsgID,
+				},
 			})
 			continue
 		}
 
+		//TODO 저장 확인 - 보낸 사람에게 메시지 ID와 순번 전달
+		h.hub.SendChatAck(conn, &res.ChatAckPayload{
+			ChatRoomID:    chat.ChatRoomID,
+			ClientMsgID:   chat.ClientMsgID,
+			ChatMessageID: chat.ID,
+			Seq:           chat.Seq,
+			CreatedAt:     chat.CreatedAt.Format(time.RFC3339),
+		})
+
+		// 메시지를 보냈으면 입력 중 표시 종료
+		h.hub.StopTyping(message.RoomID, claims.UserId)
+
 		userInfo, err := h.userUsecase.GetUserMyInfo(message.SenderID)
 		if err != nil {
 			log.Printf("사용자 정보 조회 실패: %v", err)
@@ -406,7 +639,12 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 
 		userImage := ""
 		if userInfo.UserProfile.Image != nil {
-			userImage = *userInfo.UserProfile.Image
+			userImage = util.SignProfileImageURL(*userInfo.UserProfile.Image)
+		}
+
+		//TODO 스레드 답글은 chat.thread.reply 이벤트로 전달 - 채팅방에도 표시하는 경우만 브로드캐스트
+		if message.ParentMessageID != "" && !message.ShowInRoom {
+			continue
 		}
 
 		// 메시지 전송 성공 및 브로드캐스트
@@ -420,12 +658,34 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 				SenderEmail: claims.Email,
 				SenderImage: userImage,
 				Content:     message.Content,
-				CreatedAt:   time.Now().Format(time.RFC3339),
+				CreatedAt:   chat.CreatedAt.Format(time.RFC3339),
+
+				ParentMessageID: message.ParentMessageID,
+				ShowInRoom:      message.ShowInRoom,
+
+				ChatMessageID: chat.ID,
+				ClientMsgID:   chat.ClientMsgID,
+				Seq:           chat.Seq,
 			},
 		})
 	}
 }
 
+// TODO 누락 메시지 재전송 - 한 번에 일부만, has_more면 클라이언트가 last_seq로 다시 resume
+func (h *WsHandler) replayChatMessages(conn *websocket.Conn, roomID uint, userID uint, lastSeq int64) {
+	replay, err := h.chatUsecase.GetMissedChatMessages(userID, roomID, lastSeq)
+	if err != nil {
+		log.Printf("누락 메시지 조회 실패: %v", err)
+		h.hub.WriteJSON(conn, res.JsonResponse{
+			Success: false,
+			Message: "누락 메시지 조회 실패",
+			Type:    "error",
+		})
+		return
+	}
+	h.hub.ReplayChatMessages(conn, replay)
+}
+
 // TODO 유저 웹소켓 연결 핸들러
 func (h *WsHandler) HandleUserWebSocketConnection(c *gin.Context) {
 	// 쿼리 스트링에서 token과 userId 가져오기
@@ -459,13 +719,13 @@ func (h *WsHandler) HandleUserWebSocketConnection(c *gin.Context) {
 	_, err = util.ValidateAccessToken(token)
 	if err != nil {
 		log.Printf("토큰 검증 실패: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.WriteJSON(conn, res.JsonResponse{
 			Success: false,
 			Message: "Unauthorized",
 			Type:    "error",
 		})
 		logger.LogError("토큰 검증 실패")
-		conn.Close()
+		h.hub.CloseConnection(conn)
 		return
 	}
 
@@ -473,13 +733,13 @@ func (h *WsHandler) HandleUserWebSocketConnection(c *gin.Context) {
 	userIdUint, err := strconv.ParseUint(userId, 10, 64)
 	if err != nil {
 		log.Printf("userId 변환 실패: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.WriteJSON(conn, res.JsonResponse{
 			Success: false,
 			Message: "userId 형식이 올바르지 않습니다",
 			Type:    "error",
 		})
 		logger.LogError("userId 변환 실패")
-		conn.Close()
+		h.hub.CloseConnection(conn)
 		return
 	}
 
@@ -490,13 +750,13 @@ func (h *WsHandler) HandleUserWebSocketConnection(c *gin.Context) {
 	user, err := h.userUsecase.GetUserMyInfo(uint(userIdUint))
 	if err != nil {
 		log.Printf("사용자 조회에 실패했습니다: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.WriteJSON(conn, res.JsonResponse{
 			Success: false,
 			Message: "사용자 조회에 실패했습니다",
 			Type:    "error",
 		})
 		logger.LogError("사용자 조회에 실패했습니다")
-		conn.Close()
+		h.hub.CloseConnection(conn)
 		return
 	}
 
@@ -535,7 +795,7 @@ func (h *WsHandler) HandleUserWebSocketConnection(c *gin.Context) {
 		var message req.NotificationRequest
 		if err := json.Unmarshal(messageBytes, &message); err != nil {
 			log.Printf("메시지 디코딩 실패: %v", err)
-			conn.WriteJSON(res.JsonResponse{
+			h.hub.WriteJSON(conn, res.JsonResponse{
 				Success: false,
 				Message: "메시지 형식이 올바르지 않습니다",
 				Type:    "notification",
@@ -577,32 +837,32 @@ func (h *WsHandler) HandleCompanyEvent(c *gin.Context) {
 	companyIdUint, err := strconv.ParseUint(companyId, 10, 64)
 	if err != nil {
 		log.Printf("companyId 변환 실패: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.WriteJSON(conn, res.JsonResponse{
 			Success: false,
 			Message: "companyId 형식이 올바르지 않습니다",
 			Type:    "error",
 		})
 		logger.LogError("companyId 변환 실패")
-		conn.Close()
+		h.hub.CloseConnection(conn)
 		return
 	}
 
 	defer func() {
 		h.hub.UnregisterCompanyClient(conn, uint(companyIdUint))
-		conn.Close()
+		h.hub.CloseConnection(conn)
 	}()
 
 	// 회사 존재 여부 확인
 	_, err = h.companyUsecase.GetCompanyInfo(uint(companyIdUint))
 	if err != nil {
 		log.Printf("회사 조회 실패: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.WriteJSON(conn, res.JsonResponse{
 			Success: false,
 			Message: "회사 조회 실패",
 			Type:    "error",
 		})
 		logger.LogError("회사 조회 실패")
-		conn.Close()
+		h.hub.CloseConnection(conn)
 		return
 	}
 
@@ -755,7 +1015,7 @@ func (h *WsHandler) HandleBoardWebSocket(c *gin.Context) {
 		}
 
 		h.natsPublisher.PublishEvent("link.event.board.user.left", jsonData)
-		conn.Close()
+		h.hub.CloseConnection(conn)
 	}()
 
 	// 종료 신호를 위한 채널 추가
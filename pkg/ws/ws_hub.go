@@ -20,6 +20,7 @@ const (
 	PongWait              = 60 * time.Second
 	WriteWait             = 10 * time.Second
 	CleanInterval         = 10 * time.Minute
+	TypingTimeout         = 5 * time.Second
 )
 
 // WebSocketHub는 클라이언트와 채팅방을 관리하고, 클라이언트의 온라인 상태 및 알림을 관리합니다.
@@ -34,6 +35,7 @@ type WebSocketHub struct {
 	Unregister       chan UnregisterInfo
 	boardMutexes     sync.Map // 보드 ID에 따라 뮤텍스를 관리 (key: boardId, value: sync.Mutex)
 	OnlineClients    sync.Map // 전체 온라인 유저 (key: userId, value: true/false)
+	writeMutexes     sync.Map // 연결별 쓰기 잠금 (key: *websocket.Conn, value: *sync.Mutex)
 	stopCleanup      chan struct{}
 }
 
@@ -62,6 +64,17 @@ type UnregisterInfo struct {
 // ChatRoom은 채팅방에 속한 유저의 연결을 관리합니다.
 type ChatRoom struct {
 	Clients sync.Map // roomId에 속한 유저들의 WebSocket 연결 (key: userId, value: WebSocket connection)
+
+	//TODO 채팅방 화면을 보고 있는 사용자, 입력 중인 사용자 - 메모리에서만 관리
+	Viewers sync.Map // key: userId, value: *ChatRoomViewer
+	typing  sync.Map // key: userId, value: *time.Timer (만료 시 typing.stop)
+}
+
+// ChatRoomViewer는 채팅방을 보고 있는 사용자 정보입니다.
+type ChatRoomViewer struct {
+	UserID uint
+	Name   string
+	Since  time.Time
 }
 
 // WebSocket 연결을 업그레이드합니다.
@@ -125,7 +138,7 @@ func (hub *WebSocketHub) cleanupInactiveConnections() {
 		// 오래된 연결 제거
 		for _, conn := range connsToRemove {
 			delete(clientsMap, conn)
-			conn.Close()
+			hub.CloseConnection(conn)
 			log.Printf("사용자 %d의 비활성 연결 제거됨", userID)
 		}
 
@@ -153,7 +166,7 @@ func (hub *WebSocketHub) cleanupInactiveConnections() {
 
 		for _, conn := range connsToRemove {
 			delete(clientsMap, conn)
-			conn.Close()
+			hub.CloseConnection(conn)
 			log.Printf("회사 %d의 비활성 연결 제거됨", companyID)
 		}
 
@@ -246,12 +259,12 @@ func (hub *WebSocketHub) RegisterClient(conn *websocket.Conn, userID uint, roomI
 
 		if oldestConn != nil {
 			delete(clientsMap, oldestConn)
-			oldestConn.WriteJSON(res.JsonResponse{
+			hub.WriteJSON(oldestConn, res.JsonResponse{
 				Success: false,
 				Message: "다른 기기에서 새로운 연결이 감지되어 연결이 종료됩니다.",
 				Type:    "close",
 			})
-			oldestConn.Close()
+			hub.CloseConnection(oldestConn)
 			log.Printf("사용자 %d의 최대 연결 수 초과로 오래된 연결 제거됨", userID)
 		}
 	}
@@ -302,7 +315,7 @@ func (hub *WebSocketHub) RegisterClient(conn *websocket.Conn, userID uint, roomI
 			return true
 		})
 
-		conn.WriteJSON(res.JsonResponse{
+		hub.WriteJSON(conn, res.JsonResponse{
 			Success: true,
 			Message: fmt.Sprintf("User %d 연결 성공", userID),
 			Type:    "connection",
@@ -407,7 +420,7 @@ func (hub *WebSocketHub) UnregisterClient(conn *websocket.Conn, userID uint, roo
 	}
 
 	if conn != nil {
-		conn.Close()
+		hub.CloseConnection(conn)
 	}
 }
 
@@ -428,6 +441,7 @@ func (hub *WebSocketHub) RemoveFromChatRoom(roomID uint, userID uint) {
 	log.Printf("채팅방 %d에서 사용자 %d 제거", roomID, userID)
 	if room, ok := hub.ChatRooms.Load(roomID); ok {
 		room.(*ChatRoom).Clients.Delete(userID)
+		hub.leaveChatRoomViewer(room.(*ChatRoom), roomID, userID)
 
 		// 방에 유저가 남아있는지 확인하고 삭제
 		empty := true
@@ -455,6 +469,160 @@ func (hub *WebSocketHub) SendMessageToChatRoom(roomID uint, message res.JsonResp
 	}
 }
 
+// 특정 사용자를 제외한 채팅방 전체에 메시지 전송
+func (hub *WebSocketHub) sendMessageToChatRoomExcept(roomID uint, exceptUserID uint, message res.JsonResponse) {
+	if room, ok := hub.ChatRooms.Load(roomID); ok {
+		room.(*ChatRoom).Clients.Range(func(userID, clientConn interface{}) bool {
+			if userID.(uint) != exceptUserID {
+				hub.sendMessageToClient(clientConn.(*websocket.Conn), message)
+			}
+			return true
+		})
+	}
+}
+
+// 메시지 저장 확인을 보낸 사람에게만 전송 (chat.ack)
+func (hub *WebSocketHub) SendChatAck(conn *websocket.Conn, ack *res.ChatAckPayload) {
+	hub.sendMessageToClient(conn, res.JsonResponse{
+		Success: true,
+		Type:    "chat.ack",
+		Message: "채팅 메시지 저장 완료",
+		Payload: ack,
+	})
+}
+
+// 재연결한 클라이언트에게 누락 메시지 재전송 (chat.replay)
+func (hub *WebSocketHub) ReplayChatMessages(conn *websocket.Conn, replay *res.ChatReplayResponse) {
+	hub.sendMessageToClient(conn, res.JsonResponse{
+		Success: true,
+		Type:    "chat.replay",
+		Message: "누락 메시지 재전송",
+		Payload: replay,
+	})
+}
+
+// ! 채팅방 접속자(보고 있는 사람), 입력 중 표시 - MongoDB에 저장하지 않음
+
+// 채팅방 접속자 등록 후 본인에게 전체 목록, 다른 사용자에게 입장 알림
+func (hub *WebSocketHub) JoinChatRoomViewer(roomID uint, userID uint, name string, conn *websocket.Conn) {
+	room, _ := hub.ChatRooms.LoadOrStore(roomID, &ChatRoom{})
+	viewer := &ChatRoomViewer{UserID: userID, Name: name, Since: time.Now()}
+	_, loaded := room.(*ChatRoom).Viewers.LoadOrStore(userID, viewer)
+
+	hub.sendMessageToClient(conn, res.JsonResponse{
+		Success: true,
+		Type:    "chat.presence",
+		Payload: &res.ChatPresencePayload{
+			ChatRoomID: roomID,
+			Viewers:    hub.GetChatRoomViewers(roomID),
+		},
+	})
+
+	if !loaded {
+		hub.sendMessageToChatRoomExcept(roomID, userID, res.JsonResponse{
+			Success: true,
+			Type:    "chat.presence.joined",
+			Payload: &res.ChatPresencePayload{
+				ChatRoomID: roomID,
+				Viewer:     toChatViewerResponse(viewer),
+			},
+		})
+	}
+}
+
+// 채팅방 접속자 제거 - 입력 중이었다면 typing.stop도 함께 전송
+func (hub *WebSocketHub) leaveChatRoomViewer(room *ChatRoom, roomID uint, userID uint) {
+	hub.StopTyping(roomID, userID)
+
+	viewer, loaded := room.Viewers.LoadAndDelete(userID)
+	if !loaded {
+		return
+	}
+	hub.sendMessageToChatRoomExcept(roomID, userID, res.JsonResponse{
+		Success: true,
+		Type:    "chat.presence.left",
+		Payload: &res.ChatPresencePayload{
+			ChatRoomID: roomID,
+			Viewer:     toChatViewerResponse(viewer.(*ChatRoomViewer)),
+		},
+	})
+}
+
+// 채팅방을 보고 있는 사용자 목록
+func (hub *WebSocketHub) GetChatRoomViewers(roomID uint) []*res.ChatViewerResponse {
+	viewers := make([]*res.ChatViewerResponse, 0)
+	if room, ok := hub.ChatRooms.Load(roomID); ok {
+		room.(*ChatRoom).Viewers.Range(func(_, viewer interface{}) bool {
+			viewers = append(viewers, toChatViewerResponse(viewer.(*ChatRoomViewer)))
+			return true
+		})
+	}
+	return viewers
+}
+
+// 입력 시작 - TypingTimeout 동안 다시 typing.start가 없으면 자동으로 typing.stop
+func (hub *WebSocketHub) StartTyping(roomID uint, userID uint, name string) {
+	room, ok := hub.ChatRooms.Load(roomID)
+	if !ok {
+		return
+	}
+
+	if timer, ok := room.(*ChatRoom).typing.Load(userID); ok {
+		timer.(*time.Timer).Reset(TypingTimeout)
+		return
+	}
+
+	timer := time.AfterFunc(TypingTimeout, func() {
+		hub.StopTyping(roomID, userID)
+	})
+	if _, loaded := room.(*ChatRoom).typing.LoadOrStore(userID, timer); loaded {
+		timer.Stop()
+		return
+	}
+
+	hub.sendMessageToChatRoomExcept(roomID, userID, res.JsonResponse{
+		Success: true,
+		Type:    "typing.start",
+		Payload: &res.ChatTypingPayload{
+			ChatRoomID: roomID,
+			UserID:     userID,
+			Name:       name,
+			ExpiresIn:  int(TypingTimeout.Seconds()),
+		},
+	})
+}
+
+// 입력 종료 - 입력 중이 아니었다면 무시
+func (hub *WebSocketHub) StopTyping(roomID uint, userID uint) {
+	room, ok := hub.ChatRooms.Load(roomID)
+	if !ok {
+		return
+	}
+
+	timer, loaded := room.(*ChatRoom).typing.LoadAndDelete(userID)
+	if !loaded {
+		return
+	}
+	timer.(*time.Timer).Stop()
+
+	hub.sendMessageToChatRoomExcept(roomID, userID, res.JsonResponse{
+		Success: true,
+		Type:    "typing.stop",
+		Payload: &res.ChatTypingPayload{
+			ChatRoomID: roomID,
+			UserID:     userID,
+		},
+	})
+}
+
+func toChatViewerResponse(viewer *ChatRoomViewer) *res.ChatViewerResponse {
+	return &res.ChatViewerResponse{
+		UserID: viewer.UserID,
+		Name:   viewer.Name,
+		Since:  viewer.Since.Format(time.RFC3339),
+	}
+}
+
 // 특정 유저에게 메시지 전송 -> 특정 유저에게 알람을 보낼 때,
 // 알림 같은거 보낼 때 사용
 func (hub *WebSocketHub) SendMessageToUser(userID uint, message res.JsonResponse) {
@@ -464,11 +632,25 @@ func (hub *WebSocketHub) SendMessageToUser(userID uint, message res.JsonResponse
 	}
 }
 
+// WriteJSON gorilla/websocket은 동시 쓰기를 허용하지 않으므로 연결마다 잠근 뒤 전송 (타이머, NATS 구독 등 여러 고루틴에서 호출됨)
+func (hub *WebSocketHub) WriteJSON(conn *websocket.Conn, message interface{}) error {
+	mutex, _ := hub.writeMutexes.LoadOrStore(conn, &sync.Mutex{})
+	mutex.(*sync.Mutex).Lock()
+	defer mutex.(*sync.Mutex).Unlock()
+	return conn.WriteJSON(message)
+}
+
+// CloseConnection 연결 종료 후 쓰기 잠금 정리
+func (hub *WebSocketHub) CloseConnection(conn *websocket.Conn) error {
+	hub.writeMutexes.Delete(conn)
+	return conn.Close()
+}
+
 // 개별 클라이언트에 메시지 전송
 func (hub *WebSocketHub) sendMessageToClient(client *websocket.Conn, message interface{}) {
-	if err := client.WriteJSON(message); err != nil {
+	if err := hub.WriteJSON(client, message); err != nil {
 		log.Printf("클라이언트에게 메시지 전송 실패: %v\n", err)
-		client.Close()
+		hub.CloseConnection(client)
 	}
 }
 
@@ -490,7 +672,7 @@ func (hub *WebSocketHub) RegisterCompanyClient(conn *websocket.Conn, companyID u
 
 	hub.CompanyClients.Store(companyID, clientsMap)
 
-	conn.WriteJSON(res.JsonResponse{
+	hub.WriteJSON(conn, res.JsonResponse{
 		Success: true,
 		Message: fmt.Sprintf("Company %d 연결 성공", companyID),
 		Type:    "company_connection",
@@ -511,15 +693,15 @@ func (hub *WebSocketHub) UnregisterCompanyClient(conn *websocket.Conn, companyID
 			log.Printf("회사 %d 클라이언트 연결 해제, 남은 연결 수: %d", companyID, len(clientsMap))
 		}
 	}
-	conn.Close()
+	hub.CloseConnection(conn)
 }
 
 // 회사 클라이언트에게 메시지 전송
-func (h *WebSocketHub) SendMessageToCompany(companyId uint, msg res.JsonResponse) {
-	if clientsMapInterface, ok := h.CompanyClients.Load(companyId); ok {
+func (hub *WebSocketHub) SendMessageToCompany(companyId uint, msg res.JsonResponse) {
+	if clientsMapInterface, ok := hub.CompanyClients.Load(companyId); ok {
 		clientsMap := clientsMapInterface.(map[*websocket.Conn]*ConnectionInfo)
 		for conn := range clientsMap {
-			if err := conn.WriteJSON(msg); err != nil {
+			if err := hub.WriteJSON(conn, msg); err != nil {
 				log.Printf("웹소켓 메시지 전송 실패: %v", err)
 			}
 		}
@@ -773,7 +955,7 @@ func (hub *WebSocketHub) BroadcastToBoard(boardID uint, msg interface{}) {
 				continue
 			}
 
-			err := conn.WriteJSON(msg)
+			err := hub.WriteJSON(conn, msg)
 			if err != nil {
 				log.Printf("메시지 전송 실패 (사용자 %d): %v", userID, err)
 				connInfo.IsActive = false
@@ -795,7 +977,7 @@ func (hub *WebSocketHub) SendMessageToBoardUser(boardID uint, userID uint, msg i
 
 		if userConns, ok := boardClients[userID]; ok {
 			for conn := range userConns {
-				if err := conn.WriteJSON(msg); err != nil {
+				if err := hub.WriteJSON(conn, msg); err != nil {
 					log.Printf("웹소켓 메시지 전송 실패: %v", err)
 				}
 			}
@@ -812,14 +994,14 @@ func (hub *WebSocketHub) Shutdown() {
 
 	for _, clientsMap := range hub.Clients {
 		for conn := range clientsMap {
-			conn.Close()
+			hub.CloseConnection(conn)
 		}
 	}
 
 	hub.CompanyClients.Range(func(_, clientsMapInterface interface{}) bool {
 		clientsMap := clientsMapInterface.(map[*websocket.Conn]*ConnectionInfo)
 		for conn := range clientsMap {
-			conn.Close()
+			hub.CloseConnection(conn)
 		}
 		return true
 	})